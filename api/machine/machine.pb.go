@@ -9,6 +9,7 @@ import (
 	math "math"
 
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
@@ -29,6 +30,73 @@ var (
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// WipeMode describes how the contents of a partition are destroyed.
+type WipeMode int32
+
+const (
+	// FAST removes the partition from the partition table only.
+	WipeMode_FAST WipeMode = 0
+	// ZERO overwrites the partition with zeroes.
+	WipeMode_ZERO WipeMode = 1
+	// DISCARD discards the partition blocks (BLKDISCARD).
+	WipeMode_DISCARD WipeMode = 2
+	// SECURE_DISCARD securely discards the partition blocks (BLKSECDISCARD).
+	WipeMode_SECURE_DISCARD WipeMode = 3
+)
+
+var WipeMode_name = map[int32]string{
+	0: "FAST",
+	1: "ZERO",
+	2: "DISCARD",
+	3: "SECURE_DISCARD",
+}
+
+var WipeMode_value = map[string]int32{
+	"FAST":           0,
+	"ZERO":           1,
+	"DISCARD":        2,
+	"SECURE_DISCARD": 3,
+}
+
+func (x WipeMode) String() string {
+	return proto.EnumName(WipeMode_name, int32(x))
+}
+
+func (WipeMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{0}
+}
+
+type WipeProgress_Stage int32
+
+const (
+	WipeProgress_WIPE   WipeProgress_Stage = 0
+	WipeProgress_VERIFY WipeProgress_Stage = 1
+	WipeProgress_KEEP   WipeProgress_Stage = 2
+	WipeProgress_DONE   WipeProgress_Stage = 3
+)
+
+var WipeProgress_Stage_name = map[int32]string{
+	0: "WIPE",
+	1: "VERIFY",
+	2: "KEEP",
+	3: "DONE",
+}
+
+var WipeProgress_Stage_value = map[string]int32{
+	"WIPE":   0,
+	"VERIFY": 1,
+	"KEEP":   2,
+	"DONE":   3,
+}
+
+func (x WipeProgress_Stage) String() string {
+	return proto.EnumName(WipeProgress_Stage_name, int32(x))
+}
+
+func (WipeProgress_Stage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{6, 0}
+}
+
 // rpc reboot
 // The reboot message containing the reboot status.
 type Reboot struct {
@@ -119,8 +187,19 @@ func (m *RebootResponse) GetMessages() []*Reboot {
 
 // rpc reset
 type ResetRequest struct {
-	Graceful             bool     `protobuf:"varint,1,opt,name=graceful,proto3" json:"graceful,omitempty"`
-	Reboot               bool     `protobuf:"varint,2,opt,name=reboot,proto3" json:"reboot,omitempty"`
+	Graceful bool `protobuf:"varint,1,opt,name=graceful,proto3" json:"graceful,omitempty"`
+	Reboot   bool `protobuf:"varint,2,opt,name=reboot,proto3" json:"reboot,omitempty"`
+	// Mode is the wipe mode used for the system disk partitions which are not
+	// listed in partitions.
+	Mode WipeMode `protobuf:"varint,3,opt,name=mode,proto3,enum=machine.WipeMode" json:"mode,omitempty"`
+	// Partitions overrides the wipe mode of individual system disk partitions.
+	Partitions []*ResetPartitionSpec `protobuf:"bytes,4,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	// Verify reads back wiped partitions and fails the reset unless they
+	// contain only zeroes. Partitions wiped with the discard modes are zero
+	// filled before being verified, as discard doesn't guarantee that the
+	// device reads back zeroes. Verify can't be used if any partition is wiped
+	// with the fast mode.
+	Verify               bool     `protobuf:"varint,5,opt,name=verify,proto3" json:"verify,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -169,6 +248,89 @@ func (m *ResetRequest) GetReboot() bool {
 	return false
 }
 
+func (m *ResetRequest) GetMode() WipeMode {
+	if m != nil {
+		return m.Mode
+	}
+	return WipeMode_FAST
+}
+
+func (m *ResetRequest) GetPartitions() []*ResetPartitionSpec {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *ResetRequest) GetVerify() bool {
+	if m != nil {
+		return m.Verify
+	}
+	return false
+}
+
+// ResetPartitionSpec selects the wipe mode of a system disk partition.
+type ResetPartitionSpec struct {
+	// Label is the partition label, e.g. EPHEMERAL.
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// Keep leaves the partition and its contents untouched.
+	Keep                 bool     `protobuf:"varint,2,opt,name=keep,proto3" json:"keep,omitempty"`
+	Mode                 WipeMode `protobuf:"varint,3,opt,name=mode,proto3,enum=machine.WipeMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPartitionSpec) Reset()         { *m = ResetPartitionSpec{} }
+func (m *ResetPartitionSpec) String() string { return proto.CompactTextString(m) }
+func (*ResetPartitionSpec) ProtoMessage()    {}
+func (*ResetPartitionSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{3}
+}
+
+func (m *ResetPartitionSpec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPartitionSpec.Unmarshal(m, b)
+}
+
+func (m *ResetPartitionSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPartitionSpec.Marshal(b, m, deterministic)
+}
+
+func (m *ResetPartitionSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPartitionSpec.Merge(m, src)
+}
+
+func (m *ResetPartitionSpec) XXX_Size() int {
+	return xxx_messageInfo_ResetPartitionSpec.Size(m)
+}
+
+func (m *ResetPartitionSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPartitionSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPartitionSpec proto.InternalMessageInfo
+
+func (m *ResetPartitionSpec) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ResetPartitionSpec) GetKeep() bool {
+	if m != nil {
+		return m.Keep
+	}
+	return false
+}
+
+func (m *ResetPartitionSpec) GetMode() WipeMode {
+	if m != nil {
+		return m.Mode
+	}
+	return WipeMode_FAST
+}
+
 // The reset message containing the restart status.
 type Reset struct {
 	Metadata             *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -181,7 +343,7 @@ func (m *Reset) Reset()         { *m = Reset{} }
 func (m *Reset) String() string { return proto.CompactTextString(m) }
 func (*Reset) ProtoMessage()    {}
 func (*Reset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{3}
+	return fileDescriptor_84b4f59d98cc997c, []int{4}
 }
 
 func (m *Reset) XXX_Unmarshal(b []byte) error {
@@ -224,7 +386,7 @@ func (m *ResetResponse) Reset()         { *m = ResetResponse{} }
 func (m *ResetResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetResponse) ProtoMessage()    {}
 func (*ResetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{4}
+	return fileDescriptor_84b4f59d98cc997c, []int{5}
 }
 
 func (m *ResetResponse) XXX_Unmarshal(b []byte) error {
@@ -256,6 +418,100 @@ func (m *ResetResponse) GetMessages() []*Reset {
 	return nil
 }
 
+// rpc resetprogress
+// The wipe progress message describes the progress of wiping a system disk
+// partition.
+type WipeProgress struct {
+	Metadata             *common.Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Stage                WipeProgress_Stage `protobuf:"varint,2,opt,name=stage,proto3,enum=machine.WipeProgress_Stage" json:"stage,omitempty"`
+	Label                string             `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Mode                 WipeMode           `protobuf:"varint,4,opt,name=mode,proto3,enum=machine.WipeMode" json:"mode,omitempty"`
+	BytesDone            uint64             `protobuf:"varint,5,opt,name=bytes_done,json=bytesDone,proto3" json:"bytes_done,omitempty"`
+	BytesTotal           uint64             `protobuf:"varint,6,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
+	Error                string             `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *WipeProgress) Reset()         { *m = WipeProgress{} }
+func (m *WipeProgress) String() string { return proto.CompactTextString(m) }
+func (*WipeProgress) ProtoMessage()    {}
+func (*WipeProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{6}
+}
+
+func (m *WipeProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WipeProgress.Unmarshal(m, b)
+}
+
+func (m *WipeProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WipeProgress.Marshal(b, m, deterministic)
+}
+
+func (m *WipeProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WipeProgress.Merge(m, src)
+}
+
+func (m *WipeProgress) XXX_Size() int {
+	return xxx_messageInfo_WipeProgress.Size(m)
+}
+
+func (m *WipeProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WipeProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WipeProgress proto.InternalMessageInfo
+
+func (m *WipeProgress) GetMetadata() *common.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *WipeProgress) GetStage() WipeProgress_Stage {
+	if m != nil {
+		return m.Stage
+	}
+	return WipeProgress_WIPE
+}
+
+func (m *WipeProgress) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *WipeProgress) GetMode() WipeMode {
+	if m != nil {
+		return m.Mode
+	}
+	return WipeMode_FAST
+}
+
+func (m *WipeProgress) GetBytesDone() uint64 {
+	if m != nil {
+		return m.BytesDone
+	}
+	return 0
+}
+
+func (m *WipeProgress) GetBytesTotal() uint64 {
+	if m != nil {
+		return m.BytesTotal
+	}
+	return 0
+}
+
+func (m *WipeProgress) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // rpc shutdown
 // The messages message containing the shutdown status.
 type Shutdown struct {
@@ -269,7 +525,7 @@ func (m *Shutdown) Reset()         { *m = Shutdown{} }
 func (m *Shutdown) String() string { return proto.CompactTextString(m) }
 func (*Shutdown) ProtoMessage()    {}
 func (*Shutdown) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{5}
+	return fileDescriptor_84b4f59d98cc997c, []int{7}
 }
 
 func (m *Shutdown) XXX_Unmarshal(b []byte) error {
@@ -312,7 +568,7 @@ func (m *ShutdownResponse) Reset()         { *m = ShutdownResponse{} }
 func (m *ShutdownResponse) String() string { return proto.CompactTextString(m) }
 func (*ShutdownResponse) ProtoMessage()    {}
 func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{6}
+	return fileDescriptor_84b4f59d98cc997c, []int{8}
 }
 
 func (m *ShutdownResponse) XXX_Unmarshal(b []byte) error {
@@ -356,7 +612,7 @@ func (m *UpgradeRequest) Reset()         { *m = UpgradeRequest{} }
 func (m *UpgradeRequest) String() string { return proto.CompactTextString(m) }
 func (*UpgradeRequest) ProtoMessage()    {}
 func (*UpgradeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{7}
+	return fileDescriptor_84b4f59d98cc997c, []int{9}
 }
 
 func (m *UpgradeRequest) XXX_Unmarshal(b []byte) error {
@@ -400,7 +656,7 @@ func (m *Upgrade) Reset()         { *m = Upgrade{} }
 func (m *Upgrade) String() string { return proto.CompactTextString(m) }
 func (*Upgrade) ProtoMessage()    {}
 func (*Upgrade) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{8}
+	return fileDescriptor_84b4f59d98cc997c, []int{10}
 }
 
 func (m *Upgrade) XXX_Unmarshal(b []byte) error {
@@ -450,7 +706,7 @@ func (m *UpgradeResponse) Reset()         { *m = UpgradeResponse{} }
 func (m *UpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*UpgradeResponse) ProtoMessage()    {}
 func (*UpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{9}
+	return fileDescriptor_84b4f59d98cc997c, []int{11}
 }
 
 func (m *UpgradeResponse) XXX_Unmarshal(b []byte) error {
@@ -482,6 +738,92 @@ func (m *UpgradeResponse) GetMessages() []*Upgrade {
 	return nil
 }
 
+// rpc upgradeprogressstream
+// The installer image pull progress sent during upgrade.
+type ImagePullProgress struct {
+	Metadata   *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Image      string           `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	BytesDone  uint64           `protobuf:"varint,3,opt,name=bytes_done,json=bytesDone,proto3" json:"bytes_done,omitempty"`
+	BytesTotal uint64           `protobuf:"varint,4,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
+	// Done is set once the image is pulled and verified, or the pull failed.
+	Done                 bool     `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	Error                string   `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImagePullProgress) Reset()         { *m = ImagePullProgress{} }
+func (m *ImagePullProgress) String() string { return proto.CompactTextString(m) }
+func (*ImagePullProgress) ProtoMessage()    {}
+func (*ImagePullProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{12}
+}
+
+func (m *ImagePullProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImagePullProgress.Unmarshal(m, b)
+}
+
+func (m *ImagePullProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImagePullProgress.Marshal(b, m, deterministic)
+}
+
+func (m *ImagePullProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImagePullProgress.Merge(m, src)
+}
+
+func (m *ImagePullProgress) XXX_Size() int {
+	return xxx_messageInfo_ImagePullProgress.Size(m)
+}
+
+func (m *ImagePullProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImagePullProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImagePullProgress proto.InternalMessageInfo
+
+func (m *ImagePullProgress) GetMetadata() *common.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ImagePullProgress) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *ImagePullProgress) GetBytesDone() uint64 {
+	if m != nil {
+		return m.BytesDone
+	}
+	return 0
+}
+
+func (m *ImagePullProgress) GetBytesTotal() uint64 {
+	if m != nil {
+		return m.BytesTotal
+	}
+	return 0
+}
+
+func (m *ImagePullProgress) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *ImagePullProgress) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // rpc servicelist
 type ServiceList struct {
 	Metadata             *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -495,7 +837,7 @@ func (m *ServiceList) Reset()         { *m = ServiceList{} }
 func (m *ServiceList) String() string { return proto.CompactTextString(m) }
 func (*ServiceList) ProtoMessage()    {}
 func (*ServiceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{10}
+	return fileDescriptor_84b4f59d98cc997c, []int{13}
 }
 
 func (m *ServiceList) XXX_Unmarshal(b []byte) error {
@@ -545,7 +887,7 @@ func (m *ServiceListResponse) Reset()         { *m = ServiceListResponse{} }
 func (m *ServiceListResponse) String() string { return proto.CompactTextString(m) }
 func (*ServiceListResponse) ProtoMessage()    {}
 func (*ServiceListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{11}
+	return fileDescriptor_84b4f59d98cc997c, []int{14}
 }
 
 func (m *ServiceListResponse) XXX_Unmarshal(b []byte) error {
@@ -578,20 +920,21 @@ func (m *ServiceListResponse) GetMessages() []*ServiceList {
 }
 
 type ServiceInfo struct {
-	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	State                string         `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	Events               *ServiceEvents `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"`
-	Health               *ServiceHealth `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State                string            `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Events               *ServiceEvents    `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"`
+	Health               *ServiceHealth    `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
+	Resources            *ServiceResources `protobuf:"bytes,5,opt,name=resources,proto3" json:"resources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ServiceInfo) Reset()         { *m = ServiceInfo{} }
 func (m *ServiceInfo) String() string { return proto.CompactTextString(m) }
 func (*ServiceInfo) ProtoMessage()    {}
 func (*ServiceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{12}
+	return fileDescriptor_84b4f59d98cc997c, []int{15}
 }
 
 func (m *ServiceInfo) XXX_Unmarshal(b []byte) error {
@@ -644,6 +987,13 @@ func (m *ServiceInfo) GetHealth() *ServiceHealth {
 	return nil
 }
 
+func (m *ServiceInfo) GetResources() *ServiceResources {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
 type ServiceEvents struct {
 	Events               []*ServiceEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -655,7 +1005,7 @@ func (m *ServiceEvents) Reset()         { *m = ServiceEvents{} }
 func (m *ServiceEvents) String() string { return proto.CompactTextString(m) }
 func (*ServiceEvents) ProtoMessage()    {}
 func (*ServiceEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{13}
+	return fileDescriptor_84b4f59d98cc997c, []int{16}
 }
 
 func (m *ServiceEvents) XXX_Unmarshal(b []byte) error {
@@ -700,7 +1050,7 @@ func (m *ServiceEvent) Reset()         { *m = ServiceEvent{} }
 func (m *ServiceEvent) String() string { return proto.CompactTextString(m) }
 func (*ServiceEvent) ProtoMessage()    {}
 func (*ServiceEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{14}
+	return fileDescriptor_84b4f59d98cc997c, []int{17}
 }
 
 func (m *ServiceEvent) XXX_Unmarshal(b []byte) error {
@@ -760,7 +1110,7 @@ func (m *ServiceHealth) Reset()         { *m = ServiceHealth{} }
 func (m *ServiceHealth) String() string { return proto.CompactTextString(m) }
 func (*ServiceHealth) ProtoMessage()    {}
 func (*ServiceHealth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{15}
+	return fileDescriptor_84b4f59d98cc997c, []int{18}
 }
 
 func (m *ServiceHealth) XXX_Unmarshal(b []byte) error {
@@ -813,71 +1163,584 @@ func (m *ServiceHealth) GetLastChange() *timestamp.Timestamp {
 	return nil
 }
 
-// rpc servicestart
-type ServiceStartRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// ServiceResources describes current resource usage and limits of the service cgroup.
+type ServiceResources struct {
+	Cgroup               string   `protobuf:"bytes,1,opt,name=cgroup,proto3" json:"cgroup,omitempty"`
+	MemoryUsage          uint64   `protobuf:"varint,2,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
+	MemoryLimit          uint64   `protobuf:"varint,3,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	CpuUsage             uint64   `protobuf:"varint,4,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ServiceStartRequest) Reset()         { *m = ServiceStartRequest{} }
-func (m *ServiceStartRequest) String() string { return proto.CompactTextString(m) }
-func (*ServiceStartRequest) ProtoMessage()    {}
-func (*ServiceStartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{16}
+func (m *ServiceResources) Reset()         { *m = ServiceResources{} }
+func (m *ServiceResources) String() string { return proto.CompactTextString(m) }
+func (*ServiceResources) ProtoMessage()    {}
+func (*ServiceResources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{19}
 }
 
-func (m *ServiceStartRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ServiceStartRequest.Unmarshal(m, b)
+func (m *ServiceResources) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceResources.Unmarshal(m, b)
 }
 
-func (m *ServiceStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ServiceStartRequest.Marshal(b, m, deterministic)
+func (m *ServiceResources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceResources.Marshal(b, m, deterministic)
 }
 
-func (m *ServiceStartRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ServiceStartRequest.Merge(m, src)
+func (m *ServiceResources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceResources.Merge(m, src)
 }
 
-func (m *ServiceStartRequest) XXX_Size() int {
-	return xxx_messageInfo_ServiceStartRequest.Size(m)
+func (m *ServiceResources) XXX_Size() int {
+	return xxx_messageInfo_ServiceResources.Size(m)
 }
 
-func (m *ServiceStartRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ServiceStartRequest.DiscardUnknown(m)
+func (m *ServiceResources) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceResources.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ServiceStartRequest proto.InternalMessageInfo
+var xxx_messageInfo_ServiceResources proto.InternalMessageInfo
 
-func (m *ServiceStartRequest) GetId() string {
+func (m *ServiceResources) GetCgroup() string {
 	if m != nil {
-		return m.Id
+		return m.Cgroup
 	}
 	return ""
 }
 
-type ServiceStart struct {
-	Metadata             *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Resp                 string           `protobuf:"bytes,2,opt,name=resp,proto3" json:"resp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *ServiceResources) GetMemoryUsage() uint64 {
+	if m != nil {
+		return m.MemoryUsage
+	}
+	return 0
 }
 
-func (m *ServiceStart) Reset()         { *m = ServiceStart{} }
-func (m *ServiceStart) String() string { return proto.CompactTextString(m) }
-func (*ServiceStart) ProtoMessage()    {}
-func (*ServiceStart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{17}
+func (m *ServiceResources) GetMemoryLimit() uint64 {
+	if m != nil {
+		return m.MemoryLimit
+	}
+	return 0
 }
 
-func (m *ServiceStart) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ServiceStart.Unmarshal(m, b)
+func (m *ServiceResources) GetCpuUsage() uint64 {
+	if m != nil {
+		return m.CpuUsage
+	}
+	return 0
 }
 
-func (m *ServiceStart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ServiceStart.Marshal(b, m, deterministic)
+// rpc servicedependencies
+type ServiceDependencies struct {
+	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Services are listed in topological order: dependencies first.
+	Services             []*ServiceDependency `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ServiceDependencies) Reset()         { *m = ServiceDependencies{} }
+func (m *ServiceDependencies) String() string { return proto.CompactTextString(m) }
+func (*ServiceDependencies) ProtoMessage()    {}
+func (*ServiceDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{20}
+}
+
+func (m *ServiceDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceDependencies.Unmarshal(m, b)
+}
+
+func (m *ServiceDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceDependencies.Marshal(b, m, deterministic)
+}
+
+func (m *ServiceDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceDependencies.Merge(m, src)
+}
+
+func (m *ServiceDependencies) XXX_Size() int {
+	return xxx_messageInfo_ServiceDependencies.Size(m)
+}
+
+func (m *ServiceDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceDependencies proto.InternalMessageInfo
+
+func (m *ServiceDependencies) GetMetadata() *common.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ServiceDependencies) GetServices() []*ServiceDependency {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type ServiceDependenciesResponse struct {
+	Messages             []*ServiceDependencies `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ServiceDependenciesResponse) Reset()         { *m = ServiceDependenciesResponse{} }
+func (m *ServiceDependenciesResponse) String() string { return proto.CompactTextString(m) }
+func (*ServiceDependenciesResponse) ProtoMessage()    {}
+func (*ServiceDependenciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{21}
+}
+
+func (m *ServiceDependenciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceDependenciesResponse.Unmarshal(m, b)
+}
+
+func (m *ServiceDependenciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceDependenciesResponse.Marshal(b, m, deterministic)
+}
+
+func (m *ServiceDependenciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceDependenciesResponse.Merge(m, src)
+}
+
+func (m *ServiceDependenciesResponse) XXX_Size() int {
+	return xxx_messageInfo_ServiceDependenciesResponse.Size(m)
+}
+
+func (m *ServiceDependenciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceDependenciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceDependenciesResponse proto.InternalMessageInfo
+
+func (m *ServiceDependenciesResponse) GetMessages() []*ServiceDependencies {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+// ServiceDependency describes the service as a node of the dependency graph.
+type ServiceDependency struct {
+	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State     string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	DependsOn []string `protobuf:"bytes,3,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	// Condition is the description of the condition service is waiting for.
+	Condition            string   `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceDependency) Reset()         { *m = ServiceDependency{} }
+func (m *ServiceDependency) String() string { return proto.CompactTextString(m) }
+func (*ServiceDependency) ProtoMessage()    {}
+func (*ServiceDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{22}
+}
+
+func (m *ServiceDependency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceDependency.Unmarshal(m, b)
+}
+
+func (m *ServiceDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceDependency.Marshal(b, m, deterministic)
+}
+
+func (m *ServiceDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceDependency.Merge(m, src)
+}
+
+func (m *ServiceDependency) XXX_Size() int {
+	return xxx_messageInfo_ServiceDependency.Size(m)
+}
+
+func (m *ServiceDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceDependency proto.InternalMessageInfo
+
+func (m *ServiceDependency) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ServiceDependency) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ServiceDependency) GetDependsOn() []string {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+func (m *ServiceDependency) GetCondition() string {
+	if m != nil {
+		return m.Condition
+	}
+	return ""
+}
+
+// rpc serviceevents
+type ServiceEventsRequest struct {
+	// Id of the service, if empty, events of all the services are returned.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Limit is the maximum number of most recent events to return per service, 0 means no limit.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Before returns only events older than the timestamp, used for paging.
+	Before               *timestamp.Timestamp `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ServiceEventsRequest) Reset()         { *m = ServiceEventsRequest{} }
+func (m *ServiceEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ServiceEventsRequest) ProtoMessage()    {}
+func (*ServiceEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{23}
+}
+
+func (m *ServiceEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceEventsRequest.Unmarshal(m, b)
+}
+
+func (m *ServiceEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceEventsRequest.Marshal(b, m, deterministic)
+}
+
+func (m *ServiceEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceEventsRequest.Merge(m, src)
+}
+
+func (m *ServiceEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ServiceEventsRequest.Size(m)
+}
+
+func (m *ServiceEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceEventsRequest proto.InternalMessageInfo
+
+func (m *ServiceEventsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ServiceEventsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ServiceEventsRequest) GetBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+type ServiceEventsResponse struct {
+	Messages             []*ServiceEventHistory `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ServiceEventsResponse) Reset()         { *m = ServiceEventsResponse{} }
+func (m *ServiceEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ServiceEventsResponse) ProtoMessage()    {}
+func (*ServiceEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{24}
+}
+
+func (m *ServiceEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceEventsResponse.Unmarshal(m, b)
+}
+
+func (m *ServiceEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceEventsResponse.Marshal(b, m, deterministic)
+}
+
+func (m *ServiceEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceEventsResponse.Merge(m, src)
+}
+
+func (m *ServiceEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ServiceEventsResponse.Size(m)
+}
+
+func (m *ServiceEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceEventsResponse proto.InternalMessageInfo
+
+func (m *ServiceEventsResponse) GetMessages() []*ServiceEventHistory {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type ServiceEventHistory struct {
+	Metadata             *common.Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Services             []*ServiceEventLog `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ServiceEventHistory) Reset()         { *m = ServiceEventHistory{} }
+func (m *ServiceEventHistory) String() string { return proto.CompactTextString(m) }
+func (*ServiceEventHistory) ProtoMessage()    {}
+func (*ServiceEventHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{25}
+}
+
+func (m *ServiceEventHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceEventHistory.Unmarshal(m, b)
+}
+
+func (m *ServiceEventHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceEventHistory.Marshal(b, m, deterministic)
+}
+
+func (m *ServiceEventHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceEventHistory.Merge(m, src)
+}
+
+func (m *ServiceEventHistory) XXX_Size() int {
+	return xxx_messageInfo_ServiceEventHistory.Size(m)
+}
+
+func (m *ServiceEventHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceEventHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceEventHistory proto.InternalMessageInfo
+
+func (m *ServiceEventHistory) GetMetadata() *common.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ServiceEventHistory) GetServices() []*ServiceEventLog {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+// ServiceEventLog is the event history of the service (including previous boots).
+type ServiceEventLog struct {
+	Id                   string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Events               *ServiceEvents  `protobuf:"bytes,2,opt,name=events,proto3" json:"events,omitempty"`
+	Timings              *ServiceTimings `protobuf:"bytes,3,opt,name=timings,proto3" json:"timings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ServiceEventLog) Reset()         { *m = ServiceEventLog{} }
+func (m *ServiceEventLog) String() string { return proto.CompactTextString(m) }
+func (*ServiceEventLog) ProtoMessage()    {}
+func (*ServiceEventLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{26}
+}
+
+func (m *ServiceEventLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceEventLog.Unmarshal(m, b)
+}
+
+func (m *ServiceEventLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceEventLog.Marshal(b, m, deterministic)
+}
+
+func (m *ServiceEventLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceEventLog.Merge(m, src)
+}
+
+func (m *ServiceEventLog) XXX_Size() int {
+	return xxx_messageInfo_ServiceEventLog.Size(m)
+}
+
+func (m *ServiceEventLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceEventLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceEventLog proto.InternalMessageInfo
+
+func (m *ServiceEventLog) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ServiceEventLog) GetEvents() *ServiceEvents {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *ServiceEventLog) GetTimings() *ServiceTimings {
+	if m != nil {
+		return m.Timings
+	}
+	return nil
+}
+
+// ServiceTimings are derived from the last start of the service.
+type ServiceTimings struct {
+	// Time spent waiting for the dependencies and conditions.
+	Waiting *duration.Duration `protobuf:"bytes,1,opt,name=waiting,proto3" json:"waiting,omitempty"`
+	// Time from the service start to the running state.
+	ToRunning *duration.Duration `protobuf:"bytes,2,opt,name=to_running,json=toRunning,proto3" json:"to_running,omitempty"`
+	// Time from the service start to the first successful health check.
+	ToHealthy            *duration.Duration `protobuf:"bytes,3,opt,name=to_healthy,json=toHealthy,proto3" json:"to_healthy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ServiceTimings) Reset()         { *m = ServiceTimings{} }
+func (m *ServiceTimings) String() string { return proto.CompactTextString(m) }
+func (*ServiceTimings) ProtoMessage()    {}
+func (*ServiceTimings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{27}
+}
+
+func (m *ServiceTimings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceTimings.Unmarshal(m, b)
+}
+
+func (m *ServiceTimings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceTimings.Marshal(b, m, deterministic)
+}
+
+func (m *ServiceTimings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceTimings.Merge(m, src)
+}
+
+func (m *ServiceTimings) XXX_Size() int {
+	return xxx_messageInfo_ServiceTimings.Size(m)
+}
+
+func (m *ServiceTimings) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceTimings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceTimings proto.InternalMessageInfo
+
+func (m *ServiceTimings) GetWaiting() *duration.Duration {
+	if m != nil {
+		return m.Waiting
+	}
+	return nil
+}
+
+func (m *ServiceTimings) GetToRunning() *duration.Duration {
+	if m != nil {
+		return m.ToRunning
+	}
+	return nil
+}
+
+func (m *ServiceTimings) GetToHealthy() *duration.Duration {
+	if m != nil {
+		return m.ToHealthy
+	}
+	return nil
+}
+
+// rpc servicestart
+type ServiceStartRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceStartRequest) Reset()         { *m = ServiceStartRequest{} }
+func (m *ServiceStartRequest) String() string { return proto.CompactTextString(m) }
+func (*ServiceStartRequest) ProtoMessage()    {}
+func (*ServiceStartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{28}
+}
+
+func (m *ServiceStartRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceStartRequest.Unmarshal(m, b)
+}
+
+func (m *ServiceStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceStartRequest.Marshal(b, m, deterministic)
+}
+
+func (m *ServiceStartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceStartRequest.Merge(m, src)
+}
+
+func (m *ServiceStartRequest) XXX_Size() int {
+	return xxx_messageInfo_ServiceStartRequest.Size(m)
+}
+
+func (m *ServiceStartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceStartRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceStartRequest proto.InternalMessageInfo
+
+func (m *ServiceStartRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ServiceStart struct {
+	Metadata             *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Resp                 string           `protobuf:"bytes,2,opt,name=resp,proto3" json:"resp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ServiceStart) Reset()         { *m = ServiceStart{} }
+func (m *ServiceStart) String() string { return proto.CompactTextString(m) }
+func (*ServiceStart) ProtoMessage()    {}
+func (*ServiceStart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{29}
+}
+
+func (m *ServiceStart) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceStart.Unmarshal(m, b)
+}
+
+func (m *ServiceStart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceStart.Marshal(b, m, deterministic)
 }
 
 func (m *ServiceStart) XXX_Merge(src proto.Message) {
@@ -919,7 +1782,7 @@ func (m *ServiceStartResponse) Reset()         { *m = ServiceStartResponse{} }
 func (m *ServiceStartResponse) String() string { return proto.CompactTextString(m) }
 func (*ServiceStartResponse) ProtoMessage()    {}
 func (*ServiceStartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{18}
+	return fileDescriptor_84b4f59d98cc997c, []int{30}
 }
 
 func (m *ServiceStartResponse) XXX_Unmarshal(b []byte) error {
@@ -962,7 +1825,7 @@ func (m *ServiceStopRequest) Reset()         { *m = ServiceStopRequest{} }
 func (m *ServiceStopRequest) String() string { return proto.CompactTextString(m) }
 func (*ServiceStopRequest) ProtoMessage()    {}
 func (*ServiceStopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{19}
+	return fileDescriptor_84b4f59d98cc997c, []int{31}
 }
 
 func (m *ServiceStopRequest) XXX_Unmarshal(b []byte) error {
@@ -1006,7 +1869,7 @@ func (m *ServiceStop) Reset()         { *m = ServiceStop{} }
 func (m *ServiceStop) String() string { return proto.CompactTextString(m) }
 func (*ServiceStop) ProtoMessage()    {}
 func (*ServiceStop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{20}
+	return fileDescriptor_84b4f59d98cc997c, []int{32}
 }
 
 func (m *ServiceStop) XXX_Unmarshal(b []byte) error {
@@ -1056,7 +1919,7 @@ func (m *ServiceStopResponse) Reset()         { *m = ServiceStopResponse{} }
 func (m *ServiceStopResponse) String() string { return proto.CompactTextString(m) }
 func (*ServiceStopResponse) ProtoMessage()    {}
 func (*ServiceStopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{21}
+	return fileDescriptor_84b4f59d98cc997c, []int{33}
 }
 
 func (m *ServiceStopResponse) XXX_Unmarshal(b []byte) error {
@@ -1099,7 +1962,7 @@ func (m *ServiceRestartRequest) Reset()         { *m = ServiceRestartRequest{} }
 func (m *ServiceRestartRequest) String() string { return proto.CompactTextString(m) }
 func (*ServiceRestartRequest) ProtoMessage()    {}
 func (*ServiceRestartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{22}
+	return fileDescriptor_84b4f59d98cc997c, []int{34}
 }
 
 func (m *ServiceRestartRequest) XXX_Unmarshal(b []byte) error {
@@ -1143,7 +2006,7 @@ func (m *ServiceRestart) Reset()         { *m = ServiceRestart{} }
 func (m *ServiceRestart) String() string { return proto.CompactTextString(m) }
 func (*ServiceRestart) ProtoMessage()    {}
 func (*ServiceRestart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{23}
+	return fileDescriptor_84b4f59d98cc997c, []int{35}
 }
 
 func (m *ServiceRestart) XXX_Unmarshal(b []byte) error {
@@ -1193,7 +2056,7 @@ func (m *ServiceRestartResponse) Reset()         { *m = ServiceRestartResponse{}
 func (m *ServiceRestartResponse) String() string { return proto.CompactTextString(m) }
 func (*ServiceRestartResponse) ProtoMessage()    {}
 func (*ServiceRestartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{24}
+	return fileDescriptor_84b4f59d98cc997c, []int{36}
 }
 
 func (m *ServiceRestartResponse) XXX_Unmarshal(b []byte) error {
@@ -1237,7 +2100,7 @@ func (m *StartRequest) Reset()         { *m = StartRequest{} }
 func (m *StartRequest) String() string { return proto.CompactTextString(m) }
 func (*StartRequest) ProtoMessage()    {}
 func (*StartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{25}
+	return fileDescriptor_84b4f59d98cc997c, []int{37}
 }
 
 func (m *StartRequest) XXX_Unmarshal(b []byte) error {
@@ -1281,7 +2144,7 @@ func (m *StartResponse) Reset()         { *m = StartResponse{} }
 func (m *StartResponse) String() string { return proto.CompactTextString(m) }
 func (*StartResponse) ProtoMessage()    {}
 func (*StartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{26}
+	return fileDescriptor_84b4f59d98cc997c, []int{38}
 }
 
 func (m *StartResponse) XXX_Unmarshal(b []byte) error {
@@ -1325,7 +2188,7 @@ func (m *StopRequest) Reset()         { *m = StopRequest{} }
 func (m *StopRequest) String() string { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()    {}
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{27}
+	return fileDescriptor_84b4f59d98cc997c, []int{39}
 }
 
 func (m *StopRequest) XXX_Unmarshal(b []byte) error {
@@ -1369,7 +2232,7 @@ func (m *StopResponse) Reset()         { *m = StopResponse{} }
 func (m *StopResponse) String() string { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()    {}
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{28}
+	return fileDescriptor_84b4f59d98cc997c, []int{40}
 }
 
 func (m *StopResponse) XXX_Unmarshal(b []byte) error {
@@ -1416,7 +2279,7 @@ func (m *CopyRequest) Reset()         { *m = CopyRequest{} }
 func (m *CopyRequest) String() string { return proto.CompactTextString(m) }
 func (*CopyRequest) ProtoMessage()    {}
 func (*CopyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{29}
+	return fileDescriptor_84b4f59d98cc997c, []int{41}
 }
 
 func (m *CopyRequest) XXX_Unmarshal(b []byte) error {
@@ -1467,7 +2330,7 @@ func (m *ListRequest) Reset()         { *m = ListRequest{} }
 func (m *ListRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRequest) ProtoMessage()    {}
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{30}
+	return fileDescriptor_84b4f59d98cc997c, []int{42}
 }
 
 func (m *ListRequest) XXX_Unmarshal(b []byte) error {
@@ -1542,7 +2405,7 @@ func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{31}
+	return fileDescriptor_84b4f59d98cc997c, []int{43}
 }
 
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
@@ -1643,7 +2506,7 @@ func (m *Mounts) Reset()         { *m = Mounts{} }
 func (m *Mounts) String() string { return proto.CompactTextString(m) }
 func (*Mounts) ProtoMessage()    {}
 func (*Mounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{32}
+	return fileDescriptor_84b4f59d98cc997c, []int{44}
 }
 
 func (m *Mounts) XXX_Unmarshal(b []byte) error {
@@ -1693,7 +2556,7 @@ func (m *MountsResponse) Reset()         { *m = MountsResponse{} }
 func (m *MountsResponse) String() string { return proto.CompactTextString(m) }
 func (*MountsResponse) ProtoMessage()    {}
 func (*MountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{33}
+	return fileDescriptor_84b4f59d98cc997c, []int{45}
 }
 
 func (m *MountsResponse) XXX_Unmarshal(b []byte) error {
@@ -1725,6 +2588,171 @@ func (m *MountsResponse) GetMessages() []*Mounts {
 	return nil
 }
 
+// rpc growdisk
+type GrowDiskRequest struct {
+	// Device is the extra disk to grow, e.g. /dev/sdb.
+	Device               string   `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrowDiskRequest) Reset()         { *m = GrowDiskRequest{} }
+func (m *GrowDiskRequest) String() string { return proto.CompactTextString(m) }
+func (*GrowDiskRequest) ProtoMessage()    {}
+func (*GrowDiskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{46}
+}
+
+func (m *GrowDiskRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrowDiskRequest.Unmarshal(m, b)
+}
+
+func (m *GrowDiskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrowDiskRequest.Marshal(b, m, deterministic)
+}
+
+func (m *GrowDiskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrowDiskRequest.Merge(m, src)
+}
+
+func (m *GrowDiskRequest) XXX_Size() int {
+	return xxx_messageInfo_GrowDiskRequest.Size(m)
+}
+
+func (m *GrowDiskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrowDiskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrowDiskRequest proto.InternalMessageInfo
+
+func (m *GrowDiskRequest) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+// The grow disk message describes the grown partition.
+type GrowDisk struct {
+	Metadata  *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Partition string           `protobuf:"bytes,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	MountedOn string           `protobuf:"bytes,3,opt,name=mounted_on,json=mountedOn,proto3" json:"mounted_on,omitempty"`
+	// Sizes of the filesystem in bytes.
+	SizeBefore           uint64   `protobuf:"varint,4,opt,name=size_before,json=sizeBefore,proto3" json:"size_before,omitempty"`
+	SizeAfter            uint64   `protobuf:"varint,5,opt,name=size_after,json=sizeAfter,proto3" json:"size_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrowDisk) Reset()         { *m = GrowDisk{} }
+func (m *GrowDisk) String() string { return proto.CompactTextString(m) }
+func (*GrowDisk) ProtoMessage()    {}
+func (*GrowDisk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{47}
+}
+
+func (m *GrowDisk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrowDisk.Unmarshal(m, b)
+}
+
+func (m *GrowDisk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrowDisk.Marshal(b, m, deterministic)
+}
+
+func (m *GrowDisk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrowDisk.Merge(m, src)
+}
+
+func (m *GrowDisk) XXX_Size() int {
+	return xxx_messageInfo_GrowDisk.Size(m)
+}
+
+func (m *GrowDisk) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrowDisk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrowDisk proto.InternalMessageInfo
+
+func (m *GrowDisk) GetMetadata() *common.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *GrowDisk) GetPartition() string {
+	if m != nil {
+		return m.Partition
+	}
+	return ""
+}
+
+func (m *GrowDisk) GetMountedOn() string {
+	if m != nil {
+		return m.MountedOn
+	}
+	return ""
+}
+
+func (m *GrowDisk) GetSizeBefore() uint64 {
+	if m != nil {
+		return m.SizeBefore
+	}
+	return 0
+}
+
+func (m *GrowDisk) GetSizeAfter() uint64 {
+	if m != nil {
+		return m.SizeAfter
+	}
+	return 0
+}
+
+type GrowDiskResponse struct {
+	Messages             []*GrowDisk `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GrowDiskResponse) Reset()         { *m = GrowDiskResponse{} }
+func (m *GrowDiskResponse) String() string { return proto.CompactTextString(m) }
+func (*GrowDiskResponse) ProtoMessage()    {}
+func (*GrowDiskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b4f59d98cc997c, []int{48}
+}
+
+func (m *GrowDiskResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrowDiskResponse.Unmarshal(m, b)
+}
+
+func (m *GrowDiskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrowDiskResponse.Marshal(b, m, deterministic)
+}
+
+func (m *GrowDiskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrowDiskResponse.Merge(m, src)
+}
+
+func (m *GrowDiskResponse) XXX_Size() int {
+	return xxx_messageInfo_GrowDiskResponse.Size(m)
+}
+
+func (m *GrowDiskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrowDiskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrowDiskResponse proto.InternalMessageInfo
+
+func (m *GrowDiskResponse) GetMessages() []*GrowDisk {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 // The messages message containing the requested processes.
 type MountStat struct {
 	Filesystem           string   `protobuf:"bytes,1,opt,name=filesystem,proto3" json:"filesystem,omitempty"`
@@ -1740,7 +2768,7 @@ func (m *MountStat) Reset()         { *m = MountStat{} }
 func (m *MountStat) String() string { return proto.CompactTextString(m) }
 func (*MountStat) ProtoMessage()    {}
 func (*MountStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{34}
+	return fileDescriptor_84b4f59d98cc997c, []int{49}
 }
 
 func (m *MountStat) XXX_Unmarshal(b []byte) error {
@@ -1806,7 +2834,7 @@ func (m *Version) Reset()         { *m = Version{} }
 func (m *Version) String() string { return proto.CompactTextString(m) }
 func (*Version) ProtoMessage()    {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{35}
+	return fileDescriptor_84b4f59d98cc997c, []int{50}
 }
 
 func (m *Version) XXX_Unmarshal(b []byte) error {
@@ -1863,7 +2891,7 @@ func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
 func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
 func (*VersionResponse) ProtoMessage()    {}
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{36}
+	return fileDescriptor_84b4f59d98cc997c, []int{51}
 }
 
 func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
@@ -1911,7 +2939,7 @@ func (m *VersionInfo) Reset()         { *m = VersionInfo{} }
 func (m *VersionInfo) String() string { return proto.CompactTextString(m) }
 func (*VersionInfo) ProtoMessage()    {}
 func (*VersionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{37}
+	return fileDescriptor_84b4f59d98cc997c, []int{52}
 }
 
 func (m *VersionInfo) XXX_Unmarshal(b []byte) error {
@@ -1990,7 +3018,7 @@ func (m *PlatformInfo) Reset()         { *m = PlatformInfo{} }
 func (m *PlatformInfo) String() string { return proto.CompactTextString(m) }
 func (*PlatformInfo) ProtoMessage()    {}
 func (*PlatformInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{38}
+	return fileDescriptor_84b4f59d98cc997c, []int{53}
 }
 
 func (m *PlatformInfo) XXX_Unmarshal(b []byte) error {
@@ -2047,7 +3075,7 @@ func (m *LogsRequest) Reset()         { *m = LogsRequest{} }
 func (m *LogsRequest) String() string { return proto.CompactTextString(m) }
 func (*LogsRequest) ProtoMessage()    {}
 func (*LogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{39}
+	return fileDescriptor_84b4f59d98cc997c, []int{54}
 }
 
 func (m *LogsRequest) XXX_Unmarshal(b []byte) error {
@@ -2118,7 +3146,7 @@ func (m *ReadRequest) Reset()         { *m = ReadRequest{} }
 func (m *ReadRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadRequest) ProtoMessage()    {}
 func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84b4f59d98cc997c, []int{40}
+	return fileDescriptor_84b4f59d98cc997c, []int{55}
 }
 
 func (m *ReadRequest) XXX_Unmarshal(b []byte) error {
@@ -2151,22 +3179,36 @@ func (m *ReadRequest) GetPath() string {
 }
 
 func init() {
+	proto.RegisterEnum("machine.WipeMode", WipeMode_name, WipeMode_value)
+	proto.RegisterEnum("machine.WipeProgress_Stage", WipeProgress_Stage_name, WipeProgress_Stage_value)
 	proto.RegisterType((*Reboot)(nil), "machine.Reboot")
 	proto.RegisterType((*RebootResponse)(nil), "machine.RebootResponse")
 	proto.RegisterType((*ResetRequest)(nil), "machine.ResetRequest")
+	proto.RegisterType((*ResetPartitionSpec)(nil), "machine.ResetPartitionSpec")
 	proto.RegisterType((*Reset)(nil), "machine.Reset")
 	proto.RegisterType((*ResetResponse)(nil), "machine.ResetResponse")
+	proto.RegisterType((*WipeProgress)(nil), "machine.WipeProgress")
 	proto.RegisterType((*Shutdown)(nil), "machine.Shutdown")
 	proto.RegisterType((*ShutdownResponse)(nil), "machine.ShutdownResponse")
 	proto.RegisterType((*UpgradeRequest)(nil), "machine.UpgradeRequest")
 	proto.RegisterType((*Upgrade)(nil), "machine.Upgrade")
 	proto.RegisterType((*UpgradeResponse)(nil), "machine.UpgradeResponse")
+	proto.RegisterType((*ImagePullProgress)(nil), "machine.ImagePullProgress")
 	proto.RegisterType((*ServiceList)(nil), "machine.ServiceList")
 	proto.RegisterType((*ServiceListResponse)(nil), "machine.ServiceListResponse")
 	proto.RegisterType((*ServiceInfo)(nil), "machine.ServiceInfo")
 	proto.RegisterType((*ServiceEvents)(nil), "machine.ServiceEvents")
 	proto.RegisterType((*ServiceEvent)(nil), "machine.ServiceEvent")
 	proto.RegisterType((*ServiceHealth)(nil), "machine.ServiceHealth")
+	proto.RegisterType((*ServiceResources)(nil), "machine.ServiceResources")
+	proto.RegisterType((*ServiceDependencies)(nil), "machine.ServiceDependencies")
+	proto.RegisterType((*ServiceDependenciesResponse)(nil), "machine.ServiceDependenciesResponse")
+	proto.RegisterType((*ServiceDependency)(nil), "machine.ServiceDependency")
+	proto.RegisterType((*ServiceEventsRequest)(nil), "machine.ServiceEventsRequest")
+	proto.RegisterType((*ServiceEventsResponse)(nil), "machine.ServiceEventsResponse")
+	proto.RegisterType((*ServiceEventHistory)(nil), "machine.ServiceEventHistory")
+	proto.RegisterType((*ServiceEventLog)(nil), "machine.ServiceEventLog")
+	proto.RegisterType((*ServiceTimings)(nil), "machine.ServiceTimings")
 	proto.RegisterType((*ServiceStartRequest)(nil), "machine.ServiceStartRequest")
 	proto.RegisterType((*ServiceStart)(nil), "machine.ServiceStart")
 	proto.RegisterType((*ServiceStartResponse)(nil), "machine.ServiceStartResponse")
@@ -2185,6 +3227,9 @@ func init() {
 	proto.RegisterType((*FileInfo)(nil), "machine.FileInfo")
 	proto.RegisterType((*Mounts)(nil), "machine.Mounts")
 	proto.RegisterType((*MountsResponse)(nil), "machine.MountsResponse")
+	proto.RegisterType((*GrowDiskRequest)(nil), "machine.GrowDiskRequest")
+	proto.RegisterType((*GrowDisk)(nil), "machine.GrowDisk")
+	proto.RegisterType((*GrowDiskResponse)(nil), "machine.GrowDiskResponse")
 	proto.RegisterType((*MountStat)(nil), "machine.MountStat")
 	proto.RegisterType((*Version)(nil), "machine.Version")
 	proto.RegisterType((*VersionResponse)(nil), "machine.VersionResponse")
@@ -2197,102 +3242,152 @@ func init() {
 func init() { proto.RegisterFile("machine/machine.proto", fileDescriptor_84b4f59d98cc997c) }
 
 var fileDescriptor_84b4f59d98cc997c = []byte{
-	// 1512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x73, 0xdb, 0xc4,
-	0x17, 0x1f, 0x39, 0x8e, 0x63, 0x1f, 0x27, 0x4e, 0xfe, 0x6a, 0x93, 0xe8, 0x9f, 0xa6, 0x37, 0x01,
-	0x6d, 0x27, 0xb4, 0x4e, 0x9a, 0x42, 0xa7, 0x10, 0x0a, 0xd3, 0x26, 0xa5, 0xed, 0xb4, 0xa1, 0x41,
-	0x01, 0x1e, 0x78, 0x31, 0x6b, 0x6b, 0x2d, 0xef, 0x54, 0xd2, 0x0a, 0xed, 0x3a, 0x9d, 0x30, 0x7c,
-	0x00, 0x86, 0x37, 0x86, 0x37, 0x5e, 0xf9, 0x8e, 0x3c, 0x33, 0x7b, 0x95, 0x62, 0xc5, 0xa5, 0x9e,
-	0xe9, 0x93, 0x77, 0xcf, 0xfe, 0xce, 0xfd, 0xec, 0xd1, 0x59, 0xc3, 0x6a, 0x82, 0x06, 0x23, 0x92,
-	0xe2, 0x6d, 0xfd, 0xdb, 0xcd, 0x72, 0xca, 0xa9, 0xbb, 0xa0, 0xb7, 0x1b, 0x97, 0x22, 0x4a, 0xa3,
-	0x18, 0x6f, 0x4b, 0x72, 0x7f, 0x3c, 0xdc, 0xc6, 0x49, 0xc6, 0x4f, 0x15, 0x6a, 0xe3, 0xea, 0xe4,
-	0x21, 0x27, 0x09, 0x66, 0x1c, 0x25, 0x99, 0x06, 0x5c, 0x18, 0xd0, 0x24, 0xa1, 0xe9, 0xb6, 0xfa,
-	0x51, 0x44, 0xff, 0x3e, 0x34, 0x02, 0xdc, 0xa7, 0x94, 0xbb, 0xb7, 0xa1, 0x99, 0x60, 0x8e, 0x42,
-	0xc4, 0x91, 0xe7, 0x5c, 0x73, 0x6e, 0xb5, 0x77, 0x57, 0xba, 0x1a, 0x7a, 0xa8, 0xe9, 0x81, 0x45,
-	0xf8, 0x0f, 0xa1, 0xa3, 0xf8, 0x02, 0xcc, 0x32, 0x9a, 0x32, 0xec, 0x7e, 0x2c, 0xf8, 0x19, 0x43,
-	0x11, 0x66, 0x9e, 0x73, 0x6d, 0xee, 0x56, 0x7b, 0x77, 0xb9, 0x6b, 0xfc, 0xd0, 0x50, 0x0b, 0xf0,
-	0x1f, 0xc3, 0x62, 0x80, 0x19, 0xe6, 0x01, 0xfe, 0x79, 0x8c, 0x19, 0x77, 0x37, 0xa0, 0x19, 0xe5,
-	0x68, 0x80, 0x87, 0xe3, 0x58, 0x2a, 0x6f, 0x06, 0x76, 0xef, 0xae, 0x41, 0x23, 0x97, 0xfc, 0x5e,
-	0x4d, 0x9e, 0xe8, 0x9d, 0xff, 0x29, 0xcc, 0x4b, 0x19, 0x33, 0x5a, 0xbe, 0x07, 0x4b, 0x5a, 0xb5,
-	0x36, 0x7c, 0xab, 0x62, 0x78, 0xa7, 0x64, 0xb8, 0x40, 0x16, 0x76, 0x3f, 0x80, 0xe6, 0xf1, 0x68,
-	0xcc, 0x43, 0xfa, 0x26, 0x9d, 0x51, 0xed, 0x23, 0x58, 0x31, 0x9c, 0x56, 0xf3, 0x9d, 0x8a, 0xe6,
-	0xff, 0x59, 0xcd, 0x16, 0x5c, 0x28, 0xbf, 0x01, 0x9d, 0xef, 0xb3, 0x28, 0x47, 0x21, 0x36, 0x61,
-	0xbb, 0x08, 0xf3, 0x24, 0x41, 0x11, 0x96, 0xfa, 0x5b, 0x81, 0xda, 0xf8, 0xcf, 0x61, 0x41, 0xe3,
-	0x66, 0xb3, 0xd1, 0x5d, 0x81, 0x39, 0x34, 0x78, 0x2d, 0xc3, 0xdc, 0x0a, 0xc4, 0xd2, 0xff, 0x0a,
-	0x96, 0xad, 0x4a, 0x6d, 0xf4, 0xed, 0x8a, 0xd1, 0x2b, 0xd6, 0x68, 0x83, 0x2d, 0x6c, 0x4e, 0xa0,
-	0x7d, 0x8c, 0xf3, 0x13, 0x32, 0xc0, 0x2f, 0x09, 0x9b, 0x31, 0x55, 0xee, 0x0e, 0x34, 0x99, 0x62,
-	0x66, 0x5e, 0x4d, 0xaa, 0xba, 0x58, 0xc4, 0x47, 0x1d, 0x3c, 0x4f, 0x87, 0x34, 0xb0, 0x28, 0xff,
-	0x29, 0x5c, 0x28, 0xa9, 0xb3, 0x36, 0xef, 0x54, 0x6c, 0xae, 0x08, 0x92, 0xf8, 0xc2, 0xee, 0x3f,
-	0x1d, 0x6b, 0xb8, 0x50, 0xe1, 0x76, 0xa0, 0x46, 0x42, 0x1d, 0xe6, 0x1a, 0x09, 0x45, 0xe4, 0x19,
-	0x47, 0x1c, 0xeb, 0x60, 0xa9, 0x8d, 0xdb, 0x85, 0x06, 0x3e, 0xc1, 0x29, 0x67, 0xde, 0x9c, 0x74,
-	0x6e, 0x6d, 0x52, 0xcb, 0x13, 0x79, 0x1a, 0x68, 0x94, 0xc0, 0x8f, 0x30, 0x8a, 0xf9, 0xc8, 0xab,
-	0x9f, 0x8f, 0x7f, 0x26, 0x4f, 0x03, 0x8d, 0xf2, 0xbf, 0x84, 0xa5, 0x33, 0x82, 0xdc, 0x3b, 0x56,
-	0xa1, 0x72, 0x6b, 0xf5, 0x5c, 0x85, 0x46, 0x9f, 0xdf, 0x87, 0xc5, 0x32, 0x5d, 0x24, 0x3c, 0x61,
-	0x91, 0x76, 0x4b, 0x2c, 0xa7, 0xf8, 0xb5, 0x05, 0x35, 0xeb, 0xd3, 0x46, 0x57, 0x35, 0x9a, 0xae,
-	0x69, 0x34, 0xdd, 0xef, 0x4c, 0xa3, 0x09, 0x6a, 0x9c, 0xf9, 0x7f, 0x3b, 0xd6, 0x48, 0x65, 0xbd,
-	0xeb, 0xc1, 0xc2, 0x38, 0x7d, 0x9d, 0xd2, 0x37, 0xa9, 0xbe, 0xdb, 0x66, 0x2b, 0x4e, 0x94, 0x67,
-	0xa7, 0xfa, 0x6e, 0x9b, 0xad, 0x7b, 0x1d, 0x16, 0x63, 0xc4, 0x78, 0x4f, 0x27, 0x44, 0xea, 0x6e,
-	0x05, 0x6d, 0x41, 0x3b, 0x54, 0x24, 0x77, 0x0f, 0xe4, 0xb6, 0x37, 0x18, 0xa1, 0x34, 0xc2, 0x3a,
-	0x82, 0x6f, 0xb3, 0x0e, 0x04, 0x7c, 0x5f, 0xa2, 0xfd, 0x8f, 0x6c, 0xa1, 0x1c, 0x73, 0x94, 0xdb,
-	0x3e, 0x34, 0x91, 0x66, 0xff, 0xc8, 0x06, 0x4c, 0xc2, 0x66, 0xac, 0x5f, 0x17, 0xea, 0x39, 0x66,
-	0x99, 0x8e, 0xa5, 0x5c, 0xfb, 0xcf, 0xe1, 0xe2, 0x59, 0xc5, 0xba, 0x44, 0xef, 0x56, 0x4a, 0xb4,
-	0x92, 0x4b, 0xc5, 0x50, 0xd4, 0xe8, 0x87, 0xe0, 0xda, 0x13, 0x9a, 0x4d, 0x73, 0xe1, 0x95, 0x2d,
-	0x64, 0x81, 0x7a, 0x0f, 0x1e, 0x3c, 0x2d, 0x85, 0x4e, 0xa8, 0x7d, 0xf7, 0x3b, 0x26, 0xf1, 0x85,
-	0xfd, 0x37, 0x61, 0x55, 0x1f, 0x04, 0x22, 0x43, 0xd3, 0xb3, 0x10, 0x40, 0xe7, 0x2c, 0xf0, 0x3d,
-	0x78, 0x71, 0x08, 0x6b, 0x93, 0xca, 0xb5, 0x23, 0xf7, 0x2a, 0x8e, 0xac, 0x4f, 0x3a, 0x62, 0x58,
-	0x0a, 0x5f, 0x7c, 0x58, 0x7c, 0x5b, 0x21, 0x7d, 0x5e, 0xf3, 0x1c, 0xff, 0x26, 0x2c, 0x9d, 0xcd,
-	0xb9, 0xb1, 0xcb, 0x29, 0xec, 0x92, 0xc0, 0xeb, 0xd0, 0x7e, 0x4b, 0x46, 0x25, 0xe4, 0x86, 0xd0,
-	0x57, 0x8a, 0xfe, 0x34, 0x51, 0x5b, 0xd0, 0xde, 0xa7, 0xd9, 0xa9, 0x11, 0x75, 0x09, 0x5a, 0x39,
-	0xa5, 0xbc, 0x97, 0x21, 0x3e, 0xd2, 0xd8, 0xa6, 0x20, 0x1c, 0x21, 0x3e, 0xf2, 0x43, 0x68, 0xab,
-	0xae, 0xa9, 0xb0, 0x42, 0xa4, 0xf8, 0xea, 0x1a, 0x91, 0x62, 0x48, 0xf0, 0x60, 0x21, 0xc7, 0x83,
-	0x71, 0xce, 0xb0, 0xb9, 0xb0, 0x7a, 0xeb, 0xde, 0x84, 0x65, 0xb5, 0x24, 0x34, 0xed, 0x85, 0x38,
-	0xe3, 0x23, 0x79, 0x67, 0xe7, 0x83, 0x8e, 0x25, 0x1f, 0x08, 0xaa, 0xff, 0x8f, 0x03, 0xcd, 0xaf,
-	0x49, 0xac, 0xda, 0xea, 0xcc, 0x79, 0x4c, 0x51, 0x62, 0x7a, 0x93, 0x5c, 0x0b, 0x1a, 0x23, 0xbf,
-	0xa8, 0x06, 0x31, 0x17, 0xc8, 0xb5, 0xa0, 0x25, 0x34, 0x54, 0x2d, 0x61, 0x29, 0x90, 0x6b, 0x31,
-	0x61, 0x24, 0x34, 0x24, 0x43, 0x82, 0x43, 0x6f, 0x5e, 0x62, 0xed, 0xde, 0x5d, 0x85, 0x06, 0x61,
-	0xbd, 0x90, 0xe4, 0x5e, 0x43, 0x3a, 0x35, 0x4f, 0xd8, 0x01, 0xc9, 0x45, 0x2f, 0xc4, 0x79, 0x4e,
-	0x73, 0x6f, 0x41, 0xf5, 0x42, 0xb9, 0x11, 0xc2, 0x63, 0x92, 0xbe, 0xf6, 0x9a, 0xca, 0x08, 0xb1,
-	0x76, 0x3f, 0x80, 0xa5, 0x1c, 0xc7, 0x88, 0x93, 0x13, 0xdc, 0x93, 0x16, 0xb6, 0xe4, 0xe1, 0xa2,
-	0x21, 0x7e, 0x83, 0x12, 0xec, 0xff, 0x04, 0x8d, 0x43, 0x3a, 0x16, 0x5d, 0x7b, 0x36, 0xaf, 0x6f,
-	0xa9, 0x96, 0x6c, 0x3e, 0x81, 0xae, 0x2d, 0x46, 0x29, 0xed, 0x98, 0x23, 0xae, 0xda, 0x34, 0x13,
-	0x43, 0x99, 0xd2, 0xf0, 0x4e, 0x43, 0x99, 0x86, 0x16, 0x35, 0xfc, 0x2b, 0xb4, 0xac, 0x48, 0xf7,
-	0x0a, 0xc0, 0x90, 0xc4, 0x98, 0x9d, 0x32, 0x8e, 0x13, 0x5d, 0x03, 0x25, 0x8a, 0x8d, 0xbb, 0xc8,
-	0x45, 0x5d, 0xc7, 0x7d, 0x13, 0x5a, 0xe8, 0x04, 0x91, 0x18, 0xf5, 0x63, 0x95, 0x90, 0x7a, 0x50,
-	0x10, 0xdc, 0xcb, 0x00, 0x89, 0x10, 0x8f, 0xc3, 0x1e, 0x4d, 0x65, 0x6e, 0x5a, 0x41, 0x4b, 0x53,
-	0x5e, 0xa5, 0xfe, 0x5f, 0x0e, 0x2c, 0xfc, 0x80, 0x65, 0xa1, 0xcc, 0x18, 0xa0, 0x2e, 0x2c, 0x9c,
-	0x28, 0x46, 0x69, 0x4d, 0xb9, 0xf1, 0x68, 0x81, 0x72, 0x4a, 0x30, 0x20, 0xd1, 0x6a, 0xb3, 0x18,
-	0xf1, 0x21, 0xcd, 0x13, 0xfd, 0x4d, 0x2b, 0x5a, 0xed, 0x91, 0x3e, 0x50, 0x73, 0x85, 0x81, 0x89,
-	0x39, 0x48, 0x8b, 0x7a, 0xa7, 0x39, 0xc8, 0x60, 0x8b, 0xd8, 0xfe, 0xee, 0x40, 0xbb, 0x64, 0x8c,
-	0xf8, 0xf2, 0x72, 0x64, 0xbf, 0xbc, 0x1c, 0x45, 0x82, 0xc2, 0x46, 0xc8, 0x0c, 0x5f, 0x6c, 0x84,
-	0x44, 0xfd, 0xf5, 0xc7, 0x24, 0xe6, 0xfa, 0xe3, 0xa7, 0x36, 0x22, 0x8c, 0x11, 0xed, 0x19, 0x87,
-	0x75, 0x18, 0x23, 0x6a, 0x42, 0xd7, 0x81, 0x1a, 0x65, 0xb2, 0xc2, 0x5b, 0x41, 0x8d, 0x32, 0x91,
-	0x27, 0x94, 0x0f, 0x46, 0xb2, 0xb2, 0x5b, 0x81, 0x5c, 0xfb, 0xf7, 0x61, 0xb1, 0xec, 0xa7, 0xbd,
-	0x57, 0xce, 0xd9, 0x7b, 0x25, 0xef, 0x90, 0xbe, 0x6b, 0x62, 0x2d, 0x3e, 0xed, 0xed, 0x97, 0x34,
-	0x62, 0xa6, 0x43, 0x6c, 0x42, 0x4b, 0x60, 0x59, 0x86, 0x06, 0x86, 0xb9, 0x20, 0xe8, 0xb6, 0x55,
-	0xb3, 0x23, 0xd3, 0x36, 0x34, 0xc2, 0x9c, 0x9c, 0xe0, 0x5c, 0xfa, 0xd3, 0xd9, 0x5d, 0x37, 0x29,
-	0xdd, 0xa7, 0x29, 0x47, 0x24, 0xc5, 0xf9, 0x81, 0x3c, 0x0e, 0x34, 0x4c, 0x0c, 0xfe, 0x43, 0x1a,
-	0xc7, 0xf4, 0x8d, 0xf4, 0xb2, 0x19, 0xe8, 0x9d, 0x88, 0x00, 0x47, 0x24, 0xee, 0xc5, 0x24, 0xc5,
-	0xca, 0xd5, 0xf9, 0xa0, 0x25, 0x28, 0x2f, 0x05, 0x41, 0x74, 0xcf, 0x00, 0xa3, 0xb0, 0xd4, 0xc6,
-	0x4a, 0xdd, 0x4e, 0xae, 0x77, 0xff, 0x68, 0x42, 0xe7, 0x50, 0xe5, 0x4a, 0x77, 0x74, 0xf7, 0x36,
-	0xd4, 0x45, 0xa3, 0x74, 0x8b, 0xda, 0x29, 0xf5, 0xcd, 0x8d, 0x45, 0x63, 0xeb, 0x01, 0xe2, 0x68,
-	0xc7, 0x71, 0x3f, 0x01, 0x78, 0x31, 0xee, 0xe3, 0x01, 0x4d, 0x87, 0x24, 0x72, 0xd7, 0x2a, 0x43,
-	0xc7, 0x13, 0xf1, 0x30, 0xab, 0x70, 0xdd, 0x85, 0xba, 0x9c, 0x82, 0x0b, 0x1d, 0xa5, 0x7e, 0xbb,
-	0x51, 0xcc, 0xfe, 0xa6, 0x3d, 0xee, 0x38, 0xc2, 0x2c, 0x11, 0xf1, 0x32, 0x4b, 0x91, 0x80, 0x8a,
-	0x82, 0xcf, 0x6c, 0x8b, 0x99, 0x66, 0xd2, 0xfa, 0xe4, 0xf5, 0x2f, 0xca, 0xb9, 0x2e, 0xa2, 0x56,
-	0x52, 0x54, 0x0a, 0xe2, 0x79, 0x8a, 0xf4, 0xb3, 0xf1, 0xbf, 0x15, 0x4d, 0xbc, 0x13, 0xef, 0x9b,
-	0x67, 0xdb, 0xea, 0xc4, 0x2b, 0x4b, 0xab, 0x5a, 0x9b, 0x24, 0x6b, 0xbe, 0xfd, 0xb3, 0x2f, 0x89,
-	0x69, 0x7a, 0x37, 0xcf, 0x1d, 0xec, 0x8d, 0x90, 0x6f, 0x2b, 0x93, 0xc4, 0x95, 0x69, 0xdf, 0x76,
-	0x6d, 0xce, 0xd5, 0xa9, 0xe7, 0x5a, 0xe4, 0x8b, 0x89, 0x11, 0x71, 0xf3, 0xfc, 0xb1, 0x4d, 0x8b,
-	0xbb, 0x3c, 0xe5, 0x54, 0x0b, 0x7b, 0x76, 0x76, 0x58, 0xbb, 0x74, 0xee, 0x04, 0xa5, 0x45, 0x6d,
-	0x9e, 0x7f, 0xa8, 0x25, 0x3d, 0x2c, 0xbd, 0x54, 0xa7, 0xc5, 0xea, 0xff, 0xd5, 0xd7, 0xa6, 0x61,
-	0xff, 0xa2, 0x78, 0x43, 0xae, 0x57, 0x9e, 0x77, 0xda, 0x00, 0xaf, 0x7a, 0xa0, 0xb9, 0xf7, 0x60,
-	0x5e, 0x05, 0xa3, 0x34, 0xc3, 0x96, 0xa3, 0xb0, 0x36, 0x49, 0x56, 0x7c, 0xfe, 0xdc, 0x6f, 0x35,
-	0xc7, 0x7d, 0x00, 0x75, 0xe9, 0x7c, 0x69, 0x7c, 0x2c, 0x79, 0xbd, 0x3a, 0x41, 0x2d, 0x73, 0xee,
-	0x15, 0x5f, 0x90, 0x69, 0x2e, 0x7b, 0x95, 0x1e, 0xad, 0x25, 0x3c, 0x7e, 0x01, 0xcb, 0x03, 0x9a,
-	0xd8, 0x63, 0x94, 0x91, 0xc7, 0xa0, 0x7b, 0xc4, 0xa3, 0x8c, 0x1c, 0x39, 0x3f, 0x6e, 0x45, 0x84,
-	0x8f, 0xc6, 0x7d, 0x71, 0x13, 0xb6, 0x39, 0x8a, 0x29, 0xbb, 0xa3, 0x3e, 0x85, 0x4c, 0xed, 0xb6,
-	0x51, 0x46, 0xcc, 0x1f, 0x37, 0xfd, 0x86, 0x54, 0x7b, 0xef, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe4, 0xa0, 0x75, 0xdb, 0xd2, 0x11, 0x00, 0x00,
+	// 2305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x39, 0x4f, 0x73, 0x13, 0xc9,
+	0xf5, 0x3b, 0x92, 0x2c, 0x4b, 0x4f, 0xb6, 0xac, 0x6d, 0xb0, 0x11, 0xb6, 0x61, 0xd9, 0xf9, 0xed,
+	0x2e, 0xfc, 0x08, 0xd8, 0xc6, 0x6c, 0x08, 0x09, 0xd9, 0x50, 0x60, 0x19, 0x70, 0x81, 0xc1, 0x3b,
+	0x82, 0xa5, 0xb2, 0x17, 0xa5, 0x25, 0xb5, 0xa4, 0x2e, 0x66, 0xa6, 0x27, 0x33, 0x2d, 0x53, 0x4a,
+	0x72, 0xcc, 0x21, 0x95, 0xaa, 0xe4, 0x03, 0xe4, 0x9a, 0xcf, 0xb0, 0x87, 0x5c, 0x52, 0x95, 0x7b,
+	0x3e, 0x4f, 0xce, 0xa9, 0xfe, 0x37, 0x33, 0x9a, 0x91, 0x40, 0x4a, 0xed, 0x49, 0xd3, 0xef, 0x7f,
+	0xbf, 0xf7, 0xba, 0x5f, 0xbf, 0x27, 0xd8, 0xf4, 0x70, 0x6f, 0x44, 0x7d, 0xb2, 0xaf, 0x7f, 0xf7,
+	0x82, 0x90, 0x71, 0x86, 0x56, 0xf5, 0x72, 0xfb, 0xea, 0x90, 0xb1, 0xa1, 0x4b, 0xf6, 0x25, 0xb8,
+	0x3b, 0x1e, 0xec, 0xf7, 0xc7, 0x21, 0xe6, 0x94, 0xf9, 0x8a, 0x70, 0x7b, 0x27, 0x8b, 0x27, 0x5e,
+	0xc0, 0x27, 0x1a, 0xf9, 0x59, 0x16, 0xc9, 0xa9, 0x47, 0x22, 0x8e, 0xbd, 0x40, 0x13, 0x5c, 0xe8,
+	0x31, 0xcf, 0x63, 0xfe, 0xbe, 0xfa, 0x51, 0x40, 0xfb, 0x1e, 0x94, 0x1d, 0xd2, 0x65, 0x8c, 0xa3,
+	0x5b, 0x50, 0xf1, 0x08, 0xc7, 0x7d, 0xcc, 0x71, 0xd3, 0xba, 0x66, 0xdd, 0xa8, 0x1d, 0x36, 0xf6,
+	0x34, 0xe9, 0xa9, 0x86, 0x3b, 0x31, 0x85, 0xfd, 0x0d, 0xd4, 0x15, 0x9f, 0x43, 0xa2, 0x80, 0xf9,
+	0x11, 0x41, 0x3f, 0x11, 0xfc, 0x51, 0x84, 0x87, 0x24, 0x6a, 0x5a, 0xd7, 0x8a, 0x37, 0x6a, 0x87,
+	0x1b, 0x7b, 0x66, 0x9f, 0x9a, 0x34, 0x26, 0xb0, 0xff, 0x69, 0xc1, 0x9a, 0x43, 0x22, 0xc2, 0x1d,
+	0xf2, 0xdb, 0x31, 0x89, 0x38, 0xda, 0x86, 0xca, 0x30, 0xc4, 0x3d, 0x32, 0x18, 0xbb, 0x52, 0x7b,
+	0xc5, 0x89, 0xd7, 0x68, 0x0b, 0xca, 0xa1, 0x14, 0xd0, 0x2c, 0x48, 0x8c, 0x5e, 0xa1, 0x2f, 0xa1,
+	0xe4, 0xb1, 0x3e, 0x69, 0x16, 0xaf, 0x59, 0x37, 0xea, 0x87, 0x9f, 0xc6, 0xda, 0xde, 0xd2, 0x80,
+	0x9c, 0xb2, 0x3e, 0x71, 0x24, 0x1a, 0x3d, 0x00, 0x08, 0x70, 0xc8, 0xa9, 0x70, 0x64, 0xd4, 0x2c,
+	0x49, 0xd3, 0x76, 0x52, 0xa6, 0x45, 0x84, 0x9f, 0x19, 0x7c, 0x3b, 0x20, 0x3d, 0x27, 0x45, 0x2e,
+	0x74, 0x9f, 0x93, 0x90, 0x0e, 0x26, 0xcd, 0x15, 0xa5, 0x5b, 0xad, 0x6c, 0x02, 0x28, 0xcf, 0x89,
+	0x2e, 0xc2, 0x8a, 0x8b, 0xbb, 0x44, 0x6d, 0xa1, 0xea, 0xa8, 0x05, 0x42, 0x50, 0x7a, 0x47, 0x48,
+	0xa0, 0xad, 0x97, 0xdf, 0x0b, 0xda, 0x6e, 0xff, 0x14, 0x56, 0xa4, 0x9a, 0x25, 0xa3, 0xf3, 0x00,
+	0xd6, 0xb5, 0x77, 0x75, 0x70, 0x6e, 0xe6, 0x82, 0x53, 0x9f, 0xf6, 0x40, 0x2a, 0x36, 0x3f, 0x14,
+	0x60, 0x4d, 0x98, 0x71, 0x16, 0xb2, 0x61, 0x48, 0xa2, 0x68, 0x39, 0xdd, 0xe8, 0x0e, 0xac, 0x44,
+	0x1c, 0x0f, 0x89, 0xdc, 0x6e, 0x3d, 0xe5, 0xe9, 0xb4, 0xcc, 0xbd, 0xb6, 0x20, 0x71, 0x14, 0x65,
+	0xe2, 0xb6, 0x62, 0xda, 0x6d, 0xc6, 0x45, 0xa5, 0x0f, 0x87, 0xf7, 0x0a, 0x40, 0x77, 0xc2, 0x49,
+	0xd4, 0xe9, 0x33, 0x9f, 0xc8, 0x28, 0x95, 0x9c, 0xaa, 0x84, 0xb4, 0x98, 0x4f, 0xd0, 0x67, 0x50,
+	0x53, 0x68, 0xce, 0x38, 0x76, 0x9b, 0x65, 0x89, 0x57, 0x1c, 0xaf, 0x05, 0x44, 0x28, 0x27, 0x61,
+	0xc8, 0xc2, 0xe6, 0xaa, 0x52, 0x2e, 0x17, 0xf6, 0x1d, 0x58, 0x91, 0x26, 0xa2, 0x0a, 0x94, 0xde,
+	0x9e, 0x9c, 0x1d, 0x37, 0x3e, 0x41, 0x00, 0xe5, 0xef, 0x8e, 0x9d, 0x93, 0x27, 0xbf, 0x6e, 0x58,
+	0x02, 0xfa, 0xfc, 0xf8, 0xf8, 0xac, 0x51, 0x10, 0x5f, 0xad, 0x57, 0x2f, 0x8f, 0x1b, 0x45, 0xfb,
+	0x3e, 0x54, 0xda, 0xa3, 0x31, 0xef, 0xb3, 0xf7, 0xfe, 0x92, 0xe1, 0x7a, 0x04, 0x0d, 0xc3, 0x19,
+	0x47, 0xec, 0x76, 0x2e, 0x62, 0x89, 0x07, 0x62, 0xe2, 0x24, 0x68, 0x5f, 0x41, 0xfd, 0x4d, 0x30,
+	0x0c, 0x71, 0x9f, 0x98, 0x13, 0x75, 0x11, 0x56, 0xa8, 0x27, 0xe2, 0xa0, 0x73, 0x51, 0x2e, 0xec,
+	0x13, 0x58, 0xd5, 0x74, 0x4b, 0x86, 0xb5, 0x01, 0x45, 0xdc, 0x7b, 0x27, 0x83, 0x5a, 0x75, 0xc4,
+	0xa7, 0xfd, 0x10, 0x36, 0x62, 0x95, 0xda, 0xe8, 0x5b, 0x39, 0xa3, 0x1b, 0xb1, 0xd1, 0x86, 0x36,
+	0xb1, 0xf9, 0x5f, 0x16, 0x7c, 0x7a, 0x22, 0xac, 0x3a, 0x1b, 0xbb, 0xee, 0xff, 0x98, 0x6d, 0xf1,
+	0x2e, 0x0b, 0xa9, 0x5d, 0x66, 0x72, 0xa2, 0xf8, 0x91, 0x9c, 0x28, 0xe5, 0x72, 0x02, 0x41, 0x29,
+	0xce, 0xa6, 0x8a, 0x23, 0xbf, 0x93, 0x3c, 0x29, 0xa7, 0xf3, 0xc4, 0x83, 0x5a, 0x9b, 0x84, 0xe7,
+	0xb4, 0x47, 0x5e, 0xd0, 0x68, 0xc9, 0x63, 0x8a, 0x0e, 0xa0, 0x12, 0x29, 0xe6, 0xa8, 0x59, 0x90,
+	0xee, 0xba, 0x98, 0xc4, 0x58, 0x21, 0x4e, 0xfc, 0x01, 0x73, 0x62, 0x2a, 0xfb, 0x29, 0x5c, 0x48,
+	0xa9, 0x8b, 0xfd, 0x7e, 0x90, 0xf3, 0x7b, 0x4e, 0x90, 0xa4, 0x4f, 0x7c, 0xff, 0x6f, 0x0b, 0x6a,
+	0x29, 0x15, 0xa8, 0x0e, 0x05, 0xda, 0xd7, 0xa9, 0x52, 0xa0, 0x7d, 0xb1, 0xdb, 0x88, 0x63, 0x1e,
+	0xfb, 0x55, 0x2e, 0xd0, 0x1e, 0x94, 0xc9, 0x39, 0xf1, 0x79, 0x24, 0x7d, 0x5a, 0x3b, 0xdc, 0xca,
+	0x6a, 0x39, 0x96, 0x58, 0x47, 0x53, 0x09, 0xfa, 0x11, 0xc1, 0x2e, 0x1f, 0x35, 0x4b, 0xb3, 0xe9,
+	0x9f, 0x49, 0xac, 0xa3, 0xa9, 0xd0, 0xcf, 0xa0, 0x1a, 0x92, 0x88, 0x8d, 0x43, 0xe1, 0x91, 0x15,
+	0xc9, 0x72, 0x39, 0xcb, 0xe2, 0x18, 0x02, 0x27, 0xa1, 0xb5, 0x7f, 0x05, 0xeb, 0x53, 0x16, 0xa0,
+	0xdb, 0xb1, 0xa5, 0xca, 0x1f, 0x9b, 0x33, 0x2d, 0x35, 0x86, 0xda, 0x5d, 0x58, 0x4b, 0xc3, 0x45,
+	0xb6, 0x7b, 0xd1, 0x50, 0xfb, 0x43, 0x7c, 0xce, 0x71, 0xc8, 0x4d, 0x28, 0xc4, 0xce, 0xd8, 0xde,
+	0x53, 0x15, 0x78, 0xcf, 0x54, 0xe0, 0xbd, 0xd7, 0xa6, 0x02, 0x3b, 0x05, 0x1e, 0xd9, 0x7f, 0xb7,
+	0x60, 0x7d, 0x6a, 0xdb, 0xa8, 0x09, 0xab, 0x63, 0xff, 0x9d, 0xcf, 0xde, 0xfb, 0xba, 0xe6, 0x99,
+	0xa5, 0xc0, 0x28, 0x97, 0x4c, 0x74, 0xd5, 0x30, 0x4b, 0xf4, 0x39, 0xac, 0xb9, 0x38, 0xe2, 0x1d,
+	0x1d, 0x49, 0x7d, 0x65, 0xd6, 0x04, 0xec, 0x54, 0x81, 0xd0, 0x03, 0x90, 0xcb, 0x4e, 0x6f, 0x84,
+	0xfd, 0x21, 0x69, 0x96, 0x3e, 0x6a, 0x1d, 0x08, 0xf2, 0x23, 0x49, 0x6d, 0xff, 0xc5, 0x82, 0x46,
+	0xd6, 0xd3, 0xa2, 0x0a, 0xf6, 0x86, 0x21, 0x1b, 0x07, 0xda, 0x23, 0x7a, 0x25, 0x8c, 0xf1, 0x88,
+	0xc7, 0xc2, 0x49, 0x67, 0x1c, 0x99, 0x43, 0x58, 0x72, 0x6a, 0x0a, 0xf6, 0x46, 0x1a, 0x93, 0x90,
+	0xb8, 0xd4, 0xa3, 0xbc, 0x59, 0x4c, 0x93, 0xbc, 0x10, 0x20, 0xb4, 0x03, 0xd5, 0x5e, 0x30, 0xd6,
+	0x22, 0xd4, 0x61, 0xac, 0xf4, 0x82, 0xb1, 0xe4, 0xb7, 0x7f, 0x1f, 0x67, 0x7c, 0x8b, 0x04, 0xc4,
+	0xef, 0x13, 0xbf, 0x47, 0xc9, 0xb2, 0xb7, 0xc4, 0xbd, 0xdc, 0x41, 0xdb, 0xce, 0xe6, 0x43, 0x2c,
+	0x7d, 0x92, 0x3a, 0x6e, 0x6f, 0x61, 0x67, 0x86, 0xf2, 0xf8, 0xd8, 0xdd, 0xcf, 0x1d, 0xbb, 0xdd,
+	0xb9, 0x62, 0x05, 0x5f, 0x72, 0xfc, 0xce, 0xe1, 0xd3, 0x9c, 0xde, 0x05, 0xcf, 0xe0, 0x15, 0x80,
+	0xbe, 0xe4, 0x89, 0x3a, 0xcc, 0x6f, 0x16, 0xaf, 0x15, 0x6f, 0x54, 0x9d, 0xaa, 0x86, 0xbc, 0xf2,
+	0xd1, 0x2e, 0x54, 0x7b, 0xcc, 0xef, 0xcb, 0x37, 0x89, 0x74, 0x66, 0xd5, 0x49, 0x00, 0x76, 0x00,
+	0x17, 0xa7, 0x4f, 0xaa, 0x2e, 0x16, 0x33, 0x54, 0xab, 0x70, 0x09, 0xd5, 0x2b, 0x8e, 0x5a, 0xa0,
+	0x43, 0x28, 0x77, 0xc9, 0x80, 0x85, 0x64, 0x81, 0x8c, 0xd7, 0x94, 0xf6, 0xb7, 0xb0, 0x99, 0xd1,
+	0xb8, 0xb8, 0xf3, 0x24, 0xc7, 0x33, 0x1a, 0x71, 0x16, 0x4e, 0x52, 0xce, 0x9b, 0xc0, 0x85, 0x19,
+	0x04, 0x4b, 0xa6, 0xc4, 0xd7, 0xb9, 0x94, 0x68, 0xce, 0x54, 0xff, 0x82, 0x0d, 0x53, 0x09, 0xf1,
+	0x47, 0x0b, 0x36, 0x32, 0xd8, 0x9c, 0xef, 0x92, 0x4b, 0xb2, 0xb0, 0xd0, 0x25, 0x79, 0x07, 0x56,
+	0x39, 0xf5, 0xa8, 0x3f, 0x34, 0x17, 0xc9, 0xa5, 0x2c, 0xc3, 0x6b, 0x85, 0x76, 0x0c, 0x9d, 0xfd,
+	0x0f, 0x0b, 0xea, 0xd3, 0x38, 0x74, 0x17, 0x56, 0xdf, 0x63, 0xca, 0xa9, 0x3f, 0xd4, 0x9b, 0xbf,
+	0x9c, 0x0b, 0x4e, 0x4b, 0x77, 0x13, 0x8e, 0xa1, 0x44, 0xf7, 0x01, 0x38, 0xeb, 0x84, 0x63, 0xdf,
+	0x17, 0x7c, 0x85, 0x8f, 0xf1, 0x55, 0x39, 0x73, 0x14, 0xad, 0xe6, 0x34, 0x77, 0x54, 0x71, 0x01,
+	0x4e, 0x75, 0xe7, 0x4d, 0xec, 0x2f, 0xe3, 0xe8, 0xb5, 0x39, 0x0e, 0xf9, 0x9c, 0x0c, 0xb4, 0xcf,
+	0x60, 0x2d, 0x4d, 0xb6, 0x64, 0x74, 0x11, 0x94, 0x42, 0x12, 0x05, 0xfa, 0xe4, 0xc8, 0x6f, 0xfb,
+	0x04, 0x2e, 0xa6, 0x25, 0xc6, 0x89, 0x78, 0x27, 0x97, 0x88, 0xb9, 0x62, 0xa1, 0x18, 0x92, 0x0c,
+	0xfc, 0x02, 0x50, 0x8c, 0x61, 0xc1, 0xbc, 0x2d, 0xbc, 0x82, 0x5a, 0x8a, 0xea, 0x47, 0xd8, 0xc1,
+	0x53, 0xb8, 0x30, 0xa5, 0x76, 0xf1, 0xea, 0x2f, 0xe9, 0x13, 0xfb, 0xaf, 0xc7, 0x87, 0xd2, 0x21,
+	0xd1, 0x87, 0xa2, 0xe0, 0x40, 0x7d, 0x9a, 0xf0, 0x47, 0xd8, 0xc5, 0x29, 0x6c, 0x65, 0x95, 0xeb,
+	0x8d, 0xdc, 0xcd, 0x6d, 0xe4, 0xd2, 0x8c, 0xea, 0x9f, 0x89, 0x85, 0x0d, 0x6b, 0x1f, 0x4a, 0xa4,
+	0x5f, 0x14, 0x9a, 0x96, 0x7d, 0x1d, 0xd6, 0xa7, 0x63, 0x6e, 0xec, 0xb2, 0x12, 0xbb, 0x24, 0xe1,
+	0xe7, 0x50, 0xfb, 0x40, 0x44, 0x25, 0xc9, 0x57, 0xb0, 0xa6, 0x48, 0x3e, 0x22, 0xea, 0x26, 0xd4,
+	0x8e, 0x58, 0x30, 0x31, 0xa2, 0x76, 0xa0, 0x1a, 0x32, 0xc6, 0x3b, 0x01, 0xe6, 0x23, 0x4d, 0x5b,
+	0x11, 0x80, 0x33, 0xcc, 0x47, 0x76, 0x1f, 0x6a, 0xea, 0x3d, 0xa7, 0x68, 0x85, 0x48, 0xd1, 0xee,
+	0x1a, 0x91, 0xa2, 0xd9, 0x6d, 0xc2, 0x6a, 0x48, 0x7a, 0xe3, 0x30, 0x22, 0xe6, 0x45, 0xa0, 0x97,
+	0xe8, 0x3a, 0x6c, 0xa8, 0x4f, 0xca, 0xfc, 0x4e, 0x9f, 0x04, 0x7c, 0x24, 0xcf, 0xe3, 0x8a, 0x53,
+	0x8f, 0xc1, 0x2d, 0x01, 0xb5, 0xff, 0x63, 0x41, 0xe5, 0x09, 0x75, 0xd5, 0x83, 0x6f, 0xe9, 0x38,
+	0xfa, 0xd8, 0x33, 0x95, 0x48, 0x7e, 0x0b, 0x58, 0x44, 0x7f, 0xa7, 0x6a, 0x41, 0xd1, 0x91, 0xdf,
+	0x02, 0x16, 0xf7, 0x6c, 0xeb, 0xba, 0x41, 0xdb, 0x86, 0x8a, 0xc7, 0xfa, 0x74, 0x40, 0x49, 0x5f,
+	0xbe, 0xe9, 0x8a, 0x4e, 0xbc, 0x46, 0x9b, 0x50, 0xa6, 0x51, 0xa7, 0x4f, 0xd5, 0xab, 0xba, 0xe2,
+	0xac, 0xd0, 0xa8, 0x45, 0xc3, 0xd9, 0x3d, 0x99, 0x10, 0xee, 0x52, 0xff, 0x5d, 0xb3, 0xa2, 0x8c,
+	0x10, 0xdf, 0xe8, 0xff, 0x60, 0x3d, 0x24, 0x2e, 0xe6, 0xf4, 0x9c, 0x74, 0xa4, 0x85, 0x55, 0x89,
+	0x5c, 0x33, 0xc0, 0x97, 0xd8, 0x23, 0xf6, 0x6f, 0xa0, 0x7c, 0xca, 0xc6, 0xe2, 0xae, 0x5d, 0x6e,
+	0xd7, 0x37, 0x54, 0x01, 0x36, 0x05, 0x02, 0xc5, 0xc9, 0x28, 0xa5, 0xb5, 0x39, 0xe6, 0xaa, 0x28,
+	0x47, 0x62, 0x1c, 0xa2, 0x34, 0x2c, 0x34, 0x0e, 0xd1, 0xa4, 0x49, 0x0e, 0xff, 0x3f, 0x6c, 0x3c,
+	0x0d, 0xd9, 0xfb, 0x16, 0x8d, 0xde, 0x99, 0x1c, 0xd8, 0x82, 0x72, 0x9f, 0x88, 0x8c, 0x37, 0x4f,
+	0x2e, 0xb5, 0xb2, 0x7f, 0xb0, 0xa0, 0x62, 0x68, 0x97, 0xdc, 0xce, 0x2e, 0x54, 0xe3, 0xc9, 0x86,
+	0x8e, 0x64, 0x02, 0x10, 0xef, 0x0a, 0x4f, 0xd8, 0x45, 0xfa, 0xea, 0x5d, 0x21, 0xd1, 0x1a, 0xf2,
+	0xca, 0x17, 0x3d, 0x93, 0x88, 0x70, 0x47, 0x3f, 0x00, 0x74, 0xcf, 0x24, 0x40, 0x8f, 0x25, 0x44,
+	0xf0, 0x4b, 0x02, 0x3c, 0xe0, 0x24, 0x34, 0x7d, 0xb8, 0x80, 0x3c, 0x12, 0x00, 0xd1, 0xe3, 0x26,
+	0x5b, 0x5c, 0xa0, 0xc7, 0x8d, 0x89, 0x13, 0x2f, 0xfd, 0x01, 0xaa, 0xb1, 0xe3, 0xd1, 0x55, 0x80,
+	0x01, 0x75, 0x49, 0x34, 0x89, 0x38, 0xf1, 0xb4, 0x8f, 0x52, 0x90, 0x38, 0x3b, 0xd5, 0x93, 0x54,
+	0x7e, 0x0b, 0x07, 0xe0, 0x73, 0x4c, 0x5d, 0xdc, 0x75, 0xe3, 0xae, 0x30, 0x06, 0x64, 0x1c, 0x50,
+	0xca, 0x38, 0xc0, 0xfe, 0x9b, 0x05, 0xab, 0xdf, 0x11, 0x79, 0x9c, 0x96, 0xf4, 0xfb, 0x1e, 0xac,
+	0x9e, 0x2b, 0x46, 0x5d, 0x62, 0x93, 0xeb, 0x59, 0x0b, 0x94, 0x5d, 0x9e, 0x21, 0x12, 0x05, 0x29,
+	0x70, 0x31, 0x1f, 0xb0, 0xd0, 0xd3, 0x95, 0x35, 0x29, 0x48, 0x67, 0x1a, 0x21, 0x39, 0x62, 0x32,
+	0xd1, 0x8b, 0x6b, 0x51, 0x0b, 0xf5, 0xe2, 0x86, 0x36, 0xf1, 0xed, 0x9f, 0x2d, 0xa8, 0xa5, 0x8c,
+	0x11, 0x0d, 0x10, 0xc7, 0x71, 0x03, 0xc4, 0xf1, 0x50, 0x40, 0xa2, 0x11, 0x36, 0x03, 0x80, 0x68,
+	0x24, 0x7b, 0xef, 0xee, 0x98, 0xba, 0xdc, 0x8c, 0x6d, 0xe4, 0x42, 0xb8, 0x71, 0xc8, 0x3a, 0x66,
+	0xc3, 0xda, 0x8d, 0x43, 0x66, 0x5c, 0x57, 0x87, 0x02, 0x53, 0xbd, 0x5d, 0xd5, 0x29, 0xb0, 0x48,
+	0xc4, 0x09, 0x87, 0xbd, 0x91, 0xee, 0xaa, 0xe5, 0xb7, 0x7d, 0x0f, 0xd6, 0xd2, 0xfb, 0x8c, 0x6f,
+	0x1f, 0x6b, 0xfa, 0xf6, 0x91, 0x37, 0x8d, 0xbe, 0x91, 0xc4, 0xb7, 0xe8, 0xb0, 0x6a, 0x2f, 0xd8,
+	0x30, 0x7e, 0xd5, 0xee, 0x42, 0x55, 0xd0, 0x46, 0x01, 0x8e, 0x8f, 0x51, 0x02, 0xd0, 0x97, 0x7b,
+	0x21, 0x7e, 0xb7, 0xed, 0x43, 0xb9, 0x1f, 0xd2, 0x73, 0x12, 0xea, 0xa1, 0xdc, 0x25, 0x13, 0xd2,
+	0x23, 0xe6, 0x73, 0x4c, 0x7d, 0x12, 0xb6, 0x24, 0xda, 0xd1, 0x64, 0xe2, 0x88, 0x0e, 0x98, 0xeb,
+	0xb2, 0xf7, 0x72, 0x97, 0x15, 0x47, 0xaf, 0x84, 0x07, 0x38, 0xa6, 0x6e, 0xc7, 0xa5, 0xbe, 0x6e,
+	0x63, 0x57, 0x9c, 0xaa, 0x80, 0xbc, 0x10, 0x00, 0x51, 0x63, 0x1c, 0x82, 0xfb, 0xa9, 0xcb, 0x3e,
+	0x55, 0x13, 0xe4, 0xf7, 0xcd, 0x87, 0x50, 0x31, 0x53, 0x2e, 0x31, 0x60, 0x7a, 0xf2, 0xa8, 0xfd,
+	0xba, 0xf1, 0x89, 0xf8, 0xfa, 0xfe, 0xd8, 0x79, 0xd5, 0xb0, 0x50, 0x0d, 0x56, 0x5b, 0x27, 0xed,
+	0xa3, 0x47, 0x4e, 0xab, 0x51, 0x40, 0x08, 0xea, 0xed, 0xe3, 0xa3, 0x37, 0xce, 0x71, 0xc7, 0xc0,
+	0x8a, 0x87, 0x7f, 0xad, 0x41, 0xfd, 0x54, 0x05, 0x5b, 0x17, 0x4e, 0x74, 0x0b, 0x4a, 0xa2, 0x1e,
+	0xa1, 0x24, 0xf9, 0x52, 0xe5, 0x69, 0x7b, 0xcd, 0x6c, 0xb6, 0x85, 0x39, 0x3e, 0xb0, 0xd0, 0xc3,
+	0xd4, 0x2d, 0xd3, 0xcc, 0x1f, 0x4a, 0xcd, 0x75, 0x79, 0x06, 0x46, 0xa7, 0xdf, 0xd7, 0x00, 0xcf,
+	0xc7, 0x5d, 0xd2, 0x63, 0xfe, 0x80, 0x0e, 0xd1, 0x56, 0xee, 0x69, 0x78, 0x2c, 0x46, 0xd7, 0x39,
+	0xb5, 0x77, 0xa0, 0x24, 0xe7, 0x28, 0x89, 0x91, 0xa9, 0xba, 0xb8, 0x9d, 0xdc, 0x0e, 0xa6, 0x8c,
+	0x1d, 0x58, 0x62, 0x5f, 0x22, 0xe6, 0x69, 0x96, 0x24, 0x05, 0x72, 0x0a, 0x7e, 0x1e, 0x97, 0x82,
+	0x79, 0x26, 0x5d, 0xca, 0x5e, 0xd3, 0xc9, 0x81, 0x2a, 0x89, 0xb8, 0xa5, 0x14, 0xa5, 0xc2, 0x38,
+	0x4b, 0x91, 0x1e, 0xac, 0x7f, 0x5c, 0x51, 0x66, 0x92, 0x7e, 0xcf, 0x0c, 0x7d, 0x37, 0x33, 0x33,
+	0x5a, 0xad, 0x6a, 0x2b, 0x0b, 0xd6, 0x7c, 0x4f, 0xe0, 0x82, 0x9a, 0x49, 0xeb, 0x51, 0x5a, 0x9b,
+	0x87, 0x04, 0x7b, 0x73, 0xf5, 0x6f, 0xce, 0x9c, 0xcc, 0x1e, 0x58, 0xa8, 0x3d, 0xbb, 0xe5, 0x9e,
+	0x27, 0xe7, 0x8b, 0x0f, 0xf6, 0xbc, 0xc6, 0xb8, 0x97, 0xd9, 0x09, 0xcd, 0x95, 0x39, 0x6d, 0x91,
+	0xde, 0xe4, 0xd5, 0x79, 0x68, 0x2d, 0xef, 0x68, 0x7a, 0xf0, 0x36, 0xcf, 0xb8, 0xdd, 0x99, 0x73,
+	0x30, 0x23, 0xe4, 0xdb, 0xdc, 0xf3, 0xf6, 0xea, 0xbc, 0x07, 0xa7, 0x36, 0xeb, 0xb3, 0xb9, 0x78,
+	0x2d, 0xf2, 0x79, 0xa6, 0x6f, 0xd9, 0x9d, 0xdd, 0x4b, 0x68, 0x71, 0x57, 0xe6, 0x60, 0xb5, 0xb0,
+	0x67, 0xd3, 0x1d, 0xc4, 0xce, 0xcc, 0x67, 0xbd, 0x16, 0xb5, 0x3b, 0x1b, 0xa9, 0x25, 0x7d, 0x93,
+	0x1a, 0x4e, 0xcf, 0xf3, 0xd5, 0xe5, 0xfc, 0x80, 0xd9, 0xb0, 0xff, 0x32, 0x19, 0x1b, 0x5f, 0xca,
+	0x4d, 0x74, 0xb5, 0x01, 0xcd, 0x3c, 0x42, 0x73, 0x9f, 0xc2, 0xa6, 0x06, 0x2d, 0x98, 0x9a, 0xc9,
+	0x74, 0x26, 0x37, 0x1f, 0x3e, 0xb0, 0xd0, 0x03, 0x39, 0x9b, 0x0f, 0xd3, 0xe7, 0x63, 0xca, 0xa9,
+	0x5b, 0x59, 0xb0, 0x32, 0xc3, 0x2e, 0xfe, 0xa9, 0x60, 0xa1, 0xfb, 0x50, 0x92, 0xbe, 0x4c, 0xb5,
+	0x48, 0x29, 0x27, 0x6e, 0x66, 0xa0, 0x69, 0xce, 0x07, 0x49, 0xfd, 0x9f, 0x67, 0x77, 0x33, 0x57,
+	0x61, 0xb5, 0x84, 0xc7, 0xcf, 0x61, 0xa3, 0xc7, 0xbc, 0x18, 0x8d, 0x03, 0xfa, 0x18, 0xf4, 0x05,
+	0xfd, 0x28, 0xa0, 0x67, 0xd6, 0xf7, 0x37, 0x87, 0x94, 0x8f, 0xc6, 0x5d, 0x71, 0x8b, 0xec, 0x73,
+	0xec, 0xb2, 0xe8, 0xb6, 0x7a, 0xc8, 0x44, 0x6a, 0xb5, 0x8f, 0x03, 0x6a, 0xfe, 0x36, 0xec, 0x96,
+	0xa5, 0xda, 0xbb, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x21, 0xa9, 0x83, 0x1f, 0x50, 0x1c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2310,6 +3405,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MachineServiceClient interface {
 	Copy(ctx context.Context, in *CopyRequest, opts ...grpc.CallOption) (MachineService_CopyClient, error)
+	GrowDisk(ctx context.Context, in *GrowDiskRequest, opts ...grpc.CallOption) (*GrowDiskResponse, error)
 	Kubeconfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (MachineService_KubeconfigClient, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (MachineService_ListClient, error)
 	Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (MachineService_LogsClient, error)
@@ -2317,12 +3413,16 @@ type MachineServiceClient interface {
 	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (MachineService_ReadClient, error)
 	Reboot(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RebootResponse, error)
 	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error)
+	ResetProgressStream(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (MachineService_ResetProgressStreamClient, error)
+	ServiceDependencies(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ServiceDependenciesResponse, error)
+	ServiceEvents(ctx context.Context, in *ServiceEventsRequest, opts ...grpc.CallOption) (*ServiceEventsResponse, error)
 	ServiceList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ServiceListResponse, error)
 	ServiceRestart(ctx context.Context, in *ServiceRestartRequest, opts ...grpc.CallOption) (*ServiceRestartResponse, error)
 	ServiceStart(ctx context.Context, in *ServiceStartRequest, opts ...grpc.CallOption) (*ServiceStartResponse, error)
 	ServiceStop(ctx context.Context, in *ServiceStopRequest, opts ...grpc.CallOption) (*ServiceStopResponse, error)
 	Shutdown(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ShutdownResponse, error)
 	Upgrade(ctx context.Context, in *UpgradeRequest, opts ...grpc.CallOption) (*UpgradeResponse, error)
+	UpgradeProgressStream(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (MachineService_UpgradeProgressStreamClient, error)
 	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
 	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
 	Version(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
@@ -2368,6 +3468,15 @@ func (x *machineServiceCopyClient) Recv() (*common.Data, error) {
 	return m, nil
 }
 
+func (c *machineServiceClient) GrowDisk(ctx context.Context, in *GrowDiskRequest, opts ...grpc.CallOption) (*GrowDiskResponse, error) {
+	out := new(GrowDiskResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/GrowDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) Kubeconfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (MachineService_KubeconfigClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_MachineService_serviceDesc.Streams[1], "/machine.MachineService/Kubeconfig", opts...)
 	if err != nil {
@@ -2523,6 +3632,56 @@ func (c *machineServiceClient) Reset(ctx context.Context, in *ResetRequest, opts
 	return out, nil
 }
 
+func (c *machineServiceClient) ResetProgressStream(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (MachineService_ResetProgressStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MachineService_serviceDesc.Streams[5], "/machine.MachineService/ResetProgressStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceResetProgressStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_ResetProgressStreamClient interface {
+	Recv() (*WipeProgress, error)
+	grpc.ClientStream
+}
+
+type machineServiceResetProgressStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceResetProgressStreamClient) Recv() (*WipeProgress, error) {
+	m := new(WipeProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *machineServiceClient) ServiceDependencies(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ServiceDependenciesResponse, error) {
+	out := new(ServiceDependenciesResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/ServiceDependencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ServiceEvents(ctx context.Context, in *ServiceEventsRequest, opts ...grpc.CallOption) (*ServiceEventsResponse, error) {
+	out := new(ServiceEventsResponse)
+	err := c.cc.Invoke(ctx, "/machine.MachineService/ServiceEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) ServiceList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ServiceListResponse, error) {
 	out := new(ServiceListResponse)
 	err := c.cc.Invoke(ctx, "/machine.MachineService/ServiceList", in, out, opts...)
@@ -2577,6 +3736,38 @@ func (c *machineServiceClient) Upgrade(ctx context.Context, in *UpgradeRequest,
 	return out, nil
 }
 
+func (c *machineServiceClient) UpgradeProgressStream(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (MachineService_UpgradeProgressStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MachineService_serviceDesc.Streams[6], "/machine.MachineService/UpgradeProgressStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceUpgradeProgressStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_UpgradeProgressStreamClient interface {
+	Recv() (*ImagePullProgress, error)
+	grpc.ClientStream
+}
+
+type machineServiceUpgradeProgressStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceUpgradeProgressStreamClient) Recv() (*ImagePullProgress, error) {
+	m := new(ImagePullProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Deprecated: Do not use.
 func (c *machineServiceClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
 	out := new(StartResponse)
@@ -2609,6 +3800,7 @@ func (c *machineServiceClient) Version(ctx context.Context, in *empty.Empty, opt
 // MachineServiceServer is the server API for MachineService service.
 type MachineServiceServer interface {
 	Copy(*CopyRequest, MachineService_CopyServer) error
+	GrowDisk(context.Context, *GrowDiskRequest) (*GrowDiskResponse, error)
 	Kubeconfig(*empty.Empty, MachineService_KubeconfigServer) error
 	List(*ListRequest, MachineService_ListServer) error
 	Logs(*LogsRequest, MachineService_LogsServer) error
@@ -2616,12 +3808,16 @@ type MachineServiceServer interface {
 	Read(*ReadRequest, MachineService_ReadServer) error
 	Reboot(context.Context, *empty.Empty) (*RebootResponse, error)
 	Reset(context.Context, *ResetRequest) (*ResetResponse, error)
+	ResetProgressStream(*empty.Empty, MachineService_ResetProgressStreamServer) error
+	ServiceDependencies(context.Context, *empty.Empty) (*ServiceDependenciesResponse, error)
+	ServiceEvents(context.Context, *ServiceEventsRequest) (*ServiceEventsResponse, error)
 	ServiceList(context.Context, *empty.Empty) (*ServiceListResponse, error)
 	ServiceRestart(context.Context, *ServiceRestartRequest) (*ServiceRestartResponse, error)
 	ServiceStart(context.Context, *ServiceStartRequest) (*ServiceStartResponse, error)
 	ServiceStop(context.Context, *ServiceStopRequest) (*ServiceStopResponse, error)
 	Shutdown(context.Context, *empty.Empty) (*ShutdownResponse, error)
 	Upgrade(context.Context, *UpgradeRequest) (*UpgradeResponse, error)
+	UpgradeProgressStream(*empty.Empty, MachineService_UpgradeProgressStreamServer) error
 	Start(context.Context, *StartRequest) (*StartResponse, error)
 	Stop(context.Context, *StopRequest) (*StopResponse, error)
 	Version(context.Context, *empty.Empty) (*VersionResponse, error)
@@ -2652,6 +3848,24 @@ func (x *machineServiceCopyServer) Send(m *common.Data) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MachineService_GrowDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrowDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).GrowDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/GrowDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).GrowDisk(ctx, req.(*GrowDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_Kubeconfig_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(empty.Empty)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2790,6 +4004,63 @@ func _MachineService_Reset_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_ResetProgressStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).ResetProgressStream(m, &machineServiceResetProgressStreamServer{stream})
+}
+
+type MachineService_ResetProgressStreamServer interface {
+	Send(*WipeProgress) error
+	grpc.ServerStream
+}
+
+type machineServiceResetProgressStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceResetProgressStreamServer) Send(m *WipeProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_ServiceDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ServiceDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/ServiceDependencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ServiceDependencies(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_ServiceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ServiceEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/machine.MachineService/ServiceEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ServiceEvents(ctx, req.(*ServiceEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_ServiceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -2898,6 +4169,27 @@ func _MachineService_Upgrade_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_UpgradeProgressStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).UpgradeProgressStream(m, &machineServiceUpgradeProgressStreamServer{stream})
+}
+
+type MachineService_UpgradeProgressStreamServer interface {
+	Send(*ImagePullProgress) error
+	grpc.ServerStream
+}
+
+type machineServiceUpgradeProgressStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceUpgradeProgressStreamServer) Send(m *ImagePullProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _MachineService_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartRequest)
 	if err := dec(in); err != nil {
@@ -2956,6 +4248,10 @@ var _MachineService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "machine.MachineService",
 	HandlerType: (*MachineServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GrowDisk",
+			Handler:    _MachineService_GrowDisk_Handler,
+		},
 		{
 			MethodName: "Mounts",
 			Handler:    _MachineService_Mounts_Handler,
@@ -2968,6 +4264,14 @@ var _MachineService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Reset",
 			Handler:    _MachineService_Reset_Handler,
 		},
+		{
+			MethodName: "ServiceDependencies",
+			Handler:    _MachineService_ServiceDependencies_Handler,
+		},
+		{
+			MethodName: "ServiceEvents",
+			Handler:    _MachineService_ServiceEvents_Handler,
+		},
 		{
 			MethodName: "ServiceList",
 			Handler:    _MachineService_ServiceList_Handler,
@@ -3031,6 +4335,16 @@ var _MachineService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _MachineService_Read_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ResetProgressStream",
+			Handler:       _MachineService_ResetProgressStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpgradeProgressStream",
+			Handler:       _MachineService_UpgradeProgressStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "machine/machine.proto",
 }
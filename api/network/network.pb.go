@@ -556,6 +556,136 @@ func (m *Interface) GetIpaddress() []string {
 	return nil
 }
 
+// VIPRequest selects the shared virtual IP to acquire or release.
+type VIPRequest struct {
+	Ip                   string   `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VIPRequest) Reset()         { *m = VIPRequest{} }
+func (m *VIPRequest) String() string { return proto.CompactTextString(m) }
+func (*VIPRequest) ProtoMessage()    {}
+func (*VIPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96ad937ae012c472, []int{6}
+}
+
+func (m *VIPRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VIPRequest.Unmarshal(m, b)
+}
+
+func (m *VIPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VIPRequest.Marshal(b, m, deterministic)
+}
+
+func (m *VIPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VIPRequest.Merge(m, src)
+}
+
+func (m *VIPRequest) XXX_Size() int {
+	return xxx_messageInfo_VIPRequest.Size(m)
+}
+
+func (m *VIPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VIPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VIPRequest proto.InternalMessageInfo
+
+func (m *VIPRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+type VIPResponse struct {
+	Messages             []*VIP   `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VIPResponse) Reset()         { *m = VIPResponse{} }
+func (m *VIPResponse) String() string { return proto.CompactTextString(m) }
+func (*VIPResponse) ProtoMessage()    {}
+func (*VIPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96ad937ae012c472, []int{7}
+}
+
+func (m *VIPResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VIPResponse.Unmarshal(m, b)
+}
+
+func (m *VIPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VIPResponse.Marshal(b, m, deterministic)
+}
+
+func (m *VIPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VIPResponse.Merge(m, src)
+}
+
+func (m *VIPResponse) XXX_Size() int {
+	return xxx_messageInfo_VIPResponse.Size(m)
+}
+
+func (m *VIPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VIPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VIPResponse proto.InternalMessageInfo
+
+func (m *VIPResponse) GetMessages() []*VIP {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type VIP struct {
+	Metadata             *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *VIP) Reset()         { *m = VIP{} }
+func (m *VIP) String() string { return proto.CompactTextString(m) }
+func (*VIP) ProtoMessage()    {}
+func (*VIP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96ad937ae012c472, []int{8}
+}
+
+func (m *VIP) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VIP.Unmarshal(m, b)
+}
+
+func (m *VIP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VIP.Marshal(b, m, deterministic)
+}
+
+func (m *VIP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VIP.Merge(m, src)
+}
+
+func (m *VIP) XXX_Size() int {
+	return xxx_messageInfo_VIP.Size(m)
+}
+
+func (m *VIP) XXX_DiscardUnknown() {
+	xxx_messageInfo_VIP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VIP proto.InternalMessageInfo
+
+func (m *VIP) GetMetadata() *common.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("network.AddressFamily", AddressFamily_name, AddressFamily_value)
 	proto.RegisterEnum("network.RouteProtocol", RouteProtocol_name, RouteProtocol_value)
@@ -566,64 +696,72 @@ func init() {
 	proto.RegisterType((*InterfacesResponse)(nil), "network.InterfacesResponse")
 	proto.RegisterType((*Interfaces)(nil), "network.Interfaces")
 	proto.RegisterType((*Interface)(nil), "network.Interface")
+	proto.RegisterType((*VIPRequest)(nil), "network.VIPRequest")
+	proto.RegisterType((*VIPResponse)(nil), "network.VIPResponse")
+	proto.RegisterType((*VIP)(nil), "network.VIP")
 }
 
 func init() { proto.RegisterFile("network/network.proto", fileDescriptor_96ad937ae012c472) }
 
 var fileDescriptor_96ad937ae012c472 = []byte{
-	// 828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x73, 0xeb, 0x34,
-	0x14, 0xc5, 0xf9, 0x6a, 0x72, 0x53, 0x27, 0xaa, 0x0a, 0xad, 0xa7, 0x8f, 0x45, 0x26, 0x0b, 0xa6,
-	0x13, 0x78, 0xc9, 0x4c, 0x60, 0xde, 0x0c, 0x0b, 0x16, 0x4e, 0xe2, 0x3c, 0x32, 0x49, 0x6d, 0xa3,
-	0xba, 0xc0, 0xbc, 0x05, 0x1d, 0xd5, 0x56, 0xf3, 0x3c, 0xc4, 0x1f, 0x63, 0x39, 0x94, 0xae, 0xd9,
-	0xf3, 0x1f, 0xf8, 0x05, 0x6c, 0xf9, 0x79, 0x8c, 0x65, 0xd9, 0x71, 0x5e, 0x61, 0xf1, 0x56, 0xd6,
-	0x3d, 0xf7, 0xdc, 0x7b, 0x24, 0x1d, 0x49, 0x86, 0xcf, 0x42, 0x96, 0x3e, 0x45, 0xc9, 0xaf, 0x13,
-	0xf9, 0x1d, 0xc7, 0x49, 0x94, 0x46, 0xf8, 0x44, 0x86, 0x57, 0xaf, 0xb6, 0x51, 0xb4, 0xdd, 0xb1,
-	0x89, 0x80, 0x1f, 0xf6, 0x8f, 0x13, 0x16, 0xc4, 0xe9, 0x73, 0xce, 0xba, 0x3a, 0x77, 0xa3, 0x20,
-	0x88, 0xc2, 0x49, 0xfe, 0xc9, 0xc1, 0xe1, 0x77, 0xd0, 0x23, 0xd1, 0x3e, 0x65, 0x9c, 0x30, 0x1e,
-	0x47, 0x21, 0x67, 0xf8, 0x4b, 0x68, 0x07, 0x8c, 0x73, 0xba, 0x65, 0x5c, 0x53, 0x06, 0xf5, 0xeb,
-	0xee, 0xb4, 0x3f, 0x2e, 0xe4, 0x24, 0xb5, 0x24, 0x0c, 0x7f, 0x81, 0x56, 0x8e, 0xe1, 0xaf, 0xb2,
-	0xb2, 0x94, 0x7a, 0x34, 0xa5, 0x9a, 0x32, 0x50, 0xae, 0xbb, 0x53, 0x34, 0x96, 0x4a, 0x37, 0x12,
-	0x27, 0x25, 0x03, 0x7f, 0x01, 0xad, 0x44, 0xd4, 0x69, 0x35, 0x21, 0xd1, 0x3b, 0x96, 0x20, 0x32,
-	0x3b, 0xfc, 0xab, 0x06, 0x4d, 0x81, 0xe0, 0xcf, 0xa1, 0xe3, 0x87, 0x29, 0x4b, 0x1e, 0xa9, 0xcb,
-	0x84, 0x40, 0x87, 0x1c, 0x00, 0x3c, 0x80, 0xae, 0xc7, 0x78, 0xea, 0x87, 0x34, 0xf5, 0xa3, 0x50,
-	0xab, 0x89, 0x7c, 0x15, 0xc2, 0x1a, 0x9c, 0x6c, 0x69, 0xca, 0x9e, 0xe8, 0xb3, 0x56, 0x17, 0xd9,
-	0x22, 0xc4, 0x17, 0xd0, 0x0a, 0x58, 0x9a, 0xf8, 0xae, 0xd6, 0x18, 0x28, 0xd7, 0x2a, 0x91, 0x11,
-	0xfe, 0x14, 0x9a, 0xdc, 0x8d, 0x62, 0xa6, 0x35, 0x05, 0x9c, 0x07, 0x19, 0x9b, 0x47, 0xfb, 0xc4,
-	0x65, 0x5a, 0x4b, 0xb4, 0x91, 0x11, 0x1e, 0x43, 0xeb, 0x91, 0x06, 0xfe, 0xee, 0x59, 0x3b, 0x19,
-	0x28, 0xd7, 0xbd, 0xe9, 0x45, 0xb9, 0x22, 0xdd, 0xf3, 0x12, 0xc6, 0xf9, 0x52, 0x64, 0x89, 0x64,
-	0xe1, 0x29, 0xb4, 0x85, 0x03, 0x6e, 0xb4, 0xd3, 0xda, 0x1f, 0x54, 0x88, 0x15, 0xdb, 0x32, 0x4b,
-	0x4a, 0x5e, 0x36, 0xa3, 0xc7, 0x1d, 0xdd, 0x72, 0xad, 0x93, 0xcf, 0x48, 0x04, 0x43, 0x03, 0xf0,
-	0xaa, 0xd8, 0x88, 0x83, 0x8d, 0x93, 0x17, 0x36, 0x9e, 0x97, 0xfd, 0x2b, 0xf4, 0x83, 0x95, 0x21,
-	0xc0, 0x01, 0xff, 0x48, 0x3b, 0xa7, 0x00, 0xa5, 0x17, 0x85, 0xa5, 0xf8, 0xa5, 0x1c, 0xa9, 0xb0,
-	0x86, 0xff, 0x28, 0xd0, 0x29, 0x33, 0xd9, 0xd2, 0xfc, 0xd0, 0x63, 0xbf, 0x0b, 0x31, 0x95, 0xe4,
-	0x01, 0x46, 0x50, 0x0f, 0xd2, 0xbd, 0xb0, 0x53, 0x25, 0xd9, 0x10, 0x63, 0x68, 0x84, 0x34, 0x60,
-	0xd2, 0x43, 0x31, 0xc6, 0x43, 0x38, 0x7d, 0x4f, 0x13, 0xef, 0x89, 0x26, 0x8c, 0x7a, 0x5e, 0x22,
-	0x6c, 0xec, 0x90, 0x23, 0x0c, 0xbf, 0x2e, 0xb6, 0xae, 0x29, 0xf6, 0xfa, 0xf2, 0xe5, 0xe4, 0x96,
-	0x59, 0x5a, 0xee, 0xa9, 0x38, 0x6d, 0x31, 0xcd, 0x8d, 0xd3, 0x5a, 0x83, 0xba, 0x38, 0x6d, 0x05,
-	0x30, 0xfa, 0x01, 0xd4, 0x23, 0x53, 0xb1, 0x0a, 0x1d, 0x7d, 0x79, 0x7f, 0x67, 0xde, 0xda, 0xc6,
-	0x1c, 0x7d, 0x82, 0xbb, 0x70, 0xa2, 0x2f, 0xef, 0x57, 0xa6, 0xe1, 0xa0, 0x1a, 0x6e, 0x43, 0x63,
-	0x65, 0xff, 0xf8, 0x0d, 0xaa, 0xe1, 0x53, 0x68, 0x4b, 0xf8, 0x0d, 0x02, 0x89, 0xbf, 0x41, 0x70,
-	0x55, 0x43, 0xca, 0xe8, 0xef, 0x1a, 0xa8, 0x47, 0xb6, 0xe3, 0x33, 0x50, 0x89, 0x63, 0x13, 0xcb,
-	0x39, 0xf4, 0x3d, 0x87, 0xbe, 0x84, 0x88, 0xb1, 0x58, 0x11, 0x63, 0xee, 0x20, 0xa5, 0xc2, 0x5b,
-	0x1b, 0xc4, 0x34, 0x36, 0xa8, 0x86, 0xfb, 0xd0, 0x95, 0xd0, 0xcc, 0xb2, 0x1c, 0x54, 0xaf, 0x70,
-	0x6e, 0x1d, 0xdd, 0x59, 0xcd, 0x51, 0x03, 0x23, 0x38, 0x95, 0xd0, 0x5b, 0xdd, 0x31, 0x16, 0xa8,
-	0x9d, 0x2d, 0xa2, 0xe8, 0xae, 0xa3, 0x0e, 0xee, 0x01, 0xc8, 0xf0, 0x86, 0x38, 0x08, 0x2a, 0x05,
-	0xef, 0x8c, 0x19, 0xd1, 0x51, 0xb7, 0x2a, 0xb3, 0x22, 0x0b, 0x74, 0x5a, 0x99, 0xdf, 0xc2, 0x24,
-	0xd6, 0x5d, 0xd6, 0x56, 0xad, 0xb0, 0x7e, 0xb6, 0x88, 0x8d, 0x7a, 0x95, 0xc6, 0xa6, 0xb3, 0x46,
-	0xfd, 0x0a, 0x61, 0xf1, 0xfd, 0xdc, 0x46, 0x08, 0x63, 0xe8, 0x95, 0xca, 0x79, 0x97, 0xb3, 0x8a,
-	0xfa, 0x4c, 0x9f, 0x19, 0x1b, 0x34, 0x1a, 0xfd, 0xa1, 0x40, 0xef, 0xd8, 0xbc, 0x8c, 0xb4, 0xdc,
-	0xe8, 0x6f, 0xef, 0xef, 0xcc, 0xb5, 0x69, 0xfd, 0x64, 0xe6, 0x4e, 0xe4, 0x88, 0x8d, 0x94, 0xac,
-	0xaf, 0x08, 0x66, 0xc4, 0xd2, 0x17, 0x73, 0xfd, 0x36, 0x73, 0xe7, 0x0c, 0x54, 0x81, 0x6d, 0x2c,
-	0xcb, 0x9e, 0xe9, 0xf3, 0x35, 0xaa, 0xe3, 0x4b, 0x38, 0x17, 0x90, 0x6d, 0xad, 0x4c, 0xe7, 0xde,
-	0xb1, 0xf2, 0x01, 0x6a, 0x94, 0xf5, 0x37, 0x77, 0x1b, 0x67, 0x25, 0xea, 0x9b, 0xd3, 0x3f, 0x15,
-	0xe8, 0x99, 0xf9, 0x51, 0xba, 0x65, 0xc9, 0x6f, 0xbe, 0xcb, 0xf0, 0xb7, 0xe5, 0x9b, 0x78, 0x31,
-	0xce, 0xdf, 0xe3, 0x71, 0xf1, 0x1e, 0x8f, 0x8d, 0xec, 0x3d, 0xbe, 0xba, 0xfc, 0xf0, 0x41, 0x2d,
-	0x2e, 0xad, 0x7e, 0x74, 0x07, 0xff, 0xaf, 0xfc, 0xd5, 0x7f, 0x5d, 0x64, 0xd9, 0x62, 0xb6, 0x86,
-	0xbe, 0x1b, 0x05, 0x25, 0x83, 0xc6, 0xfe, 0x0c, 0xe4, 0x04, 0xf5, 0xd8, 0xb7, 0x95, 0x77, 0xa3,
-	0xad, 0x9f, 0xbe, 0xdf, 0x3f, 0x64, 0xb7, 0x79, 0x92, 0xd2, 0x5d, 0xc4, 0x5f, 0xf3, 0x67, 0x9e,
-	0xb2, 0x80, 0xe7, 0xd1, 0x84, 0xc6, 0x7e, 0xf1, 0x7b, 0x79, 0x68, 0x09, 0xe5, 0xaf, 0xff, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0x06, 0x63, 0x99, 0xc9, 0x78, 0x06, 0x00, 0x00,
+	// 903 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x72, 0xe2, 0x46,
+	0x10, 0x8e, 0x84, 0xc1, 0xd0, 0xfc, 0x8d, 0x87, 0x8d, 0xad, 0x62, 0xf7, 0x40, 0x71, 0x48, 0x51,
+	0x24, 0x0b, 0x55, 0x6c, 0x6a, 0xb7, 0x72, 0xc8, 0x41, 0x80, 0xd8, 0xa8, 0xc0, 0x92, 0x32, 0x96,
+	0x9d, 0xd4, 0x1e, 0xe2, 0x1a, 0xc3, 0x98, 0x55, 0x05, 0xfd, 0xac, 0x24, 0xe2, 0xf8, 0x96, 0xaa,
+	0x3c, 0x49, 0x9e, 0x20, 0xd7, 0x3c, 0x5e, 0x4a, 0xa3, 0x91, 0x10, 0xeb, 0xe4, 0xe0, 0x93, 0xa6,
+	0xbf, 0xfe, 0xba, 0x7b, 0xa6, 0xbf, 0xd6, 0x0c, 0x7c, 0xe9, 0xb1, 0xf8, 0xc1, 0x0f, 0x7f, 0x1d,
+	0x8b, 0xef, 0x28, 0x08, 0xfd, 0xd8, 0xc7, 0xa7, 0xc2, 0xec, 0xbe, 0xdc, 0xfa, 0xfe, 0x76, 0xc7,
+	0xc6, 0x1c, 0xbe, 0xdb, 0xdf, 0x8f, 0x99, 0x1b, 0xc4, 0x8f, 0x29, 0xab, 0xdb, 0x59, 0xfb, 0xae,
+	0xeb, 0x7b, 0xe3, 0xf4, 0x93, 0x82, 0xfd, 0xef, 0xa1, 0x45, 0xfc, 0x7d, 0xcc, 0x22, 0xc2, 0xa2,
+	0xc0, 0xf7, 0x22, 0x86, 0xbf, 0x86, 0xaa, 0xcb, 0xa2, 0x88, 0x6e, 0x59, 0xa4, 0x48, 0xbd, 0xd2,
+	0xa0, 0x3e, 0x69, 0x8f, 0xb2, 0x72, 0x82, 0x9a, 0x13, 0xfa, 0xbf, 0x40, 0x25, 0xc5, 0xf0, 0x37,
+	0x49, 0x58, 0x4c, 0x37, 0x34, 0xa6, 0x8a, 0xd4, 0x93, 0x06, 0xf5, 0x09, 0x1a, 0x89, 0x4a, 0x97,
+	0x02, 0x27, 0x39, 0x03, 0x7f, 0x05, 0x95, 0x90, 0xc7, 0x29, 0x32, 0x2f, 0xd1, 0x3a, 0x2e, 0x41,
+	0x84, 0xb7, 0xff, 0x97, 0x0c, 0x65, 0x8e, 0xe0, 0x57, 0x50, 0x73, 0xbc, 0x98, 0x85, 0xf7, 0x74,
+	0xcd, 0x78, 0x81, 0x1a, 0x39, 0x00, 0xb8, 0x07, 0xf5, 0x0d, 0x8b, 0x62, 0xc7, 0xa3, 0xb1, 0xe3,
+	0x7b, 0x8a, 0xcc, 0xfd, 0x45, 0x08, 0x2b, 0x70, 0xba, 0xa5, 0x31, 0x7b, 0xa0, 0x8f, 0x4a, 0x89,
+	0x7b, 0x33, 0x13, 0x9f, 0x43, 0xc5, 0x65, 0x71, 0xe8, 0xac, 0x95, 0x93, 0x9e, 0x34, 0x68, 0x12,
+	0x61, 0xe1, 0x17, 0x50, 0x8e, 0xd6, 0x7e, 0xc0, 0x94, 0x32, 0x87, 0x53, 0x23, 0x61, 0x47, 0xfe,
+	0x3e, 0x5c, 0x33, 0xa5, 0xc2, 0xd3, 0x08, 0x0b, 0x8f, 0xa0, 0x72, 0x4f, 0x5d, 0x67, 0xf7, 0xa8,
+	0x9c, 0xf6, 0xa4, 0x41, 0x6b, 0x72, 0x9e, 0x9f, 0x48, 0xdd, 0x6c, 0x42, 0x16, 0x45, 0x0b, 0xee,
+	0x25, 0x82, 0x85, 0x27, 0x50, 0xe5, 0x0a, 0xac, 0xfd, 0x9d, 0x52, 0xfd, 0x2c, 0x82, 0x9f, 0xd8,
+	0x12, 0x5e, 0x92, 0xf3, 0x92, 0x1d, 0xdd, 0xef, 0xe8, 0x36, 0x52, 0x6a, 0xe9, 0x8e, 0xb8, 0xd1,
+	0xd7, 0x00, 0xeb, 0x59, 0x23, 0x0e, 0x32, 0x8e, 0x9f, 0xc8, 0xd8, 0xc9, 0xf3, 0x17, 0xe8, 0x07,
+	0x29, 0x3d, 0x80, 0x03, 0xfe, 0x4c, 0x39, 0x27, 0x00, 0xb9, 0x16, 0x99, 0xa4, 0xf8, 0x69, 0x39,
+	0x52, 0x60, 0xf5, 0xff, 0x91, 0xa0, 0x96, 0x7b, 0x92, 0xa3, 0x39, 0xde, 0x86, 0xfd, 0xce, 0x8b,
+	0x35, 0x49, 0x6a, 0x60, 0x04, 0x25, 0x37, 0xde, 0x73, 0x39, 0x9b, 0x24, 0x59, 0x62, 0x0c, 0x27,
+	0x1e, 0x75, 0x99, 0xd0, 0x90, 0xaf, 0x71, 0x1f, 0x1a, 0x1f, 0x69, 0xb8, 0x79, 0xa0, 0x21, 0xa3,
+	0x9b, 0x4d, 0xc8, 0x65, 0xac, 0x91, 0x23, 0x0c, 0xbf, 0xce, 0x5a, 0x57, 0xe6, 0xbd, 0xbe, 0x78,
+	0xba, 0xb9, 0x45, 0xe2, 0x16, 0x3d, 0xe5, 0xd3, 0x16, 0xd0, 0x54, 0x38, 0xa5, 0xd2, 0x2b, 0xf1,
+	0x69, 0xcb, 0x80, 0xfe, 0x2b, 0x80, 0x1b, 0xdd, 0x22, 0xec, 0xd3, 0x9e, 0x45, 0x31, 0x6e, 0x81,
+	0xec, 0x04, 0x62, 0x24, 0x65, 0x27, 0xe8, 0xbf, 0x83, 0x3a, 0xf7, 0x0a, 0x21, 0x06, 0x4f, 0x84,
+	0x68, 0xe4, 0xc5, 0x13, 0xde, 0x41, 0x81, 0x37, 0x50, 0xba, 0xd1, 0xad, 0xe7, 0xb5, 0x7e, 0xf8,
+	0x23, 0x34, 0x8f, 0x06, 0x0c, 0x37, 0xa1, 0xa6, 0x2e, 0x6e, 0xaf, 0x8d, 0x2b, 0x4b, 0x9b, 0xa1,
+	0x2f, 0x70, 0x1d, 0x4e, 0xd5, 0xc5, 0xad, 0x6e, 0x68, 0x36, 0x92, 0x71, 0x15, 0x4e, 0x74, 0xeb,
+	0xe6, 0x5b, 0x24, 0xe3, 0x06, 0x54, 0x05, 0xfc, 0x16, 0x81, 0xc0, 0xdf, 0x22, 0xe8, 0xca, 0x48,
+	0x1a, 0xfe, 0x2d, 0x43, 0xf3, 0x68, 0x04, 0xf1, 0x19, 0x34, 0x89, 0x6d, 0x11, 0xd3, 0x3e, 0xe4,
+	0xed, 0x40, 0x5b, 0x40, 0x44, 0x9b, 0xeb, 0x44, 0x9b, 0xd9, 0x48, 0x2a, 0xf0, 0x96, 0x1a, 0x31,
+	0xb4, 0x15, 0x92, 0x71, 0x1b, 0xea, 0x02, 0x9a, 0x9a, 0xa6, 0x8d, 0x4a, 0x05, 0xce, 0x95, 0xad,
+	0xda, 0xfa, 0x0c, 0x9d, 0x60, 0x04, 0x0d, 0x01, 0xbd, 0x57, 0x6d, 0x6d, 0x8e, 0xaa, 0xc9, 0x21,
+	0xb2, 0xec, 0x2a, 0xaa, 0xe1, 0x16, 0x80, 0x30, 0x2f, 0x89, 0x8d, 0xa0, 0x10, 0xf0, 0x41, 0x9b,
+	0x12, 0x15, 0xd5, 0x8b, 0x65, 0x74, 0x32, 0x47, 0x8d, 0xc2, 0xfe, 0xe6, 0x06, 0x31, 0xaf, 0x93,
+	0xb4, 0xcd, 0x02, 0xeb, 0x67, 0x93, 0x58, 0xa8, 0x55, 0x48, 0x6c, 0xd8, 0x4b, 0xd4, 0x2e, 0x10,
+	0xe6, 0x3f, 0xcc, 0x2c, 0x84, 0x30, 0x86, 0x56, 0x5e, 0x39, 0xcd, 0x72, 0x56, 0xa8, 0x3e, 0x55,
+	0xa7, 0xda, 0x0a, 0x0d, 0x87, 0x7f, 0x4a, 0xd0, 0x3a, 0x1e, 0xa4, 0x84, 0xb4, 0x58, 0xa9, 0xef,
+	0x6f, 0xaf, 0x8d, 0xa5, 0x61, 0xfe, 0x64, 0xa4, 0x4a, 0xa4, 0x88, 0x85, 0xa4, 0x24, 0x2f, 0x37,
+	0xa6, 0xc4, 0x54, 0xe7, 0x33, 0xf5, 0x2a, 0x51, 0xe7, 0x0c, 0x9a, 0x1c, 0x5b, 0x99, 0xa6, 0x35,
+	0x55, 0x67, 0x4b, 0x54, 0xc2, 0x17, 0xd0, 0xe1, 0x90, 0x65, 0xea, 0x86, 0x7d, 0x6b, 0x9b, 0xe9,
+	0x02, 0x9d, 0xe4, 0xf1, 0x97, 0xd7, 0x2b, 0x5b, 0xe7, 0xf1, 0xe5, 0xc9, 0x1f, 0x32, 0xb4, 0x8c,
+	0x74, 0xb2, 0xae, 0x58, 0xf8, 0x9b, 0xb3, 0x66, 0xf8, 0xbb, 0xfc, 0x7e, 0x3e, 0x1f, 0xa5, 0x6f,
+	0xc3, 0x28, 0x7b, 0x1b, 0x46, 0x5a, 0xf2, 0x36, 0x74, 0x2f, 0x3e, 0xbf, 0xdc, 0xb3, 0xb9, 0x55,
+	0x8f, 0xee, 0x83, 0xff, 0x0b, 0x7f, 0xf9, 0x5f, 0x97, 0x4a, 0x96, 0xe2, 0x1d, 0x80, 0xba, 0xfe,
+	0xb4, 0x77, 0x42, 0x96, 0xcc, 0x75, 0xe7, 0x68, 0xec, 0xd3, 0x9f, 0xa7, 0xfb, 0xe2, 0x18, 0x3c,
+	0x04, 0x12, 0xb6, 0x63, 0x34, 0x7a, 0x66, 0xe0, 0x74, 0x09, 0xed, 0xb5, 0xef, 0xe6, 0x2e, 0x1a,
+	0x38, 0x53, 0x10, 0x2d, 0x51, 0x03, 0xc7, 0x92, 0x3e, 0x0c, 0xb7, 0x4e, 0xfc, 0x71, 0x7f, 0x97,
+	0xfc, 0x50, 0xe3, 0x98, 0xee, 0xfc, 0xe8, 0x75, 0xf4, 0x18, 0xc5, 0xcc, 0x8d, 0x52, 0x6b, 0x4c,
+	0x03, 0x27, 0x7b, 0x5c, 0xef, 0x2a, 0xfc, 0xac, 0x6f, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xd6,
+	0x55, 0x10, 0xbb, 0x76, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -642,6 +780,8 @@ const _ = grpc.SupportPackageIsVersion4
 type NetworkServiceClient interface {
 	Routes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RoutesResponse, error)
 	Interfaces(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*InterfacesResponse, error)
+	AcquireVIP(ctx context.Context, in *VIPRequest, opts ...grpc.CallOption) (*VIPResponse, error)
+	ReleaseVIP(ctx context.Context, in *VIPRequest, opts ...grpc.CallOption) (*VIPResponse, error)
 }
 
 type networkServiceClient struct {
@@ -670,10 +810,30 @@ func (c *networkServiceClient) Interfaces(ctx context.Context, in *empty.Empty,
 	return out, nil
 }
 
+func (c *networkServiceClient) AcquireVIP(ctx context.Context, in *VIPRequest, opts ...grpc.CallOption) (*VIPResponse, error) {
+	out := new(VIPResponse)
+	err := c.cc.Invoke(ctx, "/network.NetworkService/AcquireVIP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) ReleaseVIP(ctx context.Context, in *VIPRequest, opts ...grpc.CallOption) (*VIPResponse, error) {
+	out := new(VIPResponse)
+	err := c.cc.Invoke(ctx, "/network.NetworkService/ReleaseVIP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NetworkServiceServer is the server API for NetworkService service.
 type NetworkServiceServer interface {
 	Routes(context.Context, *empty.Empty) (*RoutesResponse, error)
 	Interfaces(context.Context, *empty.Empty) (*InterfacesResponse, error)
+	AcquireVIP(context.Context, *VIPRequest) (*VIPResponse, error)
+	ReleaseVIP(context.Context, *VIPRequest) (*VIPResponse, error)
 }
 
 func RegisterNetworkServiceServer(s *grpc.Server, srv NetworkServiceServer) {
@@ -716,6 +876,42 @@ func _NetworkService_Interfaces_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NetworkService_AcquireVIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VIPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).AcquireVIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/network.NetworkService/AcquireVIP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).AcquireVIP(ctx, req.(*VIPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_ReleaseVIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VIPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).ReleaseVIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/network.NetworkService/ReleaseVIP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).ReleaseVIP(ctx, req.(*VIPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NetworkService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "network.NetworkService",
 	HandlerType: (*NetworkServiceServer)(nil),
@@ -728,6 +924,14 @@ var _NetworkService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Interfaces",
 			Handler:    _NetworkService_Interfaces_Handler,
 		},
+		{
+			MethodName: "AcquireVIP",
+			Handler:    _NetworkService_AcquireVIP_Handler,
+		},
+		{
+			MethodName: "ReleaseVIP",
+			Handler:    _NetworkService_ReleaseVIP_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "network/network.proto",
@@ -9,7 +9,9 @@ import (
 	math "math"
 
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 
 	common "github.com/talos-systems/talos/api/common"
@@ -319,6 +321,301 @@ func (m *DmesgRequest) GetTail() bool {
 	return false
 }
 
+// ExecStart describes the process to start in the container.
+type ExecStart struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Id        string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// driver might be default "containerd" or "cri"
+	Driver common.ContainerDriver `protobuf:"varint,3,opt,name=driver,proto3,enum=common.ContainerDriver" json:"driver,omitempty"`
+	Args   []string               `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	Env    []string               `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty"`
+	Tty    bool                   `protobuf:"varint,6,opt,name=tty,proto3" json:"tty,omitempty"`
+	// initial terminal size, if tty is set
+	Size                 *ExecResize `protobuf:"bytes,7,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ExecStart) Reset()         { *m = ExecStart{} }
+func (m *ExecStart) String() string { return proto.CompactTextString(m) }
+func (*ExecStart) ProtoMessage()    {}
+func (*ExecStart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b20a722d09fd3254, []int{5}
+}
+
+func (m *ExecStart) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecStart.Unmarshal(m, b)
+}
+
+func (m *ExecStart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecStart.Marshal(b, m, deterministic)
+}
+
+func (m *ExecStart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecStart.Merge(m, src)
+}
+
+func (m *ExecStart) XXX_Size() int {
+	return xxx_messageInfo_ExecStart.Size(m)
+}
+
+func (m *ExecStart) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecStart.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecStart proto.InternalMessageInfo
+
+func (m *ExecStart) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ExecStart) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExecStart) GetDriver() common.ContainerDriver {
+	if m != nil {
+		return m.Driver
+	}
+	return common.ContainerDriver_CONTAINERD
+}
+
+func (m *ExecStart) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *ExecStart) GetEnv() []string {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *ExecStart) GetTty() bool {
+	if m != nil {
+		return m.Tty
+	}
+	return false
+}
+
+func (m *ExecStart) GetSize() *ExecResize {
+	if m != nil {
+		return m.Size
+	}
+	return nil
+}
+
+// ExecResize is the terminal size change.
+type ExecResize struct {
+	Width                uint32   `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height               uint32   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecResize) Reset()         { *m = ExecResize{} }
+func (m *ExecResize) String() string { return proto.CompactTextString(m) }
+func (*ExecResize) ProtoMessage()    {}
+func (*ExecResize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b20a722d09fd3254, []int{6}
+}
+
+func (m *ExecResize) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecResize.Unmarshal(m, b)
+}
+
+func (m *ExecResize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecResize.Marshal(b, m, deterministic)
+}
+
+func (m *ExecResize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecResize.Merge(m, src)
+}
+
+func (m *ExecResize) XXX_Size() int {
+	return xxx_messageInfo_ExecResize.Size(m)
+}
+
+func (m *ExecResize) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecResize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecResize proto.InternalMessageInfo
+
+func (m *ExecResize) GetWidth() uint32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *ExecResize) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// The request message of the exec stream.
+//
+// The first message should set start, following messages carry stdin data,
+// terminal resize events and stdin close.
+type ExecRequest struct {
+	Start                *ExecStart  `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Stdin                []byte      `protobuf:"bytes,2,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	CloseStdin           bool        `protobuf:"varint,3,opt,name=close_stdin,json=closeStdin,proto3" json:"close_stdin,omitempty"`
+	Resize               *ExecResize `protobuf:"bytes,4,opt,name=resize,proto3" json:"resize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ExecRequest) Reset()         { *m = ExecRequest{} }
+func (m *ExecRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecRequest) ProtoMessage()    {}
+func (*ExecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b20a722d09fd3254, []int{7}
+}
+
+func (m *ExecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecRequest.Unmarshal(m, b)
+}
+
+func (m *ExecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecRequest.Marshal(b, m, deterministic)
+}
+
+func (m *ExecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecRequest.Merge(m, src)
+}
+
+func (m *ExecRequest) XXX_Size() int {
+	return xxx_messageInfo_ExecRequest.Size(m)
+}
+
+func (m *ExecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecRequest proto.InternalMessageInfo
+
+func (m *ExecRequest) GetStart() *ExecStart {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *ExecRequest) GetStdin() []byte {
+	if m != nil {
+		return m.Stdin
+	}
+	return nil
+}
+
+func (m *ExecRequest) GetCloseStdin() bool {
+	if m != nil {
+		return m.CloseStdin
+	}
+	return false
+}
+
+func (m *ExecRequest) GetResize() *ExecResize {
+	if m != nil {
+		return m.Resize
+	}
+	return nil
+}
+
+// The response message of the exec stream.
+//
+// The last message has exited set along with the exit code of the process.
+type ExecResponse struct {
+	Metadata             *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Stdout               []byte           `protobuf:"bytes,2,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr               []byte           `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	Exited               bool             `protobuf:"varint,4,opt,name=exited,proto3" json:"exited,omitempty"`
+	ExitCode             uint32           `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExecResponse) Reset()         { *m = ExecResponse{} }
+func (m *ExecResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecResponse) ProtoMessage()    {}
+func (*ExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b20a722d09fd3254, []int{8}
+}
+
+func (m *ExecResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecResponse.Unmarshal(m, b)
+}
+
+func (m *ExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecResponse.Marshal(b, m, deterministic)
+}
+
+func (m *ExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecResponse.Merge(m, src)
+}
+
+func (m *ExecResponse) XXX_Size() int {
+	return xxx_messageInfo_ExecResponse.Size(m)
+}
+
+func (m *ExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecResponse proto.InternalMessageInfo
+
+func (m *ExecResponse) GetMetadata() *common.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ExecResponse) GetStdout() []byte {
+	if m != nil {
+		return m.Stdout
+	}
+	return nil
+}
+
+func (m *ExecResponse) GetStderr() []byte {
+	if m != nil {
+		return m.Stderr
+	}
+	return nil
+}
+
+func (m *ExecResponse) GetExited() bool {
+	if m != nil {
+		return m.Exited
+	}
+	return false
+}
+
+func (m *ExecResponse) GetExitCode() uint32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
 // rpc processes
 type ProcessesRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -330,7 +627,7 @@ func (m *ProcessesRequest) Reset()         { *m = ProcessesRequest{} }
 func (m *ProcessesRequest) String() string { return proto.CompactTextString(m) }
 func (*ProcessesRequest) ProtoMessage()    {}
 func (*ProcessesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{5}
+	return fileDescriptor_b20a722d09fd3254, []int{9}
 }
 
 func (m *ProcessesRequest) XXX_Unmarshal(b []byte) error {
@@ -366,7 +663,7 @@ func (m *ProcessesResponse) Reset()         { *m = ProcessesResponse{} }
 func (m *ProcessesResponse) String() string { return proto.CompactTextString(m) }
 func (*ProcessesResponse) ProtoMessage()    {}
 func (*ProcessesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{6}
+	return fileDescriptor_b20a722d09fd3254, []int{10}
 }
 
 func (m *ProcessesResponse) XXX_Unmarshal(b []byte) error {
@@ -410,7 +707,7 @@ func (m *Process) Reset()         { *m = Process{} }
 func (m *Process) String() string { return proto.CompactTextString(m) }
 func (*Process) ProtoMessage()    {}
 func (*Process) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{7}
+	return fileDescriptor_b20a722d09fd3254, []int{11}
 }
 
 func (m *Process) XXX_Unmarshal(b []byte) error {
@@ -469,7 +766,7 @@ func (m *ProcessInfo) Reset()         { *m = ProcessInfo{} }
 func (m *ProcessInfo) String() string { return proto.CompactTextString(m) }
 func (*ProcessInfo) ProtoMessage()    {}
 func (*ProcessInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{8}
+	return fileDescriptor_b20a722d09fd3254, []int{12}
 }
 
 func (m *ProcessInfo) XXX_Unmarshal(b []byte) error {
@@ -580,7 +877,7 @@ func (m *RestartRequest) Reset()         { *m = RestartRequest{} }
 func (m *RestartRequest) String() string { return proto.CompactTextString(m) }
 func (*RestartRequest) ProtoMessage()    {}
 func (*RestartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{9}
+	return fileDescriptor_b20a722d09fd3254, []int{13}
 }
 
 func (m *RestartRequest) XXX_Unmarshal(b []byte) error {
@@ -637,7 +934,7 @@ func (m *Restart) Reset()         { *m = Restart{} }
 func (m *Restart) String() string { return proto.CompactTextString(m) }
 func (*Restart) ProtoMessage()    {}
 func (*Restart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{10}
+	return fileDescriptor_b20a722d09fd3254, []int{14}
 }
 
 func (m *Restart) XXX_Unmarshal(b []byte) error {
@@ -681,7 +978,7 @@ func (m *RestartResponse) Reset()         { *m = RestartResponse{} }
 func (m *RestartResponse) String() string { return proto.CompactTextString(m) }
 func (*RestartResponse) ProtoMessage()    {}
 func (*RestartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{11}
+	return fileDescriptor_b20a722d09fd3254, []int{15}
 }
 
 func (m *RestartResponse) XXX_Unmarshal(b []byte) error {
@@ -727,7 +1024,7 @@ func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
 func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
 func (*StatsRequest) ProtoMessage()    {}
 func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{12}
+	return fileDescriptor_b20a722d09fd3254, []int{16}
 }
 
 func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
@@ -768,18 +1065,20 @@ func (m *StatsRequest) GetDriver() common.ContainerDriver {
 
 // The messages message containing the requested stats.
 type Stats struct {
-	Metadata             *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Stats                []*Stat          `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Metadata *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Stats    []*Stat          `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	// Timestamp is the time the stats were sampled at.
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{13}
+	return fileDescriptor_b20a722d09fd3254, []int{17}
 }
 
 func (m *Stats) XXX_Unmarshal(b []byte) error {
@@ -818,6 +1117,13 @@ func (m *Stats) GetStats() []*Stat {
 	return nil
 }
 
+func (m *Stats) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 type StatsResponse struct {
 	Messages             []*Stats `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -829,7 +1135,7 @@ func (m *StatsResponse) Reset()         { *m = StatsResponse{} }
 func (m *StatsResponse) String() string { return proto.CompactTextString(m) }
 func (*StatsResponse) ProtoMessage()    {}
 func (*StatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{14}
+	return fileDescriptor_b20a722d09fd3254, []int{18}
 }
 
 func (m *StatsResponse) XXX_Unmarshal(b []byte) error {
@@ -862,13 +1168,23 @@ func (m *StatsResponse) GetMessages() []*Stats {
 }
 
 // The messages message containing the requested stat.
+//
+// CPU usage (in nanoseconds), IO and network counters are cumulative.
+// Network counters are only reported for the containers which have own
+// network namespace (once per pod).
 type Stat struct {
-	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	MemoryUsage          uint64   `protobuf:"varint,4,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
-	CpuUsage             uint64   `protobuf:"varint,5,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
-	PodId                string   `protobuf:"bytes,6,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`
-	Name                 string   `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace   string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	MemoryUsage uint64 `protobuf:"varint,4,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
+	CpuUsage    uint64 `protobuf:"varint,5,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
+	PodId       string `protobuf:"bytes,6,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`
+	Name        string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	// IO counters are only reported with the containerd driver, they are
+	// always 0 with the CRI driver.
+	IoReadBytes          uint64   `protobuf:"varint,8,opt,name=io_read_bytes,json=ioReadBytes,proto3" json:"io_read_bytes,omitempty"`
+	IoWriteBytes         uint64   `protobuf:"varint,9,opt,name=io_write_bytes,json=ioWriteBytes,proto3" json:"io_write_bytes,omitempty"`
+	NetworkRxBytes       uint64   `protobuf:"varint,10,opt,name=network_rx_bytes,json=networkRxBytes,proto3" json:"network_rx_bytes,omitempty"`
+	NetworkTxBytes       uint64   `protobuf:"varint,11,opt,name=network_tx_bytes,json=networkTxBytes,proto3" json:"network_tx_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -878,7 +1194,7 @@ func (m *Stat) Reset()         { *m = Stat{} }
 func (m *Stat) String() string { return proto.CompactTextString(m) }
 func (*Stat) ProtoMessage()    {}
 func (*Stat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{15}
+	return fileDescriptor_b20a722d09fd3254, []int{19}
 }
 
 func (m *Stat) XXX_Unmarshal(b []byte) error {
@@ -945,9 +1261,99 @@ func (m *Stat) GetName() string {
 	return ""
 }
 
+func (m *Stat) GetIoReadBytes() uint64 {
+	if m != nil {
+		return m.IoReadBytes
+	}
+	return 0
+}
+
+func (m *Stat) GetIoWriteBytes() uint64 {
+	if m != nil {
+		return m.IoWriteBytes
+	}
+	return 0
+}
+
+func (m *Stat) GetNetworkRxBytes() uint64 {
+	if m != nil {
+		return m.NetworkRxBytes
+	}
+	return 0
+}
+
+func (m *Stat) GetNetworkTxBytes() uint64 {
+	if m != nil {
+		return m.NetworkTxBytes
+	}
+	return 0
+}
+
+type StatsWatchRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// driver might be default "containerd" or "cri"
+	Driver common.ContainerDriver `protobuf:"varint,2,opt,name=driver,proto3,enum=common.ContainerDriver" json:"driver,omitempty"`
+	// Interval between the samples, defaults to 1 second.
+	Interval             *duration.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *StatsWatchRequest) Reset()         { *m = StatsWatchRequest{} }
+func (m *StatsWatchRequest) String() string { return proto.CompactTextString(m) }
+func (*StatsWatchRequest) ProtoMessage()    {}
+func (*StatsWatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b20a722d09fd3254, []int{20}
+}
+
+func (m *StatsWatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsWatchRequest.Unmarshal(m, b)
+}
+
+func (m *StatsWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsWatchRequest.Marshal(b, m, deterministic)
+}
+
+func (m *StatsWatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsWatchRequest.Merge(m, src)
+}
+
+func (m *StatsWatchRequest) XXX_Size() int {
+	return xxx_messageInfo_StatsWatchRequest.Size(m)
+}
+
+func (m *StatsWatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsWatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsWatchRequest proto.InternalMessageInfo
+
+func (m *StatsWatchRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *StatsWatchRequest) GetDriver() common.ContainerDriver {
+	if m != nil {
+		return m.Driver
+	}
+	return common.ContainerDriver_CONTAINERD
+}
+
+func (m *StatsWatchRequest) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
 type Memory struct {
 	Metadata             *common.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Meminfo              *MemInfo         `protobuf:"bytes,2,opt,name=meminfo,proto3" json:"meminfo,omitempty"`
+	Swaps                []*SwapArea      `protobuf:"bytes,3,rep,name=swaps,proto3" json:"swaps,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -957,7 +1363,7 @@ func (m *Memory) Reset()         { *m = Memory{} }
 func (m *Memory) String() string { return proto.CompactTextString(m) }
 func (*Memory) ProtoMessage()    {}
 func (*Memory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{16}
+	return fileDescriptor_b20a722d09fd3254, []int{21}
 }
 
 func (m *Memory) XXX_Unmarshal(b []byte) error {
@@ -996,6 +1402,160 @@ func (m *Memory) GetMeminfo() *MemInfo {
 	return nil
 }
 
+func (m *Memory) GetSwaps() []*SwapArea {
+	if m != nil {
+		return m.Swaps
+	}
+	return nil
+}
+
+// SwapArea describes an active swap area.
+type SwapArea struct {
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Type is either partition or file.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Size and used are in kB.
+	Size     uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Used     uint64 `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"`
+	Priority int32  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Zram describes the compression of zram devices.
+	Zram                 *ZramStats `protobuf:"bytes,6,opt,name=zram,proto3" json:"zram,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SwapArea) Reset()         { *m = SwapArea{} }
+func (m *SwapArea) String() string { return proto.CompactTextString(m) }
+func (*SwapArea) ProtoMessage()    {}
+func (*SwapArea) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b20a722d09fd3254, []int{22}
+}
+
+func (m *SwapArea) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwapArea.Unmarshal(m, b)
+}
+
+func (m *SwapArea) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwapArea.Marshal(b, m, deterministic)
+}
+
+func (m *SwapArea) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapArea.Merge(m, src)
+}
+
+func (m *SwapArea) XXX_Size() int {
+	return xxx_messageInfo_SwapArea.Size(m)
+}
+
+func (m *SwapArea) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapArea.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapArea proto.InternalMessageInfo
+
+func (m *SwapArea) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *SwapArea) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *SwapArea) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *SwapArea) GetUsed() uint64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+func (m *SwapArea) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *SwapArea) GetZram() *ZramStats {
+	if m != nil {
+		return m.Zram
+	}
+	return nil
+}
+
+type ZramStats struct {
+	Algorithm string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// Sizes are in bytes.
+	OrigDataSize         uint64   `protobuf:"varint,2,opt,name=orig_data_size,json=origDataSize,proto3" json:"orig_data_size,omitempty"`
+	ComprDataSize        uint64   `protobuf:"varint,3,opt,name=compr_data_size,json=comprDataSize,proto3" json:"compr_data_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZramStats) Reset()         { *m = ZramStats{} }
+func (m *ZramStats) String() string { return proto.CompactTextString(m) }
+func (*ZramStats) ProtoMessage()    {}
+func (*ZramStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b20a722d09fd3254, []int{23}
+}
+
+func (m *ZramStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZramStats.Unmarshal(m, b)
+}
+
+func (m *ZramStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZramStats.Marshal(b, m, deterministic)
+}
+
+func (m *ZramStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZramStats.Merge(m, src)
+}
+
+func (m *ZramStats) XXX_Size() int {
+	return xxx_messageInfo_ZramStats.Size(m)
+}
+
+func (m *ZramStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZramStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZramStats proto.InternalMessageInfo
+
+func (m *ZramStats) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
+func (m *ZramStats) GetOrigDataSize() uint64 {
+	if m != nil {
+		return m.OrigDataSize
+	}
+	return 0
+}
+
+func (m *ZramStats) GetComprDataSize() uint64 {
+	if m != nil {
+		return m.ComprDataSize
+	}
+	return 0
+}
+
 type MemoryResponse struct {
 	Messages             []*Memory `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -1007,7 +1567,7 @@ func (m *MemoryResponse) Reset()         { *m = MemoryResponse{} }
 func (m *MemoryResponse) String() string { return proto.CompactTextString(m) }
 func (*MemoryResponse) ProtoMessage()    {}
 func (*MemoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{17}
+	return fileDescriptor_b20a722d09fd3254, []int{24}
 }
 
 func (m *MemoryResponse) XXX_Unmarshal(b []byte) error {
@@ -1097,7 +1657,7 @@ func (m *MemInfo) Reset()         { *m = MemInfo{} }
 func (m *MemInfo) String() string { return proto.CompactTextString(m) }
 func (*MemInfo) ProtoMessage()    {}
 func (*MemInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b20a722d09fd3254, []int{18}
+	return fileDescriptor_b20a722d09fd3254, []int{25}
 }
 
 func (m *MemInfo) XXX_Unmarshal(b []byte) error {
@@ -1464,6 +2024,10 @@ func init() {
 	proto.RegisterType((*Container)(nil), "os.Container")
 	proto.RegisterType((*ContainersResponse)(nil), "os.ContainersResponse")
 	proto.RegisterType((*DmesgRequest)(nil), "os.DmesgRequest")
+	proto.RegisterType((*ExecStart)(nil), "os.ExecStart")
+	proto.RegisterType((*ExecResize)(nil), "os.ExecResize")
+	proto.RegisterType((*ExecRequest)(nil), "os.ExecRequest")
+	proto.RegisterType((*ExecResponse)(nil), "os.ExecResponse")
 	proto.RegisterType((*ProcessesRequest)(nil), "os.ProcessesRequest")
 	proto.RegisterType((*ProcessesResponse)(nil), "os.ProcessesResponse")
 	proto.RegisterType((*Process)(nil), "os.Process")
@@ -1475,7 +2039,10 @@ func init() {
 	proto.RegisterType((*Stats)(nil), "os.Stats")
 	proto.RegisterType((*StatsResponse)(nil), "os.StatsResponse")
 	proto.RegisterType((*Stat)(nil), "os.Stat")
+	proto.RegisterType((*StatsWatchRequest)(nil), "os.StatsWatchRequest")
 	proto.RegisterType((*Memory)(nil), "os.Memory")
+	proto.RegisterType((*SwapArea)(nil), "os.SwapArea")
+	proto.RegisterType((*ZramStats)(nil), "os.ZramStats")
 	proto.RegisterType((*MemoryResponse)(nil), "os.MemoryResponse")
 	proto.RegisterType((*MemInfo)(nil), "os.MemInfo")
 }
@@ -1483,97 +2050,130 @@ func init() {
 func init() { proto.RegisterFile("os/os.proto", fileDescriptor_b20a722d09fd3254) }
 
 var fileDescriptor_b20a722d09fd3254 = []byte{
-	// 1437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdf, 0x6e, 0xdb, 0xb6,
-	0x17, 0x86, 0x13, 0x3b, 0x8e, 0xe9, 0xc4, 0x49, 0xd8, 0x26, 0x65, 0xd3, 0xfe, 0xfa, 0x4b, 0xbd,
-	0xa6, 0x4d, 0xb7, 0xd4, 0x6e, 0xb3, 0x62, 0x1b, 0xba, 0x02, 0xc3, 0xba, 0xee, 0xa2, 0x17, 0x45,
-	0x0b, 0x75, 0xbd, 0x19, 0x50, 0x04, 0xb4, 0x44, 0x3b, 0x44, 0x44, 0x51, 0x13, 0x29, 0x67, 0xd9,
-	0x2b, 0xec, 0x15, 0x06, 0xec, 0x76, 0x6f, 0xb2, 0xd7, 0x1a, 0xce, 0x21, 0x25, 0x53, 0x09, 0xb6,
-	0x35, 0x17, 0xbb, 0xb2, 0xce, 0x77, 0x3e, 0x1d, 0x9e, 0x3f, 0x1f, 0x29, 0x9a, 0xf4, 0xb5, 0x19,
-	0x6b, 0x33, 0xca, 0x0b, 0x6d, 0x35, 0x5d, 0xd2, 0x66, 0xf7, 0xd6, 0x4c, 0xeb, 0x59, 0x2a, 0xc6,
-	0x88, 0x4c, 0xca, 0xe9, 0x58, 0xa8, 0xdc, 0x9e, 0x3b, 0xc2, 0xee, 0xb5, 0x58, 0x2b, 0xa5, 0xb3,
-	0xb1, 0xfb, 0x71, 0xe0, 0x70, 0x42, 0xb6, 0xbe, 0xd3, 0x99, 0xe5, 0x32, 0x13, 0x85, 0x89, 0xc4,
-	0x4f, 0xa5, 0x30, 0x96, 0xde, 0x26, 0xbd, 0x8c, 0x2b, 0x61, 0x72, 0x1e, 0x0b, 0xd6, 0xda, 0x6b,
-	0x1d, 0xf4, 0xa2, 0x05, 0x40, 0xc7, 0x64, 0x25, 0x29, 0xe4, 0x5c, 0x14, 0x6c, 0x69, 0xaf, 0x75,
-	0x30, 0x38, 0xba, 0x31, 0xf2, 0x11, 0xeb, 0x40, 0x2f, 0xd1, 0x1d, 0x79, 0xda, 0xf0, 0x8f, 0x16,
-	0x59, 0xaf, 0x7d, 0xaf, 0xb2, 0xa9, 0xfe, 0x97, 0x05, 0x06, 0x64, 0x49, 0x26, 0x18, 0xbc, 0x17,
-	0x2d, 0xc9, 0x84, 0x5e, 0x27, 0x1d, 0xa9, 0xf8, 0x4c, 0xb0, 0x65, 0x84, 0x9c, 0x41, 0x37, 0xc9,
-	0x72, 0x2e, 0x13, 0xd6, 0xde, 0x6b, 0x1d, 0xac, 0x47, 0xf0, 0x48, 0x77, 0xc8, 0x8a, 0xb1, 0xdc,
-	0x96, 0x86, 0x75, 0x90, 0xe8, 0x2d, 0xba, 0x4d, 0x56, 0x72, 0x9d, 0x1c, 0xcb, 0x84, 0xad, 0xb8,
-	0x00, 0xb9, 0x4e, 0x5e, 0x25, 0x94, 0x92, 0x36, 0xac, 0xc9, 0xba, 0x08, 0xe2, 0xf3, 0x30, 0x25,
-	0xbd, 0x3a, 0x53, 0x7a, 0x48, 0x56, 0x95, 0xb0, 0x3c, 0xe1, 0x96, 0x63, 0x92, 0xfd, 0xa3, 0xcd,
-	0xaa, 0xd4, 0xd7, 0x1e, 0x8f, 0x6a, 0x06, 0x7d, 0x42, 0x48, 0x5c, 0x77, 0x92, 0x2d, 0xed, 0x2d,
-	0x1f, 0xf4, 0x8f, 0xb6, 0x46, 0xda, 0x8c, 0x1a, 0xa5, 0x47, 0x01, 0x69, 0xf8, 0x0d, 0xa1, 0x61,
-	0xf3, 0x4d, 0xae, 0x33, 0x23, 0xe8, 0x43, 0x58, 0xd6, 0x18, 0x3e, 0x13, 0x86, 0xb5, 0x30, 0xcc,
-	0x7a, 0x23, 0x4c, 0x54, 0xbb, 0x87, 0xcf, 0xc8, 0xda, 0x4b, 0x25, 0xcc, 0xac, 0x1a, 0xdc, 0x0e,
-	0x59, 0x99, 0xea, 0x34, 0xd5, 0x67, 0x98, 0xef, 0x6a, 0xe4, 0x2d, 0x28, 0xd5, 0x72, 0x99, 0x62,
-	0x4f, 0x57, 0x23, 0x7c, 0x1e, 0x52, 0xb2, 0xf9, 0xb6, 0xd0, 0xb1, 0x30, 0x46, 0x54, 0x83, 0x1f,
-	0x3e, 0x27, 0x5b, 0x01, 0xe6, 0xf3, 0x79, 0x70, 0x29, 0x9f, 0x3e, 0xe4, 0xe3, 0x89, 0x41, 0x36,
-	0x53, 0xd2, 0xf5, 0xe0, 0x15, 0x5b, 0xf7, 0x88, 0xf4, 0xf2, 0x6a, 0x59, 0xdf, 0xb9, 0x8d, 0x60,
-	0x09, 0xec, 0xdb, 0x82, 0x31, 0xfc, 0x6d, 0x89, 0xf4, 0x03, 0x57, 0xa5, 0x04, 0x58, 0xa7, 0xe3,
-	0x94, 0x40, 0x49, 0x3b, 0xcf, 0xbd, 0x86, 0x3a, 0x11, 0x3e, 0x83, 0x8a, 0x40, 0x0f, 0xb5, 0x8a,
-	0xd0, 0xa0, 0x8c, 0x74, 0xed, 0x49, 0x21, 0x78, 0x62, 0x50, 0x49, 0x9d, 0xa8, 0x32, 0xe9, 0x4d,
-	0xb2, 0x1a, 0xe7, 0xe5, 0xb1, 0x95, 0x4a, 0xa0, 0x9e, 0x5a, 0x51, 0x37, 0xce, 0xcb, 0x1f, 0xa4,
-	0x12, 0x74, 0x9f, 0x0c, 0xe6, 0xb2, 0xb0, 0x25, 0x4f, 0x8f, 0x95, 0x50, 0xba, 0x38, 0x47, 0x61,
-	0xb5, 0xa3, 0x75, 0x8f, 0xbe, 0x46, 0x90, 0x3e, 0x20, 0x1b, 0x85, 0x30, 0x32, 0x11, 0x99, 0xad,
-	0x78, 0x5d, 0xe4, 0x0d, 0x2a, 0xd8, 0x13, 0x19, 0xe9, 0x42, 0x73, 0x78, 0x96, 0xb0, 0x55, 0x4c,
-	0xae, 0x32, 0xe9, 0x1d, 0x42, 0xc4, 0xcf, 0x22, 0x2e, 0x2d, 0x9f, 0xa4, 0x82, 0xf5, 0xd0, 0x19,
-	0x20, 0x50, 0x28, 0x2f, 0x66, 0x86, 0x11, 0xa7, 0x61, 0x78, 0x1e, 0x6a, 0x32, 0x88, 0x84, 0xb1,
-	0xbc, 0xb0, 0x1f, 0xb7, 0x9f, 0x2f, 0x6e, 0xb7, 0xc5, 0xfe, 0x5e, 0xfe, 0xb8, 0xfd, 0xfd, 0x25,
-	0xe9, 0xfa, 0x05, 0xaf, 0x36, 0xf7, 0xe1, 0x33, 0xb2, 0x51, 0x67, 0xfa, 0xcf, 0x62, 0xab, 0x68,
-	0x0b, 0xb1, 0x7d, 0x20, 0x6b, 0xef, 0x2c, 0xb7, 0xff, 0xd5, 0x99, 0xf5, 0x9e, 0x74, 0x30, 0xfc,
-	0x15, 0x95, 0x7c, 0xc7, 0x89, 0xac, 0x52, 0xf1, 0x2a, 0xe4, 0x0e, 0x71, 0x9c, 0xdc, 0xcc, 0xf0,
-	0x0b, 0xb2, 0xee, 0xb3, 0xf6, 0xf5, 0xee, 0x5f, 0xaa, 0xb7, 0x57, 0xbd, 0x13, 0x6e, 0xad, 0xdf,
-	0x5b, 0xa4, 0x0d, 0xd8, 0x15, 0x47, 0x79, 0x97, 0xac, 0x39, 0xe1, 0x1d, 0x97, 0x10, 0x07, 0x25,
-	0xde, 0x8e, 0xfa, 0x0e, 0x7b, 0x0f, 0x10, 0xbd, 0x45, 0x7a, 0x20, 0x73, 0xe7, 0xef, 0xa0, 0x1f,
-	0x74, 0xef, 0x9c, 0x57, 0x38, 0x39, 0x3f, 0x90, 0x15, 0xaf, 0xe6, 0xab, 0x75, 0x6c, 0x9f, 0x74,
-	0x95, 0x50, 0x32, 0x9b, 0x6a, 0xcc, 0xdb, 0xcf, 0xfb, 0xb5, 0x50, 0xb8, 0xeb, 0x2b, 0xdf, 0xf0,
-	0x2b, 0x32, 0x70, 0xe1, 0xeb, 0xce, 0xdd, 0xbf, 0xd4, 0x39, 0xe2, 0xdf, 0x04, 0xd6, 0xa2, 0x75,
-	0xbf, 0xae, 0x91, 0xae, 0x0f, 0x47, 0x77, 0xe1, 0x1d, 0x65, 0xb5, 0xe5, 0x29, 0xa6, 0xd6, 0x8e,
-	0x6a, 0x1b, 0x36, 0xa1, 0x12, 0x6a, 0x5a, 0x08, 0x81, 0x89, 0xb4, 0xa3, 0xca, 0xa4, 0x43, 0xec,
-	0x22, 0x9f, 0x73, 0x99, 0xe2, 0x36, 0x5c, 0x46, 0x77, 0x03, 0x83, 0xb7, 0x27, 0xe5, 0x74, 0x0a,
-	0x47, 0xbf, 0x6b, 0x72, 0x65, 0xc2, 0x99, 0x1c, 0xf3, 0xf8, 0x44, 0x24, 0xbe, 0xbb, 0xde, 0x82,
-	0xad, 0x6d, 0xce, 0x78, 0xee, 0x7d, 0xee, 0x00, 0x09, 0x10, 0x78, 0x8f, 0xc7, 0x56, 0xce, 0x85,
-	0x3f, 0x34, 0xbc, 0x05, 0x35, 0xc8, 0xcc, 0x7b, 0x56, 0x5d, 0x0d, 0x95, 0x0d, 0x31, 0xdd, 0x13,
-	0xcf, 0x74, 0x86, 0xc7, 0x45, 0x3b, 0x0a, 0x10, 0xa8, 0xa4, 0xe2, 0x22, 0x83, 0xb8, 0x4a, 0x42,
-	0x6c, 0x11, 0x63, 0x2a, 0x53, 0xc1, 0xfa, 0x61, 0x0c, 0x40, 0xc2, 0x18, 0xc8, 0x58, 0x6b, 0xc6,
-	0x40, 0xce, 0x1e, 0xe9, 0x97, 0x99, 0x98, 0xcb, 0xd8, 0x9d, 0x5b, 0xeb, 0x4e, 0x76, 0x01, 0x84,
-	0xdd, 0x4e, 0x75, 0x7c, 0x2a, 0x12, 0x36, 0xf0, 0xdd, 0x76, 0x26, 0x28, 0x1c, 0xba, 0xe0, 0x86,
-	0xb4, 0x81, 0xbe, 0x05, 0x00, 0xd5, 0x83, 0x81, 0x63, 0xda, 0x74, 0xd5, 0x57, 0x36, 0x9c, 0xf0,
-	0x89, 0x2c, 0xec, 0x39, 0xdb, 0x42, 0x87, 0x33, 0x20, 0xde, 0x59, 0x21, 0xad, 0x98, 0xf0, 0xf8,
-	0x94, 0x51, 0x17, 0xaf, 0x06, 0xc0, 0x0b, 0x55, 0xe7, 0x28, 0xa3, 0x6b, 0xce, 0x5b, 0x03, 0x30,
-	0x03, 0xc5, 0xf3, 0x5c, 0x24, 0xec, 0xba, 0x9b, 0x81, 0xb3, 0xf0, 0x5b, 0x72, 0xa2, 0x84, 0x62,
-	0xdb, 0x6e, 0x25, 0x34, 0x60, 0x5b, 0x98, 0x94, 0x4f, 0xd8, 0x0e, 0x82, 0xf8, 0x0c, 0xdd, 0x32,
-	0x85, 0x88, 0x53, 0x2e, 0x15, 0xb6, 0xe2, 0x86, 0xeb, 0x56, 0x88, 0xa1, 0x12, 0xca, 0xcc, 0x23,
-	0x8c, 0x79, 0x25, 0xd4, 0x08, 0x74, 0xf3, 0x54, 0x14, 0x99, 0x48, 0x8d, 0x85, 0x1a, 0x6e, 0xba,
-	0x6e, 0x06, 0x10, 0x44, 0x80, 0x84, 0xb1, 0xb5, 0x86, 0xed, 0xba, 0x08, 0x0b, 0x04, 0x22, 0x64,
-	0x53, 0x53, 0x66, 0xc6, 0xcd, 0xe3, 0x96, 0x8b, 0x10, 0x40, 0x50, 0xe9, 0x44, 0x97, 0x59, 0x2c,
-	0xd8, 0x6d, 0x57, 0xa9, 0xb3, 0x20, 0xff, 0xba, 0x59, 0x56, 0xe5, 0xec, 0x7f, 0x2e, 0xff, 0x10,
-	0x83, 0xe8, 0xb0, 0xbf, 0xa5, 0x4d, 0xa5, 0x92, 0x96, 0xdd, 0x71, 0xd1, 0x03, 0x68, 0xc1, 0xb0,
-	0x22, 0xe1, 0x86, 0xfd, 0x3f, 0x64, 0x20, 0x04, 0xeb, 0xcc, 0x15, 0x4f, 0x53, 0x1d, 0xbb, 0xc1,
-	0xef, 0xb9, 0x75, 0x42, 0x0c, 0xa2, 0x78, 0xbb, 0x34, 0x22, 0x61, 0x77, 0x5d, 0x94, 0x00, 0x0a,
-	0xa2, 0xc4, 0x27, 0x65, 0x76, 0xca, 0x86, 0x8d, 0x28, 0x88, 0xd1, 0x43, 0xb2, 0x75, 0xc2, 0x8b,
-	0xe4, 0x8c, 0x17, 0x22, 0xd6, 0x45, 0x51, 0xe6, 0x56, 0x24, 0xec, 0x13, 0x24, 0x5e, 0x76, 0xd0,
-	0x7b, 0x64, 0x1d, 0xe4, 0x70, 0x52, 0xce, 0x84, 0xd3, 0xc8, 0x3d, 0xf7, 0xa5, 0x6f, 0x80, 0xf4,
-	0x3e, 0x19, 0xa0, 0x04, 0x16, 0xb4, 0x7d, 0xf7, 0xa1, 0x6f, 0xa2, 0x35, 0x2f, 0x57, 0x89, 0xd7,
-	0xd5, 0xfd, 0x80, 0x57, 0xa3, 0xa0, 0xf2, 0x58, 0x71, 0xd7, 0x89, 0x07, 0xfe, 0x4c, 0xf6, 0x36,
-	0x5e, 0x16, 0x14, 0xc7, 0x0d, 0x70, 0xe0, 0x76, 0x8e, 0x37, 0x21, 0x7a, 0xbd, 0x94, 0x7b, 0xf7,
-	0xa1, 0x8b, 0xde, 0x44, 0xa1, 0xa6, 0x1a, 0xc1, 0x38, 0x9f, 0xba, 0x9a, 0x1a, 0x60, 0x83, 0x55,
-	0x98, 0x79, 0xc2, 0x3e, 0xbb, 0xc0, 0x02, 0xb0, 0xc1, 0x32, 0x65, 0x91, 0xb3, 0xc3, 0x0b, 0x2c,
-	0x00, 0x61, 0x2e, 0x35, 0x20, 0x7f, 0x11, 0xec, 0x91, 0x9b, 0x4b, 0x88, 0xc1, 0x74, 0x13, 0x59,
-	0x88, 0xd8, 0x2a, 0x9e, 0x3f, 0x3d, 0x65, 0x23, 0x37, 0xdd, 0x00, 0x6a, 0x30, 0x8e, 0x14, 0x1b,
-	0x5f, 0x60, 0x1c, 0xa9, 0x06, 0xe3, 0xc9, 0x8c, 0x3d, 0xbe, 0xc0, 0x78, 0x32, 0x3b, 0xfa, 0x73,
-	0x89, 0xf4, 0xde, 0xbc, 0x7b, 0x27, 0x8a, 0xb9, 0x8c, 0x05, 0xfd, 0x9a, 0x90, 0xc5, 0x05, 0x9c,
-	0x6e, 0x37, 0xae, 0xd9, 0xd5, 0xcd, 0x62, 0x77, 0xe7, 0x22, 0x5c, 0xdf, 0xd3, 0x3b, 0x78, 0xf9,
-	0xa6, 0x9b, 0x40, 0x08, 0xef, 0xe1, 0xbb, 0x6b, 0xd5, 0x07, 0xef, 0x25, 0xb7, 0xfc, 0x71, 0x8b,
-	0x3e, 0xad, 0x3f, 0x8e, 0x3b, 0x23, 0xf7, 0x17, 0x6d, 0x54, 0xfd, 0x45, 0x1b, 0x7d, 0x0f, 0x7f,
-	0xd1, 0x76, 0x69, 0xf0, 0xed, 0xaa, 0x16, 0x78, 0x46, 0x7a, 0xf5, 0x6d, 0xfc, 0x6f, 0x5f, 0xdc,
-	0x0e, 0x2e, 0xca, 0xc1, 0xa5, 0xfd, 0x68, 0x71, 0x27, 0xa3, 0xe1, 0x05, 0xca, 0x27, 0x78, 0xad,
-	0x81, 0xf9, 0x77, 0x0e, 0xab, 0x3b, 0xcf, 0xe6, 0xe2, 0x0a, 0xe2, 0xf9, 0x5b, 0x01, 0xe2, 0xd8,
-	0x2f, 0x9e, 0xc3, 0xff, 0x1d, 0x05, 0x38, 0xcf, 0xe5, 0x8b, 0xce, 0x1b, 0xf3, 0x6d, 0x2e, 0xdf,
-	0xb6, 0x7e, 0xdc, 0x9f, 0x49, 0x7b, 0x52, 0x4e, 0xa0, 0x01, 0x63, 0xcb, 0x53, 0x6d, 0x1e, 0x99,
-	0x73, 0x63, 0x85, 0x32, 0xce, 0x1a, 0xf3, 0x5c, 0x8e, 0xb5, 0x99, 0xac, 0x60, 0x19, 0x9f, 0xff,
-	0x15, 0x00, 0x00, 0xff, 0xff, 0xef, 0x25, 0xa5, 0xa9, 0xc3, 0x0e, 0x00, 0x00,
+	// 1963 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x6f, 0x1c, 0xc7,
+	0xf1, 0xc7, 0x92, 0xbb, 0xe4, 0x6e, 0xed, 0x83, 0x64, 0xcb, 0x92, 0x47, 0x94, 0xff, 0x12, 0x35,
+	0xd6, 0x83, 0xfe, 0x47, 0x22, 0x25, 0xc6, 0x49, 0x0c, 0xc5, 0x40, 0x60, 0x59, 0x3e, 0xf8, 0x20,
+	0xd8, 0x18, 0x2a, 0x30, 0x60, 0x20, 0x58, 0xf4, 0xce, 0xf4, 0xee, 0x36, 0x38, 0x33, 0x3d, 0xe9,
+	0xee, 0x59, 0x92, 0xbe, 0xe5, 0x9c, 0x43, 0x90, 0x63, 0x80, 0x5c, 0x72, 0xf3, 0x97, 0xc8, 0x35,
+	0x9f, 0x2b, 0xa8, 0xea, 0x9e, 0xc7, 0x92, 0x79, 0x88, 0x08, 0x7c, 0xda, 0xa9, 0x5f, 0xfd, 0xb6,
+	0xba, 0x9e, 0xdd, 0x3d, 0x03, 0x43, 0x65, 0x8e, 0x95, 0x39, 0x2a, 0xb4, 0xb2, 0x8a, 0x6d, 0x28,
+	0xb3, 0x7f, 0x7f, 0xa1, 0xd4, 0x22, 0x15, 0xc7, 0x84, 0xcc, 0xca, 0xf9, 0x71, 0x52, 0x6a, 0x6e,
+	0xa5, 0xca, 0x1d, 0x67, 0xff, 0xde, 0x55, 0xbd, 0xc8, 0x0a, 0x7b, 0xe9, 0x95, 0x0f, 0xae, 0x2a,
+	0xad, 0xcc, 0x84, 0xb1, 0x3c, 0x2b, 0x3c, 0xe1, 0x56, 0xac, 0xb2, 0x4c, 0xe5, 0xc7, 0xee, 0xc7,
+	0x81, 0xe1, 0x0c, 0xf6, 0xbe, 0x54, 0xb9, 0xe5, 0x32, 0x17, 0xda, 0x44, 0xe2, 0xf7, 0xa5, 0x30,
+	0x96, 0x7d, 0x04, 0x83, 0x9c, 0x67, 0xc2, 0x14, 0x3c, 0x16, 0x41, 0xe7, 0xa0, 0x73, 0x38, 0x88,
+	0x1a, 0x80, 0x1d, 0xc3, 0x56, 0xa2, 0xe5, 0x4a, 0xe8, 0x60, 0xe3, 0xa0, 0x73, 0x38, 0x39, 0xf9,
+	0xf0, 0xc8, 0x5b, 0xac, 0x0d, 0xbd, 0x21, 0x75, 0xe4, 0x69, 0xe1, 0x8f, 0x1d, 0x18, 0xd7, 0xba,
+	0xaf, 0xf3, 0xb9, 0xfa, 0x2f, 0x0b, 0x4c, 0x60, 0x43, 0x26, 0x64, 0x7c, 0x10, 0x6d, 0xc8, 0x84,
+	0x7d, 0x00, 0x3d, 0x99, 0xf1, 0x85, 0x08, 0x36, 0x09, 0x72, 0x02, 0xdb, 0x85, 0xcd, 0x42, 0x26,
+	0x41, 0xf7, 0xa0, 0x73, 0x38, 0x8e, 0xf0, 0x91, 0xdd, 0x81, 0x2d, 0x63, 0xb9, 0x2d, 0x4d, 0xd0,
+	0x23, 0xa2, 0x97, 0xd8, 0x6d, 0xd8, 0x2a, 0x54, 0x32, 0x95, 0x49, 0xb0, 0xe5, 0x0c, 0x14, 0x2a,
+	0xf9, 0x3a, 0x61, 0x0c, 0xba, 0xb8, 0x66, 0xb0, 0x4d, 0x20, 0x3d, 0x87, 0x29, 0x0c, 0x6a, 0x4f,
+	0xd9, 0x33, 0xe8, 0x67, 0xc2, 0xf2, 0x84, 0x5b, 0x4e, 0x4e, 0x0e, 0x4f, 0x76, 0xab, 0x50, 0xdf,
+	0x7a, 0x3c, 0xaa, 0x19, 0xec, 0x25, 0x40, 0x5c, 0x67, 0x32, 0xd8, 0x38, 0xd8, 0x3c, 0x1c, 0x9e,
+	0xec, 0x1d, 0x29, 0x73, 0xb4, 0x16, 0x7a, 0xd4, 0x22, 0x85, 0xbf, 0x01, 0xd6, 0x4e, 0xbe, 0x29,
+	0x54, 0x6e, 0x04, 0xfb, 0x04, 0x97, 0x35, 0x86, 0x2f, 0x84, 0x09, 0x3a, 0x64, 0x66, 0xbc, 0x66,
+	0x26, 0xaa, 0xd5, 0xe1, 0x2b, 0x18, 0xbd, 0xc9, 0x84, 0x59, 0x54, 0x85, 0xbb, 0x03, 0x5b, 0x73,
+	0x95, 0xa6, 0xea, 0x9c, 0xfc, 0xed, 0x47, 0x5e, 0xc2, 0x50, 0x2d, 0x97, 0x29, 0xe5, 0xb4, 0x1f,
+	0xd1, 0x73, 0xf8, 0x8f, 0x0e, 0x0c, 0xbe, 0xba, 0x10, 0xf1, 0xa9, 0xe5, 0xda, 0xde, 0xb0, 0x22,
+	0x4d, 0x0b, 0x6c, 0xbe, 0x57, 0x0b, 0xa0, 0x03, 0x5c, 0x2f, 0x4c, 0xd0, 0x3d, 0xd8, 0xc4, 0x5c,
+	0xe3, 0x33, 0x16, 0x50, 0xe4, 0xab, 0xa0, 0x47, 0x10, 0x3e, 0x22, 0x62, 0xed, 0x25, 0x55, 0xa9,
+	0x1f, 0xe1, 0x23, 0x0b, 0xa1, 0x6b, 0xe4, 0x0f, 0xae, 0x46, 0xc3, 0x93, 0x09, 0xe6, 0x01, 0x7d,
+	0x8e, 0x04, 0xa2, 0x11, 0xe9, 0xc2, 0x57, 0x00, 0x0d, 0x86, 0xcd, 0x72, 0x2e, 0x13, 0xbb, 0xa4,
+	0x20, 0xc6, 0x91, 0x13, 0x30, 0x31, 0x4b, 0x21, 0x17, 0x4b, 0x4b, 0x41, 0x8c, 0x23, 0x2f, 0x85,
+	0x7f, 0xee, 0xc0, 0xd0, 0xfd, 0xd9, 0x25, 0xf0, 0x63, 0xe8, 0x19, 0xcc, 0x87, 0xaf, 0xf7, 0xb8,
+	0x5a, 0x90, 0x92, 0x14, 0x39, 0x1d, 0x2e, 0x61, 0x6c, 0x22, 0x73, 0xb2, 0x35, 0x8a, 0x9c, 0xc0,
+	0x1e, 0xc0, 0x30, 0x4e, 0x95, 0x11, 0x53, 0xa7, 0xdb, 0xa4, 0x20, 0x80, 0xa0, 0x53, 0x22, 0x3c,
+	0x81, 0x2d, 0x4d, 0x3e, 0x06, 0xdd, 0x7f, 0x19, 0x8d, 0xd7, 0x86, 0x7f, 0xeb, 0xc0, 0xc8, 0xc3,
+	0xae, 0x21, 0x6e, 0xd6, 0x87, 0x34, 0x05, 0x89, 0x2a, 0xad, 0x77, 0xcf, 0x4b, 0x1e, 0x17, 0xda,
+	0xd5, 0xcc, 0xe1, 0x42, 0x6b, 0xc4, 0xc5, 0x85, 0xb4, 0xc2, 0x8d, 0x52, 0x3f, 0xf2, 0x12, 0xbb,
+	0x07, 0x03, 0x7c, 0x9a, 0xc6, 0x2a, 0x11, 0x34, 0x50, 0xe3, 0xa8, 0x8f, 0xc0, 0x97, 0x2a, 0x11,
+	0x21, 0x83, 0xdd, 0x6f, 0xb5, 0x8a, 0x85, 0x31, 0xa2, 0xda, 0x35, 0xc2, 0xcf, 0x61, 0xaf, 0x85,
+	0x79, 0xdf, 0x9f, 0x5e, 0x6b, 0xe6, 0x21, 0x86, 0xed, 0x89, 0xad, 0x56, 0x9e, 0xc3, 0xb6, 0x07,
+	0x6f, 0x18, 0xef, 0x73, 0x18, 0x14, 0xd5, 0xb2, 0x7e, 0xec, 0x76, 0x5a, 0x4b, 0xd0, 0xd0, 0x35,
+	0x8c, 0xf0, 0xaf, 0x1b, 0x30, 0x6c, 0xa9, 0xaa, 0x6d, 0x04, 0xd7, 0xe9, 0xb9, 0x6d, 0x84, 0x41,
+	0xb7, 0x28, 0x7c, 0xbb, 0xf7, 0x22, 0x7a, 0x76, 0x25, 0xe7, 0xb6, 0xde, 0x82, 0x48, 0x60, 0x01,
+	0x6c, 0xdb, 0xa5, 0x16, 0x3c, 0x31, 0x94, 0xbb, 0x5e, 0x54, 0x89, 0xec, 0x2e, 0xf4, 0xe3, 0xa2,
+	0x9c, 0xe2, 0x16, 0x4c, 0xb9, 0xeb, 0x44, 0xdb, 0x71, 0x51, 0xbe, 0x93, 0x99, 0x60, 0x8f, 0x61,
+	0xb2, 0x92, 0xda, 0x96, 0x3c, 0x9d, 0x66, 0x22, 0x53, 0xda, 0xf5, 0x7b, 0x37, 0x1a, 0x7b, 0xf4,
+	0x2d, 0x81, 0xec, 0x29, 0xec, 0x60, 0x3f, 0x24, 0x22, 0xb7, 0x15, 0x6f, 0x9b, 0x78, 0x93, 0x0a,
+	0xf6, 0xc4, 0x00, 0xb6, 0x31, 0x39, 0x3c, 0x4f, 0x82, 0x3e, 0x39, 0x57, 0x89, 0xec, 0x3e, 0x80,
+	0xb8, 0x10, 0x71, 0x69, 0xf9, 0x2c, 0x15, 0xc1, 0x80, 0x94, 0x2d, 0xa4, 0x1e, 0x4a, 0x20, 0x0d,
+	0x3d, 0x87, 0x0a, 0x26, 0x91, 0xa0, 0x36, 0x7f, 0xbf, 0xc3, 0xe0, 0x7f, 0xdd, 0x19, 0xc2, 0x5f,
+	0xc1, 0xb6, 0x5f, 0xf0, 0x66, 0x75, 0x0f, 0x5f, 0xc1, 0x4e, 0xed, 0xe9, 0x7f, 0x6e, 0xb6, 0x8a,
+	0xd6, 0x34, 0xdb, 0xef, 0x60, 0x74, 0x6a, 0xb9, 0xfd, 0xa9, 0x0e, 0xbc, 0x3f, 0x75, 0xa0, 0x47,
+	0xf6, 0x6f, 0xd8, 0xca, 0xf7, 0x5d, 0x97, 0x55, 0x6d, 0xdc, 0x47, 0xe7, 0xd1, 0x8e, 0xeb, 0x37,
+	0xc3, 0x3e, 0x83, 0x41, 0x7d, 0xa8, 0x53, 0x7e, 0x87, 0x27, 0xfb, 0x47, 0xee, 0xd8, 0x3f, 0xaa,
+	0x8e, 0xfd, 0xa3, 0x77, 0x15, 0x23, 0x6a, 0xc8, 0xe1, 0x2f, 0x61, 0xec, 0x03, 0xf6, 0xa9, 0x7a,
+	0x7c, 0x2d, 0x55, 0x83, 0x6a, 0xb5, 0xf6, 0x54, 0xfe, 0x7d, 0x03, 0xba, 0x88, 0xdd, 0xb0, 0x0b,
+	0x1e, 0xc2, 0xc8, 0xf5, 0xec, 0xb4, 0x44, 0x3b, 0x34, 0x1d, 0xdd, 0x68, 0xe8, 0xb0, 0xdf, 0x22,
+	0x84, 0xdb, 0x0b, 0x4e, 0x88, 0xd3, 0xf7, 0x48, 0x8f, 0x23, 0xe3, 0x94, 0xef, 0x7f, 0x62, 0xb3,
+	0x10, 0xc6, 0x52, 0x4d, 0x71, 0xea, 0xa6, 0xb3, 0x4b, 0x2b, 0x0c, 0x0d, 0x41, 0x37, 0x1a, 0x4a,
+	0x15, 0x09, 0x9e, 0xbc, 0x46, 0x88, 0x3d, 0x82, 0x89, 0x54, 0xd3, 0x73, 0x2d, 0xad, 0xf0, 0xa4,
+	0x01, 0x91, 0x46, 0x52, 0x7d, 0x87, 0xa0, 0x63, 0x1d, 0xc2, 0x6e, 0x2e, 0xec, 0xb9, 0xd2, 0x67,
+	0x53, 0x7d, 0xe1, 0x79, 0xe0, 0x46, 0xce, 0xe3, 0xd1, 0xc5, 0x35, 0xa6, 0xad, 0x98, 0xc3, 0x35,
+	0xe6, 0x3b, 0xc7, 0x0c, 0xff, 0xd2, 0x81, 0x3d, 0xca, 0xe9, 0x77, 0xdc, 0xc6, 0xcb, 0x9f, 0xa6,
+	0xdd, 0xd8, 0x2f, 0xa0, 0x2f, 0x73, 0x2b, 0xf4, 0x8a, 0xa7, 0xbe, 0x2b, 0xee, 0x5e, 0xeb, 0x8a,
+	0x37, 0xfe, 0x26, 0x19, 0xd5, 0xd4, 0xf0, 0x0f, 0x1d, 0xd8, 0xf2, 0x7b, 0xc8, 0xcd, 0xda, 0xf4,
+	0x31, 0x6c, 0x67, 0x22, 0x93, 0xf9, 0x5c, 0x91, 0x87, 0x7e, 0xca, 0xde, 0x8a, 0x8c, 0xf6, 0xda,
+	0x4a, 0xc7, 0x42, 0xe8, 0x99, 0x73, 0x5e, 0x98, 0x60, 0x93, 0xfa, 0x6b, 0x44, 0xfd, 0x75, 0xce,
+	0x8b, 0x2f, 0xb4, 0xe0, 0x91, 0x53, 0xe1, 0x59, 0xd7, 0xaf, 0x30, 0xb6, 0x0f, 0xfd, 0xb9, 0x4c,
+	0x05, 0x95, 0xd8, 0x65, 0xa5, 0x96, 0xe9, 0x06, 0x73, 0x59, 0x08, 0xdf, 0x63, 0xf4, 0x8c, 0x18,
+	0x1d, 0xa7, 0x9b, 0x94, 0x7a, 0x7a, 0x46, 0xac, 0x34, 0xfe, 0x2c, 0xeb, 0x46, 0xf4, 0x8c, 0x76,
+	0x0b, 0x2d, 0x95, 0x96, 0xf6, 0x92, 0x3a, 0xad, 0x17, 0xd5, 0x32, 0x7b, 0x08, 0xdd, 0x1f, 0x34,
+	0xcf, 0x82, 0xad, 0xe6, 0xbc, 0xff, 0x5e, 0xf3, 0xcc, 0xcd, 0x01, 0xa9, 0xc2, 0x73, 0x18, 0xd4,
+	0x10, 0x96, 0x8e, 0xa7, 0x0b, 0xfc, 0xef, 0x32, 0xab, 0x4a, 0x57, 0x03, 0xd8, 0x68, 0x4a, 0xcb,
+	0xc5, 0x14, 0xd3, 0x34, 0x25, 0xdf, 0x36, 0x5c, 0xa3, 0x21, 0xfa, 0x86, 0x5b, 0x7e, 0x8a, 0x3e,
+	0x3e, 0x81, 0x9d, 0x58, 0x65, 0x85, 0x6e, 0xd1, 0x5c, 0x08, 0x63, 0x82, 0x2b, 0x5e, 0xf8, 0x19,
+	0x4c, 0x5c, 0x7d, 0xea, 0xa9, 0x7d, 0x72, 0x6d, 0x6a, 0xc1, 0xa7, 0x1e, 0x59, 0xcd, 0xd8, 0xfe,
+	0x71, 0x04, 0xdb, 0xbe, 0x1e, 0x18, 0x7d, 0x26, 0x32, 0xab, 0x2c, 0x4f, 0xc9, 0xe1, 0x6e, 0x54,
+	0xcb, 0x78, 0x76, 0x64, 0x22, 0x9b, 0x6b, 0x51, 0x39, 0x5a, 0x89, 0x2c, 0xa4, 0x09, 0xe6, 0x2b,
+	0x2e, 0x53, 0x3a, 0x3d, 0x9c, 0x83, 0x6b, 0x18, 0xfe, 0x7b, 0x56, 0xce, 0xe7, 0x78, 0xdd, 0x75,
+	0xe9, 0xae, 0x44, 0xbc, 0x53, 0xc4, 0x3c, 0x5e, 0x8a, 0xc4, 0x4f, 0xb6, 0x97, 0xf0, 0x44, 0xc2,
+	0xba, 0x7b, 0x9d, 0x3b, 0xf7, 0x5a, 0x08, 0xfe, 0x8f, 0xc7, 0x56, 0xae, 0x84, 0x3f, 0xeb, 0xbc,
+	0x84, 0x31, 0xc8, 0xdc, 0x6b, 0xdc, 0x7c, 0xd7, 0x32, 0xda, 0x74, 0x4f, 0x3c, 0x57, 0xb9, 0x1f,
+	0xec, 0x16, 0x82, 0x91, 0xc8, 0xbc, 0x91, 0xfd, 0x48, 0xaf, 0x61, 0x8d, 0x0d, 0xec, 0x37, 0x3f,
+	0xca, 0x2d, 0xa4, 0x6d, 0x83, 0x18, 0xa3, 0x75, 0x1b, 0xc4, 0x39, 0x80, 0x61, 0x99, 0x8b, 0x95,
+	0x8c, 0xdd, 0x71, 0x3b, 0x76, 0xdb, 0x50, 0x0b, 0xa2, 0x6c, 0xa7, 0x2a, 0x3e, 0x13, 0x49, 0x30,
+	0xf1, 0xd9, 0x76, 0x22, 0x76, 0x15, 0x66, 0xc1, 0x15, 0x69, 0x87, 0x74, 0x0d, 0x80, 0xd1, 0xa3,
+	0x40, 0x65, 0xda, 0x75, 0xd1, 0x57, 0x32, 0x5e, 0x4c, 0x12, 0xa9, 0xed, 0x65, 0xb0, 0x47, 0x0a,
+	0x27, 0xa0, 0x3d, 0xda, 0xed, 0x66, 0x3c, 0x3e, 0x0b, 0x98, 0xb3, 0x57, 0x03, 0xa8, 0xc5, 0xa8,
+	0x0b, 0x6a, 0xa3, 0x5b, 0x4e, 0x5b, 0x03, 0x58, 0x83, 0x8c, 0x17, 0x85, 0x48, 0x82, 0x0f, 0x5c,
+	0x0d, 0x9c, 0x84, 0x2b, 0x99, 0x65, 0x26, 0xb2, 0xe0, 0xb6, 0x5b, 0x89, 0x04, 0x9a, 0xc1, 0x94,
+	0xcf, 0x82, 0x3b, 0x7e, 0x06, 0x53, 0x3e, 0xc3, 0x6c, 0x19, 0x2d, 0xe2, 0x94, 0xcb, 0x8c, 0x52,
+	0xf1, 0xa1, 0xcb, 0x56, 0x1b, 0xa3, 0x4e, 0x28, 0x73, 0x8f, 0x04, 0x81, 0xef, 0x84, 0x1a, 0xc1,
+	0x6c, 0x9e, 0x09, 0x9d, 0x8b, 0xd4, 0x58, 0x8c, 0xe1, 0xae, 0xcb, 0x66, 0x0b, 0x42, 0x0b, 0xe8,
+	0x30, 0xa5, 0xd6, 0x04, 0xfb, 0xce, 0x42, 0x83, 0xa0, 0x85, 0x7c, 0x6e, 0xca, 0xdc, 0xb8, 0x7a,
+	0xdc, 0x73, 0x16, 0x5a, 0x10, 0x46, 0x3a, 0x53, 0x65, 0x1e, 0x8b, 0xe0, 0x23, 0x17, 0xa9, 0x93,
+	0xd0, 0xff, 0x3a, 0x59, 0x36, 0x2b, 0x82, 0xff, 0x73, 0xfe, 0xb7, 0x31, 0xb4, 0x8e, 0x1b, 0xa4,
+	0xb4, 0xa9, 0xcc, 0xa4, 0x0d, 0xee, 0x3b, 0xeb, 0x2d, 0xa8, 0x61, 0x58, 0x91, 0x70, 0x13, 0x3c,
+	0x68, 0x33, 0x08, 0xc2, 0x75, 0x56, 0x19, 0x4f, 0x53, 0x15, 0xbb, 0xc2, 0x1f, 0xb8, 0x75, 0xda,
+	0x18, 0x5a, 0xf1, 0x32, 0x6d, 0x6b, 0x0f, 0x9d, 0x95, 0x16, 0xd4, 0xb2, 0x12, 0x2f, 0xcb, 0xfc,
+	0x2c, 0x08, 0xd7, 0xac, 0x10, 0xc6, 0x9e, 0xc1, 0xde, 0x92, 0xeb, 0xe4, 0x9c, 0x6b, 0x11, 0x2b,
+	0xad, 0xcb, 0x02, 0xaf, 0xfb, 0x1f, 0x13, 0xf1, 0xba, 0x82, 0x3d, 0x82, 0x31, 0xb6, 0xc3, 0xb2,
+	0x5c, 0x08, 0xd7, 0x23, 0x8f, 0xdc, 0xee, 0xb4, 0x06, 0xb2, 0x27, 0x30, 0xa1, 0x16, 0x68, 0x68,
+	0x8f, 0xdd, 0x11, 0xb8, 0x8e, 0xd6, 0xbc, 0x22, 0x4b, 0x7c, 0x5f, 0x3d, 0x69, 0xf1, 0x6a, 0x14,
+	0xbb, 0x3c, 0xce, 0xb8, 0xcb, 0xc4, 0x53, 0x7f, 0x1f, 0xf0, 0x32, 0xdd, 0x71, 0x33, 0x4e, 0x03,
+	0x70, 0xe8, 0x26, 0xc7, 0x8b, 0x68, 0xbd, 0x5e, 0xca, 0xfd, 0xf7, 0x13, 0x67, 0x7d, 0x1d, 0xc5,
+	0x98, 0x6a, 0x84, 0xec, 0xfc, 0xbf, 0x8b, 0x69, 0x0d, 0x5c, 0x63, 0x69, 0xb3, 0x4a, 0x82, 0x9f,
+	0x5d, 0x61, 0x21, 0xb8, 0xc6, 0x32, 0xa5, 0x2e, 0x82, 0x67, 0x57, 0x58, 0x08, 0x62, 0x5d, 0x6a,
+	0x00, 0xb7, 0xf8, 0xe7, 0xae, 0x2e, 0x6d, 0x0c, 0xab, 0x9b, 0x48, 0x2d, 0x62, 0x9b, 0xf1, 0xe2,
+	0xd3, 0xb3, 0xe0, 0xc8, 0x55, 0xb7, 0x05, 0xad, 0x31, 0x4e, 0xb2, 0xe0, 0xf8, 0x0a, 0xe3, 0x24,
+	0x5b, 0x63, 0xbc, 0x5c, 0x04, 0x2f, 0xae, 0x30, 0x5e, 0x2e, 0x4e, 0x7e, 0xdc, 0x84, 0xc1, 0x37,
+	0xa7, 0xa7, 0x42, 0xaf, 0x64, 0x2c, 0xd8, 0xaf, 0x01, 0x9a, 0x8f, 0x0e, 0xec, 0xf6, 0xda, 0xa7,
+	0x85, 0xea, 0x42, 0xbc, 0x7f, 0xe7, 0x2a, 0x5c, 0x7f, 0x9b, 0xe8, 0xd1, 0x07, 0x07, 0xb6, 0x8b,
+	0x84, 0xf6, 0xb7, 0x87, 0xfd, 0x51, 0x75, 0x63, 0xc0, 0xf3, 0xeb, 0x45, 0x87, 0x3d, 0x87, 0x2e,
+	0xbe, 0xc5, 0xb2, 0x9d, 0xe6, 0x35, 0xd7, 0x11, 0x77, 0x1b, 0xc0, 0x59, 0x3d, 0xec, 0xbc, 0xe8,
+	0xb0, 0x4f, 0xeb, 0xcb, 0xc8, 0x9d, 0x6b, 0x97, 0x97, 0xaf, 0xf0, 0x33, 0xd7, 0x3e, 0x6b, 0x1d,
+	0x75, 0x95, 0x3f, 0xaf, 0x60, 0x50, 0xbf, 0x73, 0xfe, 0xdb, 0x3f, 0xde, 0x6e, 0xbd, 0x0e, 0xb6,
+	0x5e, 0x4d, 0x4f, 0x9a, 0x37, 0x0f, 0xd6, 0x7e, 0x4d, 0xf0, 0x6e, 0xde, 0x5a, 0xc3, 0xea, 0x57,
+	0x71, 0x7f, 0xb1, 0xdf, 0x6d, 0x6e, 0xcb, 0x9e, 0xbf, 0xd7, 0x42, 0x3c, 0xfb, 0x05, 0x40, 0x73,
+	0xf9, 0x73, 0xa9, 0xbe, 0x76, 0x19, 0xdc, 0x6f, 0xee, 0xdd, 0x2f, 0x3a, 0xaf, 0x3f, 0xc7, 0x8f,
+	0x48, 0x19, 0x22, 0xbc, 0x90, 0xaf, 0x7b, 0xdf, 0x98, 0x2f, 0x0a, 0xf9, 0x6d, 0xe7, 0xfb, 0xc7,
+	0x0b, 0x69, 0x97, 0xe5, 0x0c, 0x33, 0x7c, 0x6c, 0x79, 0xaa, 0xcc, 0x73, 0x73, 0x69, 0xac, 0xc8,
+	0x8c, 0x93, 0x8e, 0x79, 0x21, 0x8f, 0x95, 0x99, 0x6d, 0x51, 0xe0, 0x3f, 0xff, 0x67, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x72, 0x19, 0xbd, 0xd5, 0x59, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1592,10 +2192,12 @@ const _ = grpc.SupportPackageIsVersion4
 type OSServiceClient interface {
 	Containers(ctx context.Context, in *ContainersRequest, opts ...grpc.CallOption) (*ContainersResponse, error)
 	Dmesg(ctx context.Context, in *DmesgRequest, opts ...grpc.CallOption) (OSService_DmesgClient, error)
+	Exec(ctx context.Context, opts ...grpc.CallOption) (OSService_ExecClient, error)
 	Memory(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*MemoryResponse, error)
 	Processes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProcessesResponse, error)
 	Restart(ctx context.Context, in *RestartRequest, opts ...grpc.CallOption) (*RestartResponse, error)
 	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	StatsWatch(ctx context.Context, in *StatsWatchRequest, opts ...grpc.CallOption) (OSService_StatsWatchClient, error)
 }
 
 type oSServiceClient struct {
@@ -1647,6 +2249,37 @@ func (x *oSServiceDmesgClient) Recv() (*common.Data, error) {
 	return m, nil
 }
 
+func (c *oSServiceClient) Exec(ctx context.Context, opts ...grpc.CallOption) (OSService_ExecClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_OSService_serviceDesc.Streams[1], "/os.OSService/Exec", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oSServiceExecClient{stream}
+	return x, nil
+}
+
+type OSService_ExecClient interface {
+	Send(*ExecRequest) error
+	Recv() (*ExecResponse, error)
+	grpc.ClientStream
+}
+
+type oSServiceExecClient struct {
+	grpc.ClientStream
+}
+
+func (x *oSServiceExecClient) Send(m *ExecRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *oSServiceExecClient) Recv() (*ExecResponse, error) {
+	m := new(ExecResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *oSServiceClient) Memory(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*MemoryResponse, error) {
 	out := new(MemoryResponse)
 	err := c.cc.Invoke(ctx, "/os.OSService/Memory", in, out, opts...)
@@ -1683,14 +2316,48 @@ func (c *oSServiceClient) Stats(ctx context.Context, in *StatsRequest, opts ...g
 	return out, nil
 }
 
+func (c *oSServiceClient) StatsWatch(ctx context.Context, in *StatsWatchRequest, opts ...grpc.CallOption) (OSService_StatsWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_OSService_serviceDesc.Streams[2], "/os.OSService/StatsWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oSServiceStatsWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OSService_StatsWatchClient interface {
+	Recv() (*Stats, error)
+	grpc.ClientStream
+}
+
+type oSServiceStatsWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *oSServiceStatsWatchClient) Recv() (*Stats, error) {
+	m := new(Stats)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // OSServiceServer is the server API for OSService service.
 type OSServiceServer interface {
 	Containers(context.Context, *ContainersRequest) (*ContainersResponse, error)
 	Dmesg(*DmesgRequest, OSService_DmesgServer) error
+	Exec(OSService_ExecServer) error
 	Memory(context.Context, *empty.Empty) (*MemoryResponse, error)
 	Processes(context.Context, *empty.Empty) (*ProcessesResponse, error)
 	Restart(context.Context, *RestartRequest) (*RestartResponse, error)
 	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	StatsWatch(*StatsWatchRequest, OSService_StatsWatchServer) error
 }
 
 func RegisterOSServiceServer(s *grpc.Server, srv OSServiceServer) {
@@ -1736,6 +2403,32 @@ func (x *oSServiceDmesgServer) Send(m *common.Data) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _OSService_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OSServiceServer).Exec(&oSServiceExecServer{stream})
+}
+
+type OSService_ExecServer interface {
+	Send(*ExecResponse) error
+	Recv() (*ExecRequest, error)
+	grpc.ServerStream
+}
+
+type oSServiceExecServer struct {
+	grpc.ServerStream
+}
+
+func (x *oSServiceExecServer) Send(m *ExecResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *oSServiceExecServer) Recv() (*ExecRequest, error) {
+	m := new(ExecRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _OSService_Memory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -1808,6 +2501,27 @@ func _OSService_Stats_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OSService_StatsWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StatsWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OSServiceServer).StatsWatch(m, &oSServiceStatsWatchServer{stream})
+}
+
+type OSService_StatsWatchServer interface {
+	Send(*Stats) error
+	grpc.ServerStream
+}
+
+type oSServiceStatsWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *oSServiceStatsWatchServer) Send(m *Stats) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _OSService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "os.OSService",
 	HandlerType: (*OSServiceServer)(nil),
@@ -1839,6 +2553,17 @@ var _OSService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _OSService_Dmesg_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Exec",
+			Handler:       _OSService_Exec_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StatsWatch",
+			Handler:       _OSService_StatsWatch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "os/os.proto",
 }
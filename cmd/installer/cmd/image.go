@@ -79,6 +79,7 @@ func runImageCmd() (err error) {
 			MachineInstall: &v1alpha1.InstallConfig{
 				InstallForce:           true,
 				InstallBootloader:      options.Bootloader,
+				InstallBootloaderType:  options.BootloaderType,
 				InstallDisk:            options.Disk,
 				InstallExtraKernelArgs: options.ExtraKernelArgs,
 			},
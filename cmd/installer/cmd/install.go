@@ -71,6 +71,7 @@ func runInstallCmd() (err error) {
 				MachineInstall: &v1alpha1.InstallConfig{
 					InstallForce:           true,
 					InstallBootloader:      options.Bootloader,
+					InstallBootloaderType:  options.BootloaderType,
 					InstallDisk:            options.Disk,
 					InstallExtraKernelArgs: options.ExtraKernelArgs,
 				},
@@ -6,6 +6,10 @@ package bootloader
 
 // Bootloader describes a bootloader.
 type Bootloader interface {
+	// Prepare prepares the block device for the bootloader, e.g. by writing
+	// boot code to the MBR.
 	Prepare(string) error
+	// Install installs the bootloader and its config to the boot partition
+	// mounted at the specified path.
 	Install(string, interface{}) error
 }
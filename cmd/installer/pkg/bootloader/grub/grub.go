@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package grub provides the GRUB bootloader.
+package grub
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/talos-systems/talos/pkg/cmd"
+)
+
+const grubCfgTpl = `set default="{{ .Default }}"
+set timeout=3
+
+insmod all_video
+
+terminal_input console
+terminal_output console
+{{ range .Labels }}
+menuentry "{{ .Root }}" --id {{ .Root }} {
+  set gfxmode=auto
+  set gfxpayload=text
+  linux {{ .Kernel }} {{ .Append }}
+  initrd {{ .Initrd }}
+}
+{{ end -}}
+`
+
+// Cfg represents the grub.cfg file.
+type Cfg struct {
+	Default string
+	Labels  []*Label
+}
+
+// Label represents a menu entry in the grub.cfg file.
+type Label struct {
+	Root   string
+	Kernel string
+	Initrd string
+	Append string
+}
+
+// Grub represents the GRUB bootloader.
+type Grub struct {
+	device string
+}
+
+// Prepare implements the Bootloader interface. The BIOS boot code is written
+// by grub-install, Prepare only records the device to install it to.
+func (g *Grub) Prepare(dev string) error {
+	g.device = dev
+
+	return nil
+}
+
+// Install implements the Bootloader interface. It installs GRUB for both
+// BIOS and UEFI, and writes the grub.cfg with the specified kernel
+// parameters.
+func (g *Grub) Install(base string, config interface{}) (err error) {
+	grubcfg, ok := config.(*Cfg)
+	if !ok {
+		return errors.New("expected a grub config")
+	}
+
+	if g.device == "" {
+		return errors.New("the device to install grub to is unknown")
+	}
+
+	if _, err = cmd.Run("grub-install", "--target=i386-pc", "--boot-directory="+base, g.device); err != nil {
+		return fmt.Errorf("failed to install grub for BIOS: %w", err)
+	}
+
+	// The EFI binary is installed to the removable media path, this does not
+	// require access to the EFI variables of the machine running the
+	// installer.
+	if _, err = cmd.Run("grub-install", "--target=x86_64-efi", "--efi-directory="+base, "--boot-directory="+base, "--removable", "--no-nvram"); err != nil {
+		return fmt.Errorf("failed to install grub for UEFI: %w", err)
+	}
+
+	return WriteGrubCfg(base, grubcfg)
+}
+
+// WriteGrubCfg writes grub.cfg to the grub directory under base.
+func WriteGrubCfg(base string, grubcfg *Cfg) (err error) {
+	wr := new(bytes.Buffer)
+	t := template.Must(template.New("grub").Parse(grubCfgTpl))
+
+	if err = t.Execute(wr, grubcfg); err != nil {
+		return err
+	}
+
+	path := filepath.Join(base, "grub", "grub.cfg")
+
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	log.Printf("writing %s to disk", path)
+
+	return ioutil.WriteFile(path, wr.Bytes(), 0600)
+}
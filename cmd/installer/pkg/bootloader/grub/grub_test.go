@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package grub_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/cmd/installer/pkg/bootloader/grub"
+)
+
+type GrubSuite struct {
+	suite.Suite
+}
+
+func (suite *GrubSuite) TestWriteGrubCfg() {
+	dir, err := ioutil.TempDir("", "talos")
+	suite.Require().NoError(err)
+
+	// nolint: errcheck
+	defer os.RemoveAll(dir)
+
+	cfg := &grub.Cfg{
+		Default: "default",
+		Labels: []*grub.Label{
+			{
+				Root:   "default",
+				Kernel: "/default/vmlinuz",
+				Initrd: "/default/initramfs.xz",
+				Append: "talos.platform=metal console=ttyS0",
+			},
+		},
+	}
+
+	suite.Require().NoError(grub.WriteGrubCfg(dir, cfg))
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "grub", "grub.cfg"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(`set default="default"
+set timeout=3
+
+insmod all_video
+
+terminal_input console
+terminal_output console
+
+menuentry "default" --id default {
+  set gfxmode=auto
+  set gfxpayload=text
+  linux /default/vmlinuz talos.platform=metal console=ttyS0
+  initrd /default/initramfs.xz
+}
+`, string(b))
+}
+
+func (suite *GrubSuite) TestInstallWithoutDevice() {
+	suite.Require().Error((&grub.Grub{}).Install("/boot", &grub.Cfg{}))
+	suite.Require().Error((&grub.Grub{}).Install("/boot", nil))
+}
+
+func TestGrubSuite(t *testing.T) {
+	suite.Run(t, new(GrubSuite))
+}
@@ -56,7 +56,7 @@ type Syslinux struct{}
 
 // Prepare implements the Bootloader interface. It works by writing
 // gptmbr.bin to a block device.
-func Prepare(dev string) (err error) {
+func (s *Syslinux) Prepare(dev string) (err error) {
 	b, err := ioutil.ReadFile(gptmbrbin)
 	if err != nil {
 		return err
@@ -79,7 +79,7 @@ func Prepare(dev string) (err error) {
 
 // Install implements the Bootloader interface. It sets up syslinux with the
 // specified kernel parameters.
-func Install(base string, config interface{}) (err error) {
+func (s *Syslinux) Install(base string, config interface{}) (err error) {
 	syslinuxcfg, ok := config.(*Cfg)
 	if !ok {
 		return errors.New("expected a syslinux config")
@@ -4,11 +4,57 @@
 
 package syslinux_test
 
-import "testing"
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/cmd/installer/pkg/bootloader/syslinux"
+)
+
+type SyslinuxSuite struct {
+	suite.Suite
+}
+
+func (suite *SyslinuxSuite) TestWriteSyslinuxCfg() {
+	dir, err := ioutil.TempDir("", "talos")
+	suite.Require().NoError(err)
+
+	// nolint: errcheck
+	defer os.RemoveAll(dir)
+
+	cfg := &syslinux.Cfg{
+		Default: "default",
+		Labels: []*syslinux.Label{
+			{
+				Root:   "default",
+				Kernel: "/default/vmlinuz",
+				Initrd: "/default/initramfs.xz",
+				Append: "talos.platform=metal console=ttyS0",
+			},
+		},
+	}
+
+	path := filepath.Join(dir, "syslinux", "syslinux.cfg")
+
+	suite.Require().NoError(syslinux.WriteSyslinuxCfg(dir, path, cfg))
+
+	b, err := ioutil.ReadFile(path)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("DEFAULT default\n  SAY Talos\nINCLUDE /default/include.cfg", string(b))
+
+	b, err = ioutil.ReadFile(filepath.Join(dir, "default", "include.cfg"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(`LABEL default
+  KERNEL /default/vmlinuz
+  INITRD /default/initramfs.xz
+  APPEND talos.platform=metal console=ttyS0
+`, string(b))
+}
+
+func TestSyslinuxSuite(t *testing.T) {
+	suite.Run(t, new(SyslinuxSuite))
 }
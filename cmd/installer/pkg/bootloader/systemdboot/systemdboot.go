@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package systemdboot provides the systemd-boot UEFI bootloader.
+package systemdboot
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+const loaderCfgTpl = `default {{ .Default }}.conf
+timeout 3
+`
+
+const entryTpl = `title Talos ({{ .Root }})
+linux {{ .Kernel }}
+initrd {{ .Initrd }}
+options {{ .Append }}
+`
+
+// systemdbootefi is the EFI binary of the bootloader. The installer image
+// ships gummiboot, the predecessor of systemd-boot which uses the same
+// config format.
+const systemdbootefi = "/usr/lib/gummiboot/gummibootx64.efi"
+
+// Cfg represents the loader.conf file.
+type Cfg struct {
+	Default string
+	Labels  []*Label
+}
+
+// Label represents a boot loader entry. The kernel is booted using its EFI
+// stub.
+type Label struct {
+	Root   string
+	Kernel string
+	Initrd string
+	Append string
+}
+
+// SystemdBoot represents the systemd-boot bootloader.
+type SystemdBoot struct{}
+
+// Prepare implements the Bootloader interface. systemd-boot supports UEFI
+// only, there is nothing to write to the block device.
+func (s *SystemdBoot) Prepare(dev string) error {
+	return nil
+}
+
+// Install implements the Bootloader interface. It installs the EFI binary to
+// the removable media path of the EFI system partition, and writes the loader
+// config and entries with the specified kernel parameters.
+func (s *SystemdBoot) Install(base string, config interface{}) (err error) {
+	loadercfg, ok := config.(*Cfg)
+	if !ok {
+		return errors.New("expected a systemd-boot config")
+	}
+
+	efiDir := filepath.Join(base, "EFI", "BOOT")
+	if err = os.MkdirAll(efiDir, 0700); err != nil {
+		return err
+	}
+
+	input, err := ioutil.ReadFile(systemdbootefi)
+	if err != nil {
+		return err
+	}
+
+	if err = ioutil.WriteFile(filepath.Join(efiDir, "BOOTX64.EFI"), input, 0600); err != nil {
+		return err
+	}
+
+	return WriteLoaderCfg(base, loadercfg)
+}
+
+// WriteLoaderCfg writes loader.conf and an entry per label to the loader
+// directory under base.
+func WriteLoaderCfg(base string, loadercfg *Cfg) (err error) {
+	entries := filepath.Join(base, "loader", "entries")
+
+	if err = os.MkdirAll(entries, 0755); err != nil {
+		return err
+	}
+
+	path := filepath.Join(base, "loader", "loader.conf")
+
+	log.Printf("writing %s to disk", path)
+
+	if err = render(path, loaderCfgTpl, loadercfg); err != nil {
+		return err
+	}
+
+	for _, label := range loadercfg.Labels {
+		log.Printf("writing systemd-boot entry %s to disk", label.Root)
+
+		if err = render(filepath.Join(entries, label.Root+".conf"), entryTpl, label); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func render(path, tpl string, data interface{}) (err error) {
+	wr := new(bytes.Buffer)
+	t := template.Must(template.New("systemd-boot").Parse(tpl))
+
+	if err = t.Execute(wr, data); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, wr.Bytes(), 0600)
+}
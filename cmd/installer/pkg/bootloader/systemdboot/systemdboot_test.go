@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package systemdboot_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/cmd/installer/pkg/bootloader/systemdboot"
+)
+
+type SystemdBootSuite struct {
+	suite.Suite
+}
+
+func (suite *SystemdBootSuite) TestWriteLoaderCfg() {
+	dir, err := ioutil.TempDir("", "talos")
+	suite.Require().NoError(err)
+
+	// nolint: errcheck
+	defer os.RemoveAll(dir)
+
+	cfg := &systemdboot.Cfg{
+		Default: "default",
+		Labels: []*systemdboot.Label{
+			{
+				Root:   "default",
+				Kernel: "/default/vmlinuz",
+				Initrd: "/default/initramfs.xz",
+				Append: "talos.platform=metal console=ttyS0",
+			},
+		},
+	}
+
+	suite.Require().NoError(systemdboot.WriteLoaderCfg(dir, cfg))
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "loader", "loader.conf"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("default default.conf\ntimeout 3\n", string(b))
+
+	b, err = ioutil.ReadFile(filepath.Join(dir, "loader", "entries", "default.conf"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(`title Talos (default)
+linux /default/vmlinuz
+initrd /default/initramfs.xz
+options talos.platform=metal console=ttyS0
+`, string(b))
+}
+
+func TestSystemdBootSuite(t *testing.T) {
+	suite.Run(t, new(SystemdBootSuite))
+}
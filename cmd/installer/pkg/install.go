@@ -22,6 +22,7 @@ type InstallOptions struct {
 	Platform        string
 	ExtraKernelArgs []string
 	Bootloader      bool
+	BootloaderType  string
 	Upgrade         bool
 }
 
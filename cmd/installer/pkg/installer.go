@@ -15,7 +15,10 @@ import (
 
 	"github.com/talos-systems/go-procfs/procfs"
 
+	"github.com/talos-systems/talos/cmd/installer/pkg/bootloader"
+	"github.com/talos-systems/talos/cmd/installer/pkg/bootloader/grub"
 	"github.com/talos-systems/talos/cmd/installer/pkg/bootloader/syslinux"
+	"github.com/talos-systems/talos/cmd/installer/pkg/bootloader/systemdboot"
 	"github.com/talos-systems/talos/cmd/installer/pkg/manifest"
 	"github.com/talos-systems/talos/internal/pkg/metadata"
 	"github.com/talos-systems/talos/internal/pkg/mount"
@@ -91,16 +94,21 @@ func (i *Installer) Install(sequence runtime.Sequence) (err error) {
 	// nolint: errcheck
 	defer m.UnmountAll()
 
+	bl, cfg, err := i.bootloader()
+	if err != nil {
+		return err
+	}
+
 	// Install the assets.
 
 	for _, targets := range i.manifest.Targets {
 		for _, target := range targets {
 			switch target.Label {
 			case constants.BootPartitionLabel:
-				if err = syslinux.Prepare(target.Device); err != nil {
+				if err = bl.Prepare(target.Device); err != nil {
 					return err
 				}
-			case constants.EphemeralPartitionLabel:
+			case constants.BIOSBootPartitionLabel, constants.EphemeralPartitionLabel:
 				continue
 			}
 
@@ -117,19 +125,7 @@ func (i *Installer) Install(sequence runtime.Sequence) (err error) {
 		return nil
 	}
 
-	syslinuxcfg := &syslinux.Cfg{
-		Default: "default",
-		Labels: []*syslinux.Label{
-			{
-				Root:   "default",
-				Initrd: filepath.Join("/", "default", constants.InitramfsAsset),
-				Kernel: filepath.Join("/", "default", constants.KernelAsset),
-				Append: i.cmdline.String(),
-			},
-		},
-	}
-
-	if err = syslinux.Install(filepath.Join(constants.BootMountPoint), syslinuxcfg); err != nil {
+	if err = bl.Install(constants.BootMountPoint, cfg); err != nil {
 		return err
 	}
 
@@ -138,6 +134,56 @@ func (i *Installer) Install(sequence runtime.Sequence) (err error) {
 	return metadata.Save()
 }
 
+// bootloader returns the configured bootloader and its config.
+func (i *Installer) bootloader() (bootloader.Bootloader, interface{}, error) {
+	var (
+		root   = "default"
+		kernel = filepath.Join("/", "default", constants.KernelAsset)
+		initrd = filepath.Join("/", "default", constants.InitramfsAsset)
+	)
+
+	switch i.install.BootloaderType() {
+	case constants.BootloaderSyslinux:
+		return &syslinux.Syslinux{}, &syslinux.Cfg{
+			Default: root,
+			Labels: []*syslinux.Label{
+				{
+					Root:   root,
+					Initrd: initrd,
+					Kernel: kernel,
+					Append: i.cmdline.String(),
+				},
+			},
+		}, nil
+	case constants.BootloaderGRUB:
+		return &grub.Grub{}, &grub.Cfg{
+			Default: root,
+			Labels: []*grub.Label{
+				{
+					Root:   root,
+					Initrd: initrd,
+					Kernel: kernel,
+					Append: i.cmdline.String(),
+				},
+			},
+		}, nil
+	case constants.BootloaderSystemdBoot:
+		return &systemdboot.SystemdBoot{}, &systemdboot.Cfg{
+			Default: root,
+			Labels: []*systemdboot.Label{
+				{
+					Root:   root,
+					Initrd: initrd,
+					Kernel: kernel,
+					Append: i.cmdline.String(),
+				},
+			},
+		}, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown bootloader %q", i.install.BootloaderType())
+	}
+}
+
 func zero(manifest *manifest.Manifest) (err error) {
 	var zero *os.File
 
@@ -43,6 +43,9 @@ type Target struct {
 	Test           bool
 	Assets         []*Asset
 	BlockDevice    *blockdevice.BlockDevice
+	// LegacyBIOSBootable sets the legacy BIOS bootable attribute of the
+	// partition.
+	LegacyBIOSBootable bool
 }
 
 // Asset represents a file required by a target.
@@ -74,14 +77,29 @@ func NewManifest(install machine.Install) (manifest *Manifest, err error) {
 		manifest.Targets[install.Disk()] = []*Target{}
 	}
 
-	var bootTarget *Target
+	var biosTarget, bootTarget *Target
 	if install.WithBootloader() {
+		// GRUB requires a BIOS boot partition to store its core image on
+		// GPT disks.
+		if install.BootloaderType() == constants.BootloaderGRUB {
+			biosTarget = &Target{
+				Device: install.Disk(),
+				Label:  constants.BIOSBootPartitionLabel,
+				Size:   1024 * 1024,
+				Force:  true,
+				Test:   false,
+			}
+		}
+
+		// The EFI system partition holds the bootloader, the kernel and the
+		// initramfs.
 		bootTarget = &Target{
-			Device: install.Disk(),
-			Label:  constants.BootPartitionLabel,
-			Size:   512 * 1024 * 1024,
-			Force:  true,
-			Test:   false,
+			Device:             install.Disk(),
+			Label:              constants.BootPartitionLabel,
+			Size:               512 * 1024 * 1024,
+			Force:              true,
+			Test:               false,
+			LegacyBIOSBootable: install.BootloaderType() == constants.BootloaderSyslinux,
 			Assets: []*Asset{
 				{
 					Source:      constants.KernelAssetPath,
@@ -103,7 +121,7 @@ func NewManifest(install machine.Install) (manifest *Manifest, err error) {
 		Test:   false,
 	}
 
-	for _, target := range []*Target{bootTarget, ephemeralTarget} {
+	for _, target := range []*Target{biosTarget, bootTarget, ephemeralTarget} {
 		if target == nil {
 			continue
 		}
@@ -156,10 +174,14 @@ func (t *Target) Partition(bd *blockdevice.BlockDevice) (err error) {
 	opts := []interface{}{}
 
 	switch t.Label {
+	case constants.BIOSBootPartitionLabel:
+		// BIOS Boot Partition
+		typeID := "21686148-6449-6E6F-744E-656564454649"
+		opts = append(opts, partition.WithPartitionType(typeID), partition.WithPartitionName(t.Label))
 	case constants.BootPartitionLabel:
 		// EFI System Partition
 		typeID := "C12A7328-F81F-11D2-BA4B-00A0C93EC93B"
-		opts = append(opts, partition.WithPartitionType(typeID), partition.WithPartitionName(t.Label), partition.WithLegacyBIOSBootableAttribute(true))
+		opts = append(opts, partition.WithPartitionType(typeID), partition.WithPartitionName(t.Label), partition.WithLegacyBIOSBootableAttribute(t.LegacyBIOSBootable))
 	case constants.EphemeralPartitionLabel:
 		// Ephemeral Partition
 		typeID := "AF3DC60F-8384-7247-8E79-3D69D8477DE4"
@@ -185,6 +207,11 @@ func (t *Target) Partition(bd *blockdevice.BlockDevice) (err error) {
 
 // Format creates a filesystem on the device/partition.
 func (t *Target) Format() error {
+	if t.Label == constants.BIOSBootPartitionLabel {
+		// The BIOS boot partition holds raw data only.
+		return nil
+	}
+
 	if t.Label == constants.BootPartitionLabel {
 		log.Printf("formatting partition %s - %s as %s\n", t.PartitionName, t.Label, "fat")
 		return vfat.MakeFS(t.PartitionName, vfat.WithLabel(t.Label))
@@ -6,28 +6,33 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"math/big"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
 
+	machineapi "github.com/talos-systems/talos/api/machine"
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
 	clientconfig "github.com/talos-systems/talos/cmd/osctl/pkg/client/config"
 	"github.com/talos-systems/talos/cmd/osctl/pkg/helpers"
 	"github.com/talos-systems/talos/internal/pkg/provision"
 	"github.com/talos-systems/talos/internal/pkg/provision/access"
 	"github.com/talos-systems/talos/internal/pkg/provision/check"
 	"github.com/talos-systems/talos/internal/pkg/provision/providers"
+	"github.com/talos-systems/talos/internal/pkg/provision/spec"
 	"github.com/talos-systems/talos/internal/pkg/runtime"
 	"github.com/talos-systems/talos/pkg/config"
+	"github.com/talos-systems/talos/pkg/config/machine"
 	"github.com/talos-systems/talos/pkg/config/types/v1alpha1/generate"
 	"github.com/talos-systems/talos/pkg/constants"
 	talosnet "github.com/talos-systems/talos/pkg/net"
@@ -40,8 +45,10 @@ var (
 	nodeInstallImage        string
 	registryMirrors         []string
 	nodeVmlinuxPath         string
+	nodeVmlinuzPath         string
 	nodeInitramfsPath       string
 	bootloaderEmulation     bool
+	uefiEnabled             bool
 	networkCIDR             string
 	networkMTU              int
 	nameservers             []string
@@ -50,6 +57,11 @@ var (
 	clusterCpus             string
 	clusterMemory           int
 	clusterDiskSize         int
+	extraDisks              int
+	extraDiskSize           int
+	extraNICs               int
+	scaleMasters            int
+	scaleWorkers            int
 	clusterWait             bool
 	clusterWaitTimeout      time.Duration
 	forceInitNodeAsEndpoint bool
@@ -59,6 +71,9 @@ var (
 	cniConfDir              string
 	cniCacheDir             string
 	stateDir                string
+	clusterSpecPath         string
+	showOutput              string
+	snapshotOutput          string
 )
 
 // clusterCmd represents the cluster command
@@ -72,8 +87,12 @@ var clusterCmd = &cobra.Command{
 var clusterUpCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Creates a local docker-based or firecracker-based kubernetes cluster",
-	Long:  ``,
-	Args:  cobra.NoArgs,
+	Long: `Creates a local docker-based or firecracker-based kubernetes cluster.
+
+Cluster topology could be described declaratively with the cluster spec file (--file),
+which allows to set resources, extra disks, network interfaces and machine configuration
+patches for each node. Spec of the existing cluster could be printed with 'osctl cluster show -o yaml'.`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return helpers.WithCLIContext(context.Background(), create)
 	},
@@ -90,21 +109,88 @@ var clusterDownCmd = &cobra.Command{
 	},
 }
 
+// clusterScaleCmd represents the cluster scale command
+var clusterScaleCmd = &cobra.Command{
+	Use:   "scale",
+	Short: "Adds or removes nodes of a local provisioned kubernetes cluster",
+	Long: `Adds or removes nodes of a local provisioned kubernetes cluster.
+
+New nodes are configured with the cluster secrets saved in the cluster state directory
+when the cluster was created. Master nodes are removed gracefully: the node leaves etcd
+cluster before it is destroyed. Init node is never removed.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return helpers.WithCLIContext(context.Background(), func(ctx context.Context) error {
+			return scale(ctx, cmd.Flags().Changed("masters"), cmd.Flags().Changed("workers"))
+		})
+	},
+}
+
 // clusterShowCmd represents the cluster show command
 var clusterShowCmd = &cobra.Command{
 	Use:   "show",
 	Short: "Shows info about a local provisioned kubernetes cluster",
-	Long:  ``,
-	Args:  cobra.NoArgs,
+	Long: `Shows info about a local provisioned kubernetes cluster.
+
+With '-o yaml' cluster is printed in the format of the cluster spec file accepted by 'osctl cluster create --file'.`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return helpers.WithCLIContext(context.Background(), show)
 	},
 }
 
+// clusterSaveCmd represents the cluster save command
+var clusterSaveCmd = &cobra.Command{
+	Use:   "save",
+	Short: "Saves a snapshot of a local provisioned kubernetes cluster",
+	Long: `Saves a snapshot of a local provisioned kubernetes cluster.
+
+Nodes are stopped while the snapshot is taken and started again afterwards. The snapshot
+archive contains node disks (volumes for docker), machine configuration, osctl configuration
+and the cluster state. It could be restored later with 'osctl cluster restore'.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return helpers.WithCLIContext(context.Background(), save)
+	},
+}
+
+// clusterRestoreCmd represents the cluster restore command
+var clusterRestoreCmd = &cobra.Command{
+	Use:   "restore <snapshot>",
+	Short: "Restores a local provisioned kubernetes cluster from the snapshot",
+	Long: `Restores a local provisioned kubernetes cluster from the snapshot.
+
+Cluster is recreated with the same name, nodes and IPs as captured by 'osctl cluster save'.
+Cluster with the same name shouldn't exist. Snapshot should be restored with the same provisioner
+it was taken with.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return helpers.WithCLIContext(context.Background(), func(ctx context.Context) error {
+			return restore(ctx, args[0])
+		})
+	},
+}
+
 //nolint: gocyclo
 func create(ctx context.Context) (err error) {
-	if masters < 1 {
-		return fmt.Errorf("number of masters can't be less than 1")
+	var clusterSpec *spec.Cluster
+
+	if clusterSpecPath != "" {
+		if clusterSpec, err = spec.Load(clusterSpecPath); err != nil {
+			return err
+		}
+
+		applyClusterSpec(clusterSpec)
+	} else {
+		if masters < 1 {
+			return fmt.Errorf("number of masters can't be less than 1")
+		}
+
+		clusterSpec = clusterSpecFromFlags()
+	}
+
+	if err = clusterSpec.AssignNodeNames(clusterName); err != nil {
+		return err
 	}
 
 	nanoCPUs, err := parseCPUShare()
@@ -115,6 +201,11 @@ func create(ctx context.Context) (err error) {
 	memory := int64(clusterMemory) * 1024 * 1024
 	diskSize := int64(clusterDiskSize) * 1024 * 1024
 
+	extraDiskSizes := make([]int64, extraDisks)
+	for i := range extraDiskSizes {
+		extraDiskSizes[i] = int64(extraDiskSize) * 1024 * 1024
+	}
+
 	// Validate CIDR range and allocate IPs
 	fmt.Println("validating CIDR and reserving IPs")
 
@@ -131,13 +222,17 @@ func create(ctx context.Context) (err error) {
 		return err
 	}
 
-	// Set starting ip at 2nd ip in range, ex: 192.168.0.2
-	ips := make([]net.IP, masters+workers)
+	// Starting at 2nd ip in range, ex: 192.168.0.2, static IPs from the spec are skipped
+	nodeIPs, err := allocateNodeIPs(cidr, gatewayIP, clusterSpec.Nodes)
+	if err != nil {
+		return err
+	}
 
-	for i := range ips {
-		ips[i], err = talosnet.NthIPInNetwork(cidr, i+2)
-		if err != nil {
-			return err
+	var initNodeIP net.IP
+
+	for i := range clusterSpec.Nodes {
+		if nodeType, _ := clusterSpec.Nodes[i].MachineType(); nodeType == machine.TypeInit { //nolint: errcheck
+			initNodeIP = nodeIPs[i][0]
 		}
 	}
 
@@ -151,6 +246,12 @@ func create(ctx context.Context) (err error) {
 		}
 	}
 
+	// qemu boots compressed kernel, while firecracker requires uncompressed one
+	kernelPath := nodeVmlinuxPath
+	if provisioner == "qemu" {
+		kernelPath = nodeVmlinuzPath
+	}
+
 	provisioner, err := providers.Factory(ctx, provisioner)
 	if err != nil {
 		return err
@@ -176,7 +277,7 @@ func create(ctx context.Context) (err error) {
 		},
 
 		Image:         nodeImage,
-		KernelPath:    nodeVmlinuxPath,
+		KernelPath:    kernelPath,
 		InitramfsPath: nodeInitramfsPath,
 
 		SelfExecutable: os.Args[0],
@@ -190,6 +291,10 @@ func create(ctx context.Context) (err error) {
 		provisionOptions = append(provisionOptions, provision.WithBootladerEmulation())
 	}
 
+	if uefiEnabled {
+		provisionOptions = append(provisionOptions, provision.WithUEFI(true))
+	}
+
 	if inputDir != "" {
 		configBundleOpts = append(configBundleOpts, config.WithExistingConfigs(inputDir))
 	} else {
@@ -211,8 +316,8 @@ func create(ctx context.Context) (err error) {
 		defaultInternalLB, defaultExternalLB := provisioner.GetLoadBalancers(request.Network)
 
 		if defaultInternalLB == "" {
-			// provisioner doesn't provide internal LB, so use first master node
-			defaultInternalLB = ips[0].String()
+			// provisioner doesn't provide internal LB, so use init node
+			defaultInternalLB = initNodeIP.String()
 		}
 
 		var endpointList []string
@@ -222,7 +327,7 @@ func create(ctx context.Context) (err error) {
 			endpointList = []string{forceEndpoint}
 			provisionOptions = append(provisionOptions, provision.WithEndpoint(forceEndpoint))
 		case forceInitNodeAsEndpoint:
-			endpointList = []string{ips[0].String()}
+			endpointList = []string{initNodeIP.String()}
 		default:
 			endpointList = []string{defaultExternalLB}
 		}
@@ -248,37 +353,68 @@ func create(ctx context.Context) (err error) {
 	// Add talosconfig to provision options so we'll have it to parse there
 	provisionOptions = append(provisionOptions, provision.WithTalosConfig(configBundle.TalosConfig()))
 
-	// Create the master nodes.
-	for i := 0; i < masters; i++ {
+	// Create the node requests
+	for i, node := range clusterSpec.Nodes {
+		nodeReq := provision.NodeRequest{
+			Name:       node.Name,
+			IP:         nodeIPs[i][0],
+			ExtraIPs:   nodeIPs[i][1:],
+			Memory:     memory,
+			NanoCPUs:   nanoCPUs,
+			DiskSize:   diskSize,
+			ExtraDisks: extraDiskSizes,
+		}
+
+		var nodeType machine.Type
+
+		if nodeType, err = node.MachineType(); err != nil {
+			return err
+		}
+
 		var cfg runtime.Configurator
 
-		if i == 0 {
+		switch nodeType {
+		case machine.TypeInit:
 			cfg = configBundle.Init()
-		} else {
+		case machine.TypeControlPlane:
 			cfg = configBundle.ControlPlane()
+		case machine.TypeWorker:
+			cfg = configBundle.Join()
 		}
 
-		request.Nodes = append(request.Nodes,
-			provision.NodeRequest{
-				Name:     fmt.Sprintf("%s-master-%d", clusterName, i+1),
-				IP:       ips[i],
-				Memory:   memory,
-				NanoCPUs: nanoCPUs,
-				DiskSize: diskSize,
-				Config:   cfg,
-			})
-	}
+		if nodeReq.Config, err = spec.ApplyConfigPatches(cfg, clusterSpec.ConfigPatch, node.ConfigPatch); err != nil {
+			return fmt.Errorf("node %q: %w", nodeReq.Name, err)
+		}
+
+		if node.CPUs != "" {
+			if nodeReq.NanoCPUs, err = spec.ParseCPUs(node.CPUs); err != nil {
+				return err
+			}
+		}
+
+		if node.Memory != "" {
+			if nodeReq.Memory, err = spec.ParseSize(node.Memory); err != nil {
+				return err
+			}
+		}
+
+		if node.Disk != "" {
+			if nodeReq.DiskSize, err = spec.ParseSize(node.Disk); err != nil {
+				return err
+			}
+		}
 
-	for i := 1; i <= workers; i++ {
-		request.Nodes = append(request.Nodes,
-			provision.NodeRequest{
-				Name:     fmt.Sprintf("%s-worker-%d", clusterName, i),
-				IP:       ips[masters+i-1],
-				Memory:   memory,
-				NanoCPUs: nanoCPUs,
-				DiskSize: diskSize,
-				Config:   configBundle.Join(),
-			})
+		if node.ExtraDisks != nil {
+			nodeReq.ExtraDisks = make([]int64, len(node.ExtraDisks))
+
+			for j := range node.ExtraDisks {
+				if nodeReq.ExtraDisks[j], err = spec.ParseSize(node.ExtraDisks[j]); err != nil {
+					return err
+				}
+			}
+		}
+
+		request.Nodes = append(request.Nodes, nodeReq)
 	}
 
 	cluster, err := provisioner.Create(ctx, request, provisionOptions...)
@@ -286,6 +422,16 @@ func create(ctx context.Context) (err error) {
 		return err
 	}
 
+	// Save machine configuration to the state directory, so that more nodes could be added later.
+	statePath, err := cluster.StatePath()
+	if err != nil {
+		return err
+	}
+
+	if err = configBundle.Write(statePath); err != nil {
+		return fmt.Errorf("error saving cluster configuration: %w", err)
+	}
+
 	// Create and save the osctl configuration file.
 	if err = saveConfig(cluster, configBundle.TalosConfig()); err != nil {
 		return err
@@ -321,6 +467,366 @@ func destroy(ctx context.Context) error {
 	return provisioner.Destroy(ctx, cluster)
 }
 
+//nolint: gocyclo
+func scale(ctx context.Context, mastersChanged, workersChanged bool) error {
+	nanoCPUs, err := parseCPUShare()
+	if err != nil {
+		return fmt.Errorf("error parsing --cpus: %s", err)
+	}
+
+	memory := int64(clusterMemory) * 1024 * 1024
+	diskSize := int64(clusterDiskSize) * 1024 * 1024
+
+	extraDiskSizes := make([]int64, extraDisks)
+	for i := range extraDiskSizes {
+		extraDiskSizes[i] = int64(extraDiskSize) * 1024 * 1024
+	}
+
+	// Parse nameservers
+	nameserverIPs := make([]net.IP, len(nameservers))
+
+	for i := range nameserverIPs {
+		nameserverIPs[i] = net.ParseIP(nameservers[i])
+		if nameserverIPs[i] == nil {
+			return fmt.Errorf("failed parsing nameserver IP %q", nameservers[i])
+		}
+	}
+
+	kernelPath := nodeVmlinuxPath
+	if provisioner == "qemu" {
+		kernelPath = nodeVmlinuzPath
+	}
+
+	provisioner, err := providers.Factory(ctx, provisioner)
+	if err != nil {
+		return err
+	}
+
+	defer provisioner.Close() //nolint: errcheck
+
+	cluster, err := provisioner.Reflect(ctx, clusterName, stateDir)
+	if err != nil {
+		return err
+	}
+
+	statePath, err := cluster.StatePath()
+	if err != nil {
+		return err
+	}
+
+	configBundle, err := config.NewConfigBundle(config.WithExistingConfigs(statePath))
+	if err != nil {
+		return fmt.Errorf("error loading cluster configuration from %q, was the cluster created with older version of osctl?: %w", statePath, err)
+	}
+
+	var masterNodes, workerNodes []provision.NodeInfo
+
+	for _, node := range cluster.Info().Nodes {
+		if node.Type == machine.TypeWorker {
+			workerNodes = append(workerNodes, node)
+		} else {
+			masterNodes = append(masterNodes, node)
+		}
+	}
+
+	sortNodesByIndex(masterNodes)
+	sortNodesByIndex(workerNodes)
+
+	if !mastersChanged {
+		scaleMasters = len(masterNodes)
+	}
+
+	if !workersChanged {
+		scaleWorkers = len(workerNodes)
+	}
+
+	if scaleMasters < 1 {
+		return fmt.Errorf("number of masters can't be less than 1")
+	}
+
+	if scaleWorkers < 0 {
+		return fmt.Errorf("number of workers can't be negative")
+	}
+
+	provisionOptions := []provision.Option{
+		provision.WithTalosConfig(configBundle.TalosConfig()),
+	}
+
+	if bootloaderEmulation {
+		provisionOptions = append(provisionOptions, provision.WithBootladerEmulation())
+	}
+
+	if uefiEnabled {
+		provisionOptions = append(provisionOptions, provision.WithUEFI(true))
+	}
+
+	// Remove nodes with the highest indexes first, init node is never removed.
+	var (
+		removeMasters []provision.NodeInfo
+		removeNodes   []string
+	)
+
+	for i := len(masterNodes) - 1; i >= 0 && len(masterNodes)-len(removeMasters) > scaleMasters; i-- {
+		if masterNodes[i].Type == machine.TypeInit {
+			continue
+		}
+
+		removeMasters = append(removeMasters, masterNodes[i])
+	}
+
+	if len(masterNodes)-len(removeMasters) > scaleMasters {
+		return fmt.Errorf("can't remove enough master nodes to scale down to %d masters", scaleMasters)
+	}
+
+	// Masters leave etcd one by one, so that etcd doesn't lose the quorum.
+	for _, node := range removeMasters {
+		if err = leaveEtcd(ctx, cluster, node, provisionOptions...); err != nil {
+			return err
+		}
+
+		removeNodes = append(removeNodes, node.Name)
+	}
+
+	for i := len(workerNodes) - 1; i >= scaleWorkers; i-- {
+		removeNodes = append(removeNodes, workerNodes[i].Name)
+	}
+
+	if len(removeNodes) > 0 {
+		if cluster, err = provisioner.RemoveNodes(ctx, cluster, removeNodes, provisionOptions...); err != nil {
+			return err
+		}
+	}
+
+	// Add new nodes.
+	info := cluster.Info()
+
+	request := provision.ClusterRequest{
+		Name: clusterName,
+
+		Network: provision.NetworkRequest{
+			Name:        info.Network.Name,
+			CIDR:        info.Network.CIDR,
+			GatewayAddr: info.Network.GatewayAddr,
+			MTU:         info.Network.MTU,
+			Nameservers: nameserverIPs,
+			CNI: provision.CNIConfig{
+				BinPath:  cniBinPath,
+				ConfDir:  cniConfDir,
+				CacheDir: cniCacheDir,
+			},
+		},
+
+		Image:         nodeImage,
+		KernelPath:    kernelPath,
+		InitramfsPath: nodeInitramfsPath,
+
+		SelfExecutable: os.Args[0],
+		StateDirectory: stateDir,
+	}
+
+	ipAllocator := newIPAllocator(&info.Network.CIDR, info.Nodes)
+
+	newNodeRequest := func(nodeType string, index int, cfg runtime.Configurator) (nodeReq provision.NodeRequest, err error) {
+		nodeReq = provision.NodeRequest{
+			Name:       fmt.Sprintf("%s-%s-%d", clusterName, nodeType, index),
+			Memory:     memory,
+			NanoCPUs:   nanoCPUs,
+			DiskSize:   diskSize,
+			ExtraDisks: extraDiskSizes,
+			Config:     cfg,
+		}
+
+		if nodeReq.IP, err = ipAllocator.next(); err != nil {
+			return nodeReq, err
+		}
+
+		nodeReq.ExtraIPs = make([]net.IP, extraNICs)
+
+		for j := range nodeReq.ExtraIPs {
+			if nodeReq.ExtraIPs[j], err = ipAllocator.next(); err != nil {
+				return nodeReq, err
+			}
+		}
+
+		return nodeReq, nil
+	}
+
+	for i, index := len(masterNodes), nodeIndex(masterNodes); i < scaleMasters; i++ {
+		index++
+
+		var nodeReq provision.NodeRequest
+
+		if nodeReq, err = newNodeRequest("master", index, configBundle.ControlPlane()); err != nil {
+			return err
+		}
+
+		request.Nodes = append(request.Nodes, nodeReq)
+	}
+
+	for i, index := len(workerNodes), nodeIndex(workerNodes); i < scaleWorkers; i++ {
+		index++
+
+		var nodeReq provision.NodeRequest
+
+		if nodeReq, err = newNodeRequest("worker", index, configBundle.Join()); err != nil {
+			return err
+		}
+
+		request.Nodes = append(request.Nodes, nodeReq)
+	}
+
+	if len(request.Nodes) > 0 {
+		if cluster, err = provisioner.AddNodes(ctx, cluster, request, provisionOptions...); err != nil {
+			return err
+		}
+	}
+
+	if !clusterWait {
+		return nil
+	}
+
+	// Run cluster readiness checks
+	checkCtx, checkCtxCancel := context.WithTimeout(ctx, clusterWaitTimeout)
+	defer checkCtxCancel()
+
+	clusterAccess := access.NewAdapter(cluster, provisionOptions...)
+	defer clusterAccess.Close() //nolint: errcheck
+
+	return check.Wait(checkCtx, clusterAccess, check.DefaultClusterChecks(), check.StderrReporter())
+}
+
+// leaveEtcd gracefully resets the master node, so that it leaves etcd cluster.
+//
+// Graceful reset drains the node, removes it from etcd and then proceeds with wiping the disks.
+// leaveEtcd waits for the final message of the reset progress stream, the reset fails if the
+// stream ends before that.
+func leaveEtcd(ctx context.Context, cluster provision.Cluster, node provision.NodeInfo, opts ...provision.Option) error {
+	fmt.Println("removing", strings.TrimPrefix(node.Name, "/"), "from etcd")
+
+	clusterAccess := access.NewAdapter(cluster, opts...)
+	defer clusterAccess.Close() //nolint: errcheck
+
+	cli, err := clusterAccess.Client()
+	if err != nil {
+		return err
+	}
+
+	nodeCtx := client.WithNodes(ctx, node.PrivateIP.String())
+
+	stream, err := cli.ResetProgress(nodeCtx)
+	if err != nil {
+		return fmt.Errorf("error watching reset progress: %w", err)
+	}
+
+	if err = cli.Reset(nodeCtx, true, false); err != nil {
+		return fmt.Errorf("error resetting node %q: %w", node.Name, err)
+	}
+
+	for {
+		var progress *machineapi.WipeProgress
+
+		progress, err = stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return fmt.Errorf("reset progress stream of node %q ended before the reset was done", node.Name)
+			}
+
+			return fmt.Errorf("error waiting for node %q to leave etcd: %w", node.Name, err)
+		}
+
+		if progress.Metadata != nil && progress.Metadata.Error != "" {
+			return fmt.Errorf("error resetting node %q: %s", node.Name, progress.Metadata.Error)
+		}
+
+		if progress.Stage == machineapi.WipeProgress_DONE {
+			if progress.Error != "" {
+				return fmt.Errorf("error resetting node %q: %s", node.Name, progress.Error)
+			}
+
+			return nil
+		}
+	}
+}
+
+// nodeIndex returns the highest index of the nodes named <cluster>-<type>-<index>.
+func nodeIndex(nodes []provision.NodeInfo) int {
+	max := 0
+
+	for _, node := range nodes {
+		if index := parseNodeIndex(node.Name); index > max {
+			max = index
+		}
+	}
+
+	return max
+}
+
+func parseNodeIndex(name string) int {
+	name = strings.TrimPrefix(name, "/")
+
+	index, err := strconv.Atoi(name[strings.LastIndex(name, "-")+1:])
+	if err != nil {
+		return 0
+	}
+
+	return index
+}
+
+func sortNodesByIndex(nodes []provision.NodeInfo) {
+	sort.Slice(nodes, func(i, j int) bool { return parseNodeIndex(nodes[i].Name) < parseNodeIndex(nodes[j].Name) })
+}
+
+// ipAllocator allocates IPs from the cluster CIDR skipping the gateway and the addresses in use.
+type ipAllocator struct {
+	cidr *net.IPNet
+	used map[string]struct{}
+	n    int
+}
+
+func newIPAllocator(cidr *net.IPNet, nodes []provision.NodeInfo) *ipAllocator {
+	a := &ipAllocator{
+		cidr: cidr,
+		used: map[string]struct{}{},
+		n:    1, // skip gateway
+	}
+
+	for _, node := range nodes {
+		a.reserve(node.PrivateIP)
+
+		for _, ip := range node.ExtraIPs {
+			a.reserve(ip)
+		}
+	}
+
+	return a
+}
+
+// reserve marks the IP as used, it returns false if the IP is already in use.
+func (a *ipAllocator) reserve(ip net.IP) bool {
+	if _, ok := a.used[ip.String()]; ok {
+		return false
+	}
+
+	a.used[ip.String()] = struct{}{}
+
+	return true
+}
+
+func (a *ipAllocator) next() (net.IP, error) {
+	for {
+		a.n++
+
+		ip, err := talosnet.NthIPInNetwork(a.cidr, a.n)
+		if err != nil {
+			return nil, fmt.Errorf("no free IPs left in %s: %w", a.cidr, err)
+		}
+
+		if a.reserve(ip) {
+			return ip, nil
+		}
+	}
+}
+
 func show(ctx context.Context) error {
 	provisioner, err := providers.Factory(ctx, provisioner)
 	if err != nil {
@@ -334,6 +840,22 @@ func show(ctx context.Context) error {
 		return err
 	}
 
+	switch showOutput {
+	case "table":
+	case "yaml":
+		var out []byte
+
+		if out, err = yaml.Marshal(spec.FromClusterInfo(cluster.Provisioner(), cluster.Info())); err != nil {
+			return err
+		}
+
+		_, err = os.Stdout.Write(out)
+
+		return err
+	default:
+		return fmt.Errorf("unsupported output format %q", showOutput)
+	}
+
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 	fmt.Fprintf(w, "PROVISIONER\t%s\n", cluster.Provisioner())
 	fmt.Fprintf(w, "NAME\t%s\n", cluster.Info().ClusterName)
@@ -386,6 +908,93 @@ func show(ctx context.Context) error {
 	return w.Flush()
 }
 
+func save(ctx context.Context) error {
+	provisioner, err := providers.Factory(ctx, provisioner)
+	if err != nil {
+		return err
+	}
+
+	defer provisioner.Close() //nolint: errcheck
+
+	cluster, err := provisioner.Reflect(ctx, clusterName, stateDir)
+	if err != nil {
+		return err
+	}
+
+	if len(cluster.Info().Nodes) == 0 {
+		return fmt.Errorf("cluster %q not found", clusterName)
+	}
+
+	output := snapshotOutput
+	if output == "" {
+		output = clusterName + ".tar.gz"
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	if err = provisioner.Save(ctx, cluster, f); err != nil {
+		f.Close()         //nolint: errcheck
+		os.Remove(output) //nolint: errcheck
+
+		return fmt.Errorf("error saving cluster snapshot: %w", err)
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "cluster snapshot saved to %q\n", output)
+
+	return nil
+}
+
+func restore(ctx context.Context, snapshotPath string) error {
+	provisioner, err := providers.Factory(ctx, provisioner)
+	if err != nil {
+		return err
+	}
+
+	defer provisioner.Close() //nolint: errcheck
+
+	f, err := os.Open(snapshotPath)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	cluster, err := provisioner.Restore(ctx, provision.RestoreRequest{
+		Snapshot:       f,
+		StateDirectory: stateDir,
+		SelfExecutable: os.Args[0],
+		CNI: provision.CNIConfig{
+			BinPath:  cniBinPath,
+			ConfDir:  cniConfDir,
+			CacheDir: cniCacheDir,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	statePath, err := cluster.StatePath()
+	if err != nil {
+		return err
+	}
+
+	configBundle, err := config.NewConfigBundle(config.WithExistingConfigs(statePath))
+	if err != nil {
+		return fmt.Errorf("error loading cluster configuration from %q: %w", statePath, err)
+	}
+
+	return saveConfig(cluster, configBundle.TalosConfig())
+}
+
 func saveConfig(cluster provision.Cluster, talosConfigObj *clientconfig.Config) (err error) {
 	c, err := clientconfig.Open(talosconfig)
 	if err != nil {
@@ -403,18 +1012,176 @@ func saveConfig(cluster provision.Cluster, talosConfigObj *clientconfig.Config)
 	return c.Save(talosconfig)
 }
 
-func parseCPUShare() (int64, error) {
-	cpu, ok := new(big.Rat).SetString(clusterCpus)
-	if !ok {
-		return 0, fmt.Errorf("failed to parsing as a rational number: %s", clusterCpus)
+// clusterSpecFromFlags builds cluster spec with identical nodes from the flags.
+func clusterSpecFromFlags() *spec.Cluster {
+	clusterSpec := &spec.Cluster{
+		Version: spec.Version,
+	}
+
+	for i := 0; i < masters; i++ {
+		nodeType := machine.TypeControlPlane
+		if i == 0 {
+			nodeType = machine.TypeInit
+		}
+
+		clusterSpec.Nodes = append(clusterSpec.Nodes, spec.Node{
+			Type:      nodeType.String(),
+			ExtraNICs: extraNICs,
+		})
 	}
 
-	nano := cpu.Mul(cpu, big.NewRat(1e9, 1))
-	if !nano.IsInt() {
-		return 0, errors.New("value is too precise")
+	for i := 0; i < workers; i++ {
+		clusterSpec.Nodes = append(clusterSpec.Nodes, spec.Node{
+			Type:      machine.TypeWorker.String(),
+			ExtraNICs: extraNICs,
+		})
+	}
+
+	return clusterSpec
+}
+
+// applyClusterSpec overrides flag values with cluster-wide settings from the spec.
+//
+// Node settings which are not set in the spec default to the flag values.
+func applyClusterSpec(clusterSpec *spec.Cluster) {
+	for dst, value := range map[*string]string{
+		&clusterName:       clusterSpec.Name,
+		&provisioner:       clusterSpec.Provisioner,
+		&kubernetesVersion: clusterSpec.KubernetesVersion,
+		&nodeImage:         clusterSpec.Image,
+		&nodeInstallImage:  clusterSpec.InstallImage,
+		&nodeVmlinuxPath:   clusterSpec.VmlinuxPath,
+		&nodeVmlinuzPath:   clusterSpec.VmlinuzPath,
+		&nodeInitramfsPath: clusterSpec.InitrdPath,
+		&forceEndpoint:     clusterSpec.Endpoint,
+		&networkCIDR:       clusterSpec.Network.CIDR,
+	} {
+		if value != "" {
+			*dst = value
+		}
+	}
+
+	if len(clusterSpec.RegistryMirrors) > 0 {
+		registryMirrors = nil
+
+		for registry, mirror := range clusterSpec.RegistryMirrors {
+			registryMirrors = append(registryMirrors, registry+"="+mirror)
+		}
+
+		sort.Strings(registryMirrors)
 	}
 
-	return nano.Num().Int64(), nil
+	if clusterSpec.Network.MTU != 0 {
+		networkMTU = clusterSpec.Network.MTU
+	}
+
+	if len(clusterSpec.Network.Nameservers) > 0 {
+		nameservers = clusterSpec.Network.Nameservers
+	}
+
+	if cni := clusterSpec.Network.CNI; cni != nil {
+		if len(cni.BinPath) > 0 {
+			cniBinPath = cni.BinPath
+		}
+
+		if cni.ConfDir != "" {
+			cniConfDir = cni.ConfDir
+		}
+
+		if cni.CacheDir != "" {
+			cniCacheDir = cni.CacheDir
+		}
+	}
+}
+
+// allocateNodeIPs returns the list of IPs for each node: primary IP followed by the IPs of extra NICs.
+//
+// Static IPs from the spec are reserved first, then primary IPs are allocated, followed by the IPs of extra NICs.
+func allocateNodeIPs(cidr *net.IPNet, gatewayIP net.IP, nodes []spec.Node) ([][]net.IP, error) {
+	allocator := newIPAllocator(cidr, nil)
+
+	parseIP := func(ip string) (net.IP, error) {
+		parsed := net.ParseIP(ip)
+
+		switch {
+		case parsed == nil:
+			return nil, fmt.Errorf("failed parsing node IP %q", ip)
+		case !cidr.Contains(parsed):
+			return nil, fmt.Errorf("node IP %s is not in %s", ip, cidr)
+		case parsed.Equal(gatewayIP):
+			return nil, fmt.Errorf("node IP %s conflicts with the gateway address", ip)
+		}
+
+		return parsed, nil
+	}
+
+	for _, node := range nodes {
+		for _, ip := range append([]string{node.IP}, node.ExtraIPs...) {
+			if ip == "" {
+				continue
+			}
+
+			parsed, err := parseIP(ip)
+			if err != nil {
+				return nil, err
+			}
+
+			if !allocator.reserve(parsed) {
+				return nil, fmt.Errorf("node IP %s is used more than once", ip)
+			}
+		}
+	}
+
+	result := make([][]net.IP, len(nodes))
+
+	for i, node := range nodes {
+		var (
+			ip  net.IP
+			err error
+		)
+
+		if node.IP != "" {
+			ip, err = parseIP(node.IP)
+		} else {
+			ip, err = allocator.next()
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = []net.IP{ip}
+	}
+
+	for i, node := range nodes {
+		if len(node.ExtraIPs) > 0 {
+			for _, extraIP := range node.ExtraIPs {
+				ip, err := parseIP(extraIP)
+				if err != nil {
+					return nil, err
+				}
+
+				result[i] = append(result[i], ip)
+			}
+
+			continue
+		}
+
+		for j := 0; j < node.ExtraNICs; j++ {
+			ip, err := allocator.next()
+			if err != nil {
+				return nil, err
+			}
+
+			result[i] = append(result[i], ip)
+		}
+	}
+
+	return result, nil
+}
+
+func parseCPUShare() (int64, error) {
+	return spec.ParseCPUs(clusterCpus)
 }
 
 func init() {
@@ -426,8 +1193,10 @@ func init() {
 	clusterUpCmd.Flags().StringVar(&nodeImage, "image", defaultImage(constants.DefaultTalosImageRepository), "the image to use")
 	clusterUpCmd.Flags().StringVar(&nodeInstallImage, "install-image", defaultImage(constants.DefaultInstallerImageRepository), "the installer image to use")
 	clusterUpCmd.Flags().StringVar(&nodeVmlinuxPath, "vmlinux-path", helpers.ArtifactPath(constants.KernelUncompressedAsset), "the uncompressed kernel image to use")
+	clusterUpCmd.Flags().StringVar(&nodeVmlinuzPath, "vmlinuz-path", helpers.ArtifactPath(constants.KernelAsset), "the compressed kernel image to use")
 	clusterUpCmd.Flags().StringVar(&nodeInitramfsPath, "initrd-path", helpers.ArtifactPath(constants.InitramfsAsset), "the uncompressed kernel image to use")
 	clusterUpCmd.Flags().BoolVar(&bootloaderEmulation, "with-bootloader-emulation", false, "enable bootloader emulation to load kernel and initramfs from disk image")
+	clusterUpCmd.Flags().BoolVar(&uefiEnabled, "with-uefi", false, "boot VMs with UEFI firmware instead of BIOS (QEMU only)")
 	clusterUpCmd.Flags().StringSliceVar(&registryMirrors, "registry-mirror", []string{}, "list of registry mirrors to use in format: <registry host>=<mirror URL>")
 	clusterUpCmd.Flags().IntVar(&networkMTU, "mtu", 1500, "MTU of the docker bridge network")
 	clusterUpCmd.Flags().StringVar(&networkCIDR, "cidr", "10.5.0.0/24", "CIDR of the docker bridge network")
@@ -437,6 +1206,9 @@ func init() {
 	clusterUpCmd.Flags().StringVar(&clusterCpus, "cpus", "1.5", "the share of CPUs as fraction (each container)")
 	clusterUpCmd.Flags().IntVar(&clusterMemory, "memory", 1024, "the limit on memory usage in MB (each container)")
 	clusterUpCmd.Flags().IntVar(&clusterDiskSize, "disk", 4*1024, "the limit on disk size in MB (each VM)")
+	clusterUpCmd.Flags().IntVar(&extraDisks, "extra-disks", 0, "the number of extra disks to create for each VM (VM only)")
+	clusterUpCmd.Flags().IntVar(&extraDiskSize, "extra-disks-size", 5*1024, "the limit on extra disk size in MB (each extra disk)")
+	clusterUpCmd.Flags().IntVar(&extraNICs, "extra-nics", 0, "the number of extra network interfaces to create for each VM (QEMU only)")
 	clusterUpCmd.Flags().BoolVar(&clusterWait, "wait", false, "wait for the cluster to be ready before returning")
 	clusterUpCmd.Flags().DurationVar(&clusterWaitTimeout, "wait-timeout", 20*time.Minute, "timeout to wait for the cluster to be ready")
 	clusterUpCmd.Flags().BoolVar(&forceInitNodeAsEndpoint, "init-node-as-endpoint", false, "use init node as endpoint instead of any load balancer endpoint")
@@ -446,11 +1218,40 @@ func init() {
 	clusterUpCmd.Flags().StringSliceVar(&cniBinPath, "cni-bin-path", []string{"/opt/cni/bin"}, "search path for CNI binaries")
 	clusterUpCmd.Flags().StringVar(&cniConfDir, "cni-conf-dir", "/etc/cni/conf.d", "CNI config directory path")
 	clusterUpCmd.Flags().StringVar(&cniCacheDir, "cni-cache-dir", "/var/lib/cni", "CNI cache directory path")
+	clusterUpCmd.Flags().StringVarP(&clusterSpecPath, "file", "f", "", "path to the cluster spec file, settings not present in the spec default to the flag values")
+	clusterScaleCmd.Flags().IntVar(&scaleMasters, "masters", 0, "the desired number of masters (unchanged if not set)")
+	clusterScaleCmd.Flags().IntVar(&scaleWorkers, "workers", 0, "the desired number of workers (unchanged if not set)")
+	clusterScaleCmd.Flags().StringVar(&nodeImage, "image", defaultImage(constants.DefaultTalosImageRepository), "the image to use")
+	clusterScaleCmd.Flags().StringVar(&nodeVmlinuxPath, "vmlinux-path", helpers.ArtifactPath(constants.KernelUncompressedAsset), "the uncompressed kernel image to use")
+	clusterScaleCmd.Flags().StringVar(&nodeVmlinuzPath, "vmlinuz-path", helpers.ArtifactPath(constants.KernelAsset), "the compressed kernel image to use")
+	clusterScaleCmd.Flags().StringVar(&nodeInitramfsPath, "initrd-path", helpers.ArtifactPath(constants.InitramfsAsset), "the uncompressed kernel image to use")
+	clusterScaleCmd.Flags().BoolVar(&bootloaderEmulation, "with-bootloader-emulation", false, "enable bootloader emulation to load kernel and initramfs from disk image")
+	clusterScaleCmd.Flags().BoolVar(&uefiEnabled, "with-uefi", false, "boot VMs with UEFI firmware instead of BIOS (QEMU only)")
+	clusterScaleCmd.Flags().StringSliceVar(&nameservers, "nameservers", []string{"8.8.8.8", "1.1.1.1"}, "list of nameservers to use (VM only)")
+	clusterScaleCmd.Flags().StringVar(&clusterCpus, "cpus", "1.5", "the share of CPUs as fraction (each container)")
+	clusterScaleCmd.Flags().IntVar(&clusterMemory, "memory", 1024, "the limit on memory usage in MB (each container)")
+	clusterScaleCmd.Flags().IntVar(&clusterDiskSize, "disk", 4*1024, "the limit on disk size in MB (each VM)")
+	clusterScaleCmd.Flags().IntVar(&extraDisks, "extra-disks", 0, "the number of extra disks to create for each VM (VM only)")
+	clusterScaleCmd.Flags().IntVar(&extraDiskSize, "extra-disks-size", 5*1024, "the limit on extra disk size in MB (each extra disk)")
+	clusterScaleCmd.Flags().IntVar(&extraNICs, "extra-nics", 0, "the number of extra network interfaces to create for each VM (QEMU only)")
+	clusterScaleCmd.Flags().BoolVar(&clusterWait, "wait", false, "wait for the cluster to be ready before returning")
+	clusterScaleCmd.Flags().DurationVar(&clusterWaitTimeout, "wait-timeout", 20*time.Minute, "timeout to wait for the cluster to be ready")
+	clusterScaleCmd.Flags().StringSliceVar(&cniBinPath, "cni-bin-path", []string{"/opt/cni/bin"}, "search path for CNI binaries")
+	clusterScaleCmd.Flags().StringVar(&cniConfDir, "cni-conf-dir", "/etc/cni/conf.d", "CNI config directory path")
+	clusterScaleCmd.Flags().StringVar(&cniCacheDir, "cni-cache-dir", "/var/lib/cni", "CNI cache directory path")
+	clusterShowCmd.Flags().StringVarP(&showOutput, "output", "o", "table", "output format (table, yaml)")
+	clusterSaveCmd.Flags().StringVarP(&snapshotOutput, "output", "o", "", "path to the snapshot archive (defaults to <cluster name>.tar.gz)")
+	clusterRestoreCmd.Flags().StringSliceVar(&cniBinPath, "cni-bin-path", []string{"/opt/cni/bin"}, "search path for CNI binaries")
+	clusterRestoreCmd.Flags().StringVar(&cniConfDir, "cni-conf-dir", "/etc/cni/conf.d", "CNI config directory path")
+	clusterRestoreCmd.Flags().StringVar(&cniCacheDir, "cni-cache-dir", "/var/lib/cni", "CNI cache directory path")
 	clusterCmd.PersistentFlags().StringVar(&provisioner, "provisioner", "docker", "Talos cluster provisioner to use")
 	clusterCmd.PersistentFlags().StringVar(&stateDir, "state", defaultStateDir, "directory path to store cluster state")
 	clusterCmd.PersistentFlags().StringVar(&clusterName, "name", "talos-default", "the name of the cluster")
 	clusterCmd.AddCommand(clusterUpCmd)
 	clusterCmd.AddCommand(clusterDownCmd)
 	clusterCmd.AddCommand(clusterShowCmd)
+	clusterCmd.AddCommand(clusterScaleCmd)
+	clusterCmd.AddCommand(clusterSaveCmd)
+	clusterCmd.AddCommand(clusterRestoreCmd)
 	rootCmd.AddCommand(clusterCmd)
 }
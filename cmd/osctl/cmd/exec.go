@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	criconstants "github.com/containerd/cri/pkg/constants"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/talos-systems/talos/api/common"
+	osapi "github.com/talos-systems/talos/api/os"
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/pkg/constants"
+)
+
+var (
+	execStdin bool
+	execTTY   bool
+)
+
+// execCmd represents the exec command
+var execCmd = &cobra.Command{
+	Use:   "exec <id> -- <command> [args...]",
+	Short: "Run a command in a container",
+	Long: `Run a command in the namespaces of the running container.
+
+The command inherits environment, user and capabilities of the container process.
+Exec requires admin role in the client certificate.`,
+	Example: `  osctl exec etcd -- etcdctl member list
+  osctl exec -k -it <container> -- sh`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var exitCode uint32
+
+		err := WithClient(func(ctx context.Context, c *client.Client) (err error) {
+			exitCode, err = execProcess(ctx, c, args[0], args[1:])
+
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		if exitCode != 0 {
+			os.Exit(int(exitCode))
+		}
+
+		return nil
+	},
+}
+
+// nolint: gocyclo
+func execProcess(ctx context.Context, c *client.Client, id string, args []string) (uint32, error) {
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get("nodes")) > 1 {
+		return 0, errors.New("exec is supported only for a single node")
+	}
+
+	namespace := constants.SystemContainerdNamespace
+	if kubernetes {
+		namespace = criconstants.K8sContainerdNamespace
+	}
+
+	start := &osapi.ExecStart{
+		Namespace: namespace,
+		Driver:    common.ContainerDriver_CONTAINERD,
+		Id:        id,
+		Args:      args,
+		Tty:       execTTY,
+	}
+
+	stdinFd := int(os.Stdin.Fd())
+
+	if execTTY {
+		if !terminal.IsTerminal(stdinFd) {
+			return 0, errors.New("--tty requires stdin to be a terminal")
+		}
+
+		if width, height, err := terminal.GetSize(stdinFd); err == nil {
+			start.Size = &osapi.ExecResize{
+				Width:  uint32(width),
+				Height: uint32(height),
+			}
+		}
+
+		state, err := terminal.MakeRaw(stdinFd)
+		if err != nil {
+			return 0, fmt.Errorf("error switching terminal to raw mode: %w", err)
+		}
+
+		// nolint: errcheck
+		defer terminal.Restore(stdinFd, state)
+	}
+
+	stream, err := c.Exec(ctx, start)
+	if err != nil {
+		return 0, fmt.Errorf("error starting process: %w", err)
+	}
+
+	sender := &execSender{stream: stream}
+
+	if execStdin || execTTY {
+		go sender.forwardStdin(os.Stdin)
+	} else if err = sender.send(&osapi.ExecRequest{CloseStdin: true}); err != nil {
+		return 0, err
+	}
+
+	if execTTY {
+		go sender.forwardResize(ctx, stdinFd)
+	}
+
+	for {
+		var resp *osapi.ExecResponse
+
+		resp, err = stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return 0, errors.New("exec stream closed before the process exited")
+			}
+
+			return 0, fmt.Errorf("error executing process: %w", err)
+		}
+
+		if resp.Metadata != nil && resp.Metadata.Error != "" {
+			return 0, fmt.Errorf("error executing process: %s", resp.Metadata.Error)
+		}
+
+		if _, err = os.Stdout.Write(resp.Stdout); err != nil {
+			return 0, err
+		}
+
+		if _, err = os.Stderr.Write(resp.Stderr); err != nil {
+			return 0, err
+		}
+
+		if resp.Exited {
+			return resp.ExitCode, nil
+		}
+	}
+}
+
+// execSender serializes sends to the exec stream.
+type execSender struct {
+	mu     sync.Mutex
+	stream osapi.OSService_ExecClient
+}
+
+func (s *execSender) send(req *osapi.ExecRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stream.Send(req)
+}
+
+func (s *execSender) forwardStdin(r io.Reader) {
+	buf := make([]byte, 4096)
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if s.send(&osapi.ExecRequest{Stdin: append([]byte(nil), buf[:n]...)}) != nil {
+				return
+			}
+		}
+
+		if err != nil {
+			// nolint: errcheck
+			s.send(&osapi.ExecRequest{CloseStdin: true})
+
+			return
+		}
+	}
+}
+
+func (s *execSender) forwardResize(ctx context.Context, fd int) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGWINCH)
+
+	defer signal.Stop(sigCh)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sigCh:
+		}
+
+		width, height, err := terminal.GetSize(fd)
+		if err != nil {
+			continue
+		}
+
+		if s.send(&osapi.ExecRequest{Resize: &osapi.ExecResize{Width: uint32(width), Height: uint32(height)}}) != nil {
+			return
+		}
+	}
+}
+
+func init() {
+	execCmd.Flags().BoolVarP(&kubernetes, "kubernetes", "k", false, "use the k8s.io containerd namespace")
+	execCmd.Flags().BoolVarP(&execStdin, "stdin", "i", false, "pass stdin to the process")
+	execCmd.Flags().BoolVarP(&execTTY, "tty", "t", false, "allocate a terminal for the process (implies --stdin)")
+	rootCmd.AddCommand(execCmd)
+}
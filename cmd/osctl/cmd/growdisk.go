@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	machineapi "github.com/talos-systems/talos/api/machine"
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/cmd/osctl/pkg/helpers"
+)
+
+// growDiskCmd represents the growdisk command.
+var growDiskCmd = &cobra.Command{
+	Use:   "growdisk <device>",
+	Short: "Grow an extra disk",
+	Long: `Grow the last partition of an extra disk and its filesystem to the size of the disk.
+
+The device must be one of the disks defined in the machine config, e.g.:
+
+  osctl growdisk /dev/sdb`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.GrowDisk(ctx, args[0], grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error growing disk: %s", err)
+				}
+
+				helpers.Warning("%s", err)
+			}
+
+			return growDiskRender(&remotePeer, resp)
+		})
+	},
+}
+
+func growDiskRender(remotePeer *peer.Peer, resp *machineapi.GrowDiskResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tPARTITION\tMOUNTED ON\tBEFORE\tAFTER")
+
+	defaultNode := helpers.AddrFromPeer(remotePeer)
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			node, msg.Partition, msg.MountedOn, humanize.Bytes(msg.SizeBefore), humanize.Bytes(msg.SizeAfter))
+	}
+
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(growDiskCmd)
+}
@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/internal/pkg/provision/access"
+	"github.com/talos-systems/talos/internal/pkg/provision/check"
+	"github.com/talos-systems/talos/pkg/config/machine"
+)
+
+var healthCmdFlags struct {
+	initNode          string
+	controlPlaneNodes []string
+	workerNodes       []string
+	k8sEndpoint       string
+	checks            []string
+	skipChecks        []string
+	output            string
+	waitTimeout       time.Duration
+}
+
+// healthCmd represents the health command
+var healthCmd = &cobra.Command{
+	Use:   "health",
+	Short: "Check cluster health",
+	Long: `Check cluster health.
+
+Health checks are run against the cluster described by the node flags, the cluster doesn't
+have to be created with 'osctl cluster create'. Talos API is accessed via the endpoints of the
+client configuration. Checks are run in order, each check is retried until it passes or its timeout expires.
+
+Available checks: ` + strings.Join(check.CheckNames(check.AllNamedChecks()), ", ") + `.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		checks, err := check.SelectChecks(check.AllNamedChecks(), healthCmdFlags.checks, healthCmdFlags.skipChecks)
+		if err != nil {
+			return err
+		}
+
+		switch healthCmdFlags.output {
+		case "text", "json", "junit":
+		default:
+			return fmt.Errorf("unsupported output format %q", healthCmdFlags.output)
+		}
+
+		info, err := healthClusterInfo()
+		if err != nil {
+			return err
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			opts := []provision.Option{provision.WithTalosClient(c)}
+
+			if healthCmdFlags.k8sEndpoint != "" {
+				opts = append(opts, provision.WithEndpoint(healthCmdFlags.k8sEndpoint))
+			}
+
+			clusterAccess := access.NewAdapter(&healthCluster{info: info}, opts...)
+			defer clusterAccess.Close() //nolint: errcheck
+
+			checkCtx, checkCtxCancel := context.WithTimeout(ctx, healthCmdFlags.waitTimeout)
+			defer checkCtxCancel()
+
+			results := check.Run(checkCtx, clusterAccess, checks, check.StderrReporter())
+
+			switch healthCmdFlags.output {
+			case "json":
+				err = check.WriteJSON(os.Stdout, results)
+			case "junit":
+				err = check.WriteJUnit(os.Stdout, "talos-health", results)
+			default:
+				err = check.WriteText(os.Stdout, results)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			failed := 0
+
+			for _, result := range results {
+				if !result.Passed() {
+					failed++
+				}
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d health checks failed", failed, len(results))
+			}
+
+			return nil
+		})
+	},
+}
+
+// healthCluster implements provision.Cluster for the cluster described by the command line flags.
+type healthCluster struct {
+	info provision.ClusterInfo
+}
+
+func (cluster *healthCluster) Provisioner() string {
+	return ""
+}
+
+func (cluster *healthCluster) StatePath() (string, error) {
+	return "", fmt.Errorf("cluster state is not available")
+}
+
+func (cluster *healthCluster) Info() provision.ClusterInfo {
+	return cluster.info
+}
+
+func healthClusterInfo() (provision.ClusterInfo, error) {
+	info := provision.ClusterInfo{}
+
+	if healthCmdFlags.initNode == "" {
+		return info, fmt.Errorf("init node should be specified with --init-node")
+	}
+
+	addNode := func(addr string, machineType machine.Type) error {
+		ip := net.ParseIP(addr)
+		if ip == nil {
+			return fmt.Errorf("failed to parse node IP %q", addr)
+		}
+
+		info.Nodes = append(info.Nodes, provision.NodeInfo{
+			Name:      addr,
+			Type:      machineType,
+			PrivateIP: ip,
+		})
+
+		return nil
+	}
+
+	if err := addNode(healthCmdFlags.initNode, machine.TypeInit); err != nil {
+		return info, err
+	}
+
+	for _, addr := range healthCmdFlags.controlPlaneNodes {
+		if err := addNode(addr, machine.TypeControlPlane); err != nil {
+			return info, err
+		}
+	}
+
+	for _, addr := range healthCmdFlags.workerNodes {
+		if err := addNode(addr, machine.TypeWorker); err != nil {
+			return info, err
+		}
+	}
+
+	return info, nil
+}
+
+func init() {
+	healthCmd.Flags().StringVar(&healthCmdFlags.initNode, "init-node", "", "specify IP of the init node")
+	healthCmd.Flags().StringSliceVar(&healthCmdFlags.controlPlaneNodes, "control-plane-nodes", nil, "specify IPs of control plane nodes")
+	healthCmd.Flags().StringSliceVar(&healthCmdFlags.workerNodes, "worker-nodes", nil, "specify IPs of worker nodes")
+	healthCmd.Flags().StringVar(&healthCmdFlags.k8sEndpoint, "k8s-endpoint", "", "use endpoint instead of kubeconfig default")
+	healthCmd.Flags().StringSliceVar(&healthCmdFlags.checks, "checks", nil, "run only the specified checks")
+	healthCmd.Flags().StringSliceVar(&healthCmdFlags.skipChecks, "skip-checks", nil, "skip the specified checks")
+	healthCmd.Flags().StringVarP(&healthCmdFlags.output, "output", "o", "text", "output format (text, json, junit)")
+	healthCmd.Flags().DurationVar(&healthCmdFlags.waitTimeout, "wait-timeout", 20*time.Minute, "timeout to wait for the cluster to be healthy")
+	rootCmd.AddCommand(healthCmd)
+}
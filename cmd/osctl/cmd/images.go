@@ -0,0 +1,216 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	criconstants "github.com/containerd/cri/pkg/constants"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	imageapi "github.com/talos-systems/talos/api/image"
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/cmd/osctl/pkg/helpers"
+	"github.com/talos-systems/talos/pkg/constants"
+)
+
+// imagesCmd represents the images command
+var imagesCmd = &cobra.Command{
+	Use:   "images",
+	Short: "List and manage container images",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.ImageList(ctx, imagesNamespace(), grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error listing images: %s", err)
+				}
+
+				helpers.Warning("%s", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tIMAGE\tDIGEST\tSIZE\tCREATED")
+
+			defaultNode := helpers.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				images := msg.Images
+				sort.Slice(images, func(i, j int) bool {
+					return strings.Compare(images[i].Name, images[j].Name) < 0
+				})
+
+				for _, image := range images {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", node, image.Name, shortDigest(image.Digest), humanize.Bytes(uint64(image.Size)), imageCreated(image))
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// imagesPullCmd represents the images pull command
+var imagesPullCmd = &cobra.Command{
+	Use:   "pull <image>",
+	Short: "Pull an image",
+	Long:  ``,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			var remotePeer peer.Peer
+
+			resp, err := c.ImagePull(ctx, imagesNamespace(), args[0], grpc.Peer(&remotePeer))
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error pulling image: %s", err)
+				}
+
+				helpers.Warning("%s", err)
+			}
+
+			defaultNode := helpers.AddrFromPeer(&remotePeer)
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				if msg.Image != nil {
+					fmt.Printf("%s: pulled %s (%s)\n", node, msg.Image.Name, msg.Image.Digest)
+				}
+			}
+
+			return nil
+		})
+	},
+}
+
+// imagesRemoveCmd represents the images rm command
+var imagesRemoveCmd = &cobra.Command{
+	Use:     "rm <image>",
+	Aliases: []string{"remove"},
+	Short:   "Remove an image",
+	Long:    ``,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if _, err := c.ImageRemove(ctx, imagesNamespace(), args[0]); err != nil {
+				return fmt.Errorf("error removing image: %s", err)
+			}
+
+			return nil
+		})
+	},
+}
+
+// imagesImportCmd represents the images import command
+var imagesImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import images from a tarball",
+	Long: `Import images from a tarball in OCI image layout or 'docker save' format.
+
+Use '-' to read the tarball from stdin. Imported images are unpacked and
+ready to be used without pulling, which allows to pre-seed images on
+air-gapped nodes before kubelet starts.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var r io.Reader = os.Stdin
+
+		if args[0] != "-" {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+
+			// nolint: errcheck
+			defer f.Close()
+
+			r = f
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			// the tarball is streamed to a single node
+			if err := helpers.FailIfMultiNodes(ctx, "images import"); err != nil {
+				return err
+			}
+
+			var remotePeer peer.Peer
+
+			resp, err := c.ImageImport(ctx, imagesNamespace(), r, grpc.Peer(&remotePeer))
+			if err != nil {
+				return fmt.Errorf("error importing images: %s", err)
+			}
+
+			node := helpers.AddrFromPeer(&remotePeer)
+
+			if resp.Metadata != nil && resp.Metadata.Hostname != "" {
+				node = resp.Metadata.Hostname
+			}
+
+			for _, image := range resp.Images {
+				fmt.Printf("%s: imported %s (%s)\n", node, image.Name, image.Digest)
+			}
+
+			return nil
+		})
+	},
+}
+
+func imagesNamespace() string {
+	if kubernetes {
+		return criconstants.K8sContainerdNamespace
+	}
+
+	return constants.SystemContainerdNamespace
+}
+
+func shortDigest(digest string) string {
+	const shortLen = 12
+
+	parts := strings.SplitN(digest, ":", 2)
+	if len(parts) != 2 || len(parts[1]) < shortLen {
+		return digest
+	}
+
+	return parts[1][:shortLen]
+}
+
+func imageCreated(image *imageapi.ImageInfo) string {
+	created, err := ptypes.Timestamp(image.CreatedAt)
+	if err != nil {
+		return ""
+	}
+
+	return humanize.Time(created)
+}
+
+func init() {
+	imagesCmd.PersistentFlags().BoolVarP(&kubernetes, "kubernetes", "k", false, "use the k8s.io containerd namespace")
+	imagesCmd.AddCommand(imagesPullCmd, imagesRemoveCmd, imagesImportCmd)
+	rootCmd.AddCommand(imagesCmd)
+}
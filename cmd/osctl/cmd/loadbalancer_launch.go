@@ -5,18 +5,30 @@
 package cmd
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/talos-systems/talos/internal/pkg/loadbalancer"
+	"github.com/talos-systems/talos/internal/pkg/loadbalancer/upstream"
 	"github.com/talos-systems/talos/pkg/constants"
 )
 
 var loadbalancerLaunchCmdFlags struct {
-	addr             string
-	upstreams        []string
-	apidOnlyInitNode bool
+	addr                string
+	upstreams           []string
+	apidOnlyInitNode    bool
+	strategy            string
+	drainTimeout        time.Duration
+	apiserverHealthCA   string
+	apiserverHealthPath string
+	statsInterval       time.Duration
 }
 
 // loadbalancerLaunchCmd represents the loadbalancer-launch command
@@ -27,7 +39,31 @@ var loadbalancerLaunchCmd = &cobra.Command{
 	Args:   cobra.NoArgs,
 	Hidden: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var lb loadbalancer.TCP
+		strategy, err := upstream.ParseStrategy(loadbalancerLaunchCmdFlags.strategy)
+		if err != nil {
+			return err
+		}
+
+		var apiserverHealthCheck loadbalancer.HealthCheck
+
+		if loadbalancerLaunchCmdFlags.apiserverHealthCA != "" {
+			var caPEM []byte
+
+			if caPEM, err = ioutil.ReadFile(loadbalancerLaunchCmdFlags.apiserverHealthCA); err != nil {
+				return err
+			}
+
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(caPEM) {
+				return fmt.Errorf("no certificates found in %q", loadbalancerLaunchCmdFlags.apiserverHealthCA)
+			}
+
+			apiserverHealthCheck = loadbalancer.HTTPSHealthCheck(loadbalancerLaunchCmdFlags.apiserverHealthPath, &tls.Config{RootCAs: pool})
+		}
+
+		lb := &loadbalancer.TCP{
+			DrainTimeout: loadbalancerLaunchCmdFlags.drainTimeout,
+		}
 
 		for _, port := range []int{constants.ApidPort, 6443} { // TODO: need to put 6443 as constant or use config?
 			upstreams := make([]string, len(loadbalancerLaunchCmdFlags.upstreams))
@@ -42,18 +78,59 @@ var loadbalancerLaunchCmd = &cobra.Command{
 				}
 			}
 
-			if err := lb.AddRoute(fmt.Sprintf("%s:%d", loadbalancerLaunchCmdFlags.addr, port), upstreams); err != nil {
+			healthCheck := loadbalancer.TCPHealthCheck
+			options := []upstream.ListOption{upstream.WithStrategy(strategy)}
+
+			if port == 6443 && loadbalancerLaunchCmdFlags.apiserverHealthCA != "" {
+				healthCheck = apiserverHealthCheck
+				// HTTPS health check requires TLS handshake, so it takes longer than TCP dial
+				options = append(options, upstream.WithHealthcheckTimeout(time.Second))
+			}
+
+			if err = lb.AddRouteWithHealthCheck(fmt.Sprintf("%s:%d", loadbalancerLaunchCmdFlags.addr, port), upstreams, healthCheck, options...); err != nil {
 				return err
 			}
 		}
 
+		if loadbalancerLaunchCmdFlags.statsInterval > 0 {
+			go logLoadbalancerStats(lb, loadbalancerLaunchCmdFlags.statsInterval)
+		}
+
 		return lb.Run()
 	},
 }
 
+func logLoadbalancerStats(lb *loadbalancer.TCP, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		stats := lb.Stats()
+
+		routes := make([]string, 0, len(stats))
+		for route := range stats {
+			routes = append(routes, route)
+		}
+
+		sort.Strings(routes)
+
+		for _, route := range routes {
+			for _, upstreamStats := range stats[route] {
+				log.Printf("stats %s -> %s: healthy %v, score %.0f, active connections %d, total connections %d",
+					route, upstreamStats.Addr, upstreamStats.Healthy, upstreamStats.Score, upstreamStats.ActiveConnections, upstreamStats.TotalConnections)
+			}
+		}
+	}
+}
+
 func init() {
 	loadbalancerLaunchCmd.Flags().StringVar(&loadbalancerLaunchCmdFlags.addr, "loadbalancer-addr", "localhost", "load balancer listen address (IP or host)")
 	loadbalancerLaunchCmd.Flags().StringSliceVar(&loadbalancerLaunchCmdFlags.upstreams, "loadbalancer-upstreams", []string{}, "load balancer upstreams (nodes to proxy to)")
 	loadbalancerLaunchCmd.Flags().BoolVar(&loadbalancerLaunchCmdFlags.apidOnlyInitNode, "apid-only-init-node", false, "use only apid init node for load balancing")
+	loadbalancerLaunchCmd.Flags().StringVar(&loadbalancerLaunchCmdFlags.strategy, "strategy", upstream.RoundRobin.String(), "upstream pick strategy (round-robin, least-connections)")
+	loadbalancerLaunchCmd.Flags().DurationVar(&loadbalancerLaunchCmdFlags.drainTimeout, "drain-timeout", 30*time.Second, "time to keep connections to the unhealthy upstream open (0 to never close them)")
+	loadbalancerLaunchCmd.Flags().StringVar(&loadbalancerLaunchCmdFlags.apiserverHealthCA, "apiserver-healthcheck-ca", "", "path to the Kubernetes CA certificate to enable HTTPS health checks for kube-apiserver")
+	loadbalancerLaunchCmd.Flags().StringVar(&loadbalancerLaunchCmdFlags.apiserverHealthPath, "apiserver-healthcheck-path", "/healthz", "HTTPS health check path for kube-apiserver")
+	loadbalancerLaunchCmd.Flags().DurationVar(&loadbalancerLaunchCmdFlags.statsInterval, "stats-interval", 0, "interval to log connection statistics (0 to disable)")
 	rootCmd.AddCommand(loadbalancerLaunchCmd)
 }
@@ -52,7 +52,7 @@ var memoryCmd = &cobra.Command{
 
 func briefRender(remotePeer *peer.Peer, resp *osapi.MemoryResponse) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-	fmt.Fprintln(w, "NODE\tTOTAL\tUSED\tFREE\tSHARED\tBUFFERS\tCACHE\tAVAILABLE")
+	fmt.Fprintln(w, "NODE\tTOTAL\tUSED\tFREE\tSHARED\tBUFFERS\tCACHE\tAVAILABLE\tSWAP\tSWAPFREE")
 
 	defaultNode := helpers.AddrFromPeer(remotePeer)
 
@@ -64,7 +64,7 @@ func briefRender(remotePeer *peer.Peer, resp *osapi.MemoryResponse) error {
 		}
 
 		// Default to displaying output as MB
-		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\n",
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\n",
 			node,
 			msg.Meminfo.Memtotal/1024,
 			(msg.Meminfo.Memtotal-msg.Meminfo.Memfree-msg.Meminfo.Cached-msg.Meminfo.Buffers)/1024,
@@ -73,6 +73,8 @@ func briefRender(remotePeer *peer.Peer, resp *osapi.MemoryResponse) error {
 			msg.Meminfo.Buffers/1024,
 			msg.Meminfo.Cached/1024,
 			msg.Meminfo.Memavailable/1024,
+			msg.Meminfo.Swaptotal/1024,
+			msg.Meminfo.Swapfree/1024,
 		)
 	}
 
@@ -139,6 +141,17 @@ func verboseRender(remotePeer *peer.Peer, resp *osapi.MemoryResponse) error {
 		fmt.Printf("%s: %d %s\n", "DirectMap4k", msg.Meminfo.Directmap4K, "kB")
 		fmt.Printf("%s: %d %s\n", "DirectMap2M", msg.Meminfo.Directmap2M, "kB")
 		fmt.Printf("%s: %d %s\n", "DirectMap1G", msg.Meminfo.Directmap1G, "kB")
+
+		// Dump as /proc/swaps
+		for _, swap := range msg.Swaps {
+			fmt.Printf("%s: %s type=%s size=%d kB used=%d kB priority=%d\n", "Swap",
+				swap.Filename, swap.Type, swap.Size, swap.Used, swap.Priority)
+
+			if swap.Zram != nil {
+				fmt.Printf("%s: %s algorithm=%s orig=%d kB compressed=%d kB\n", "Zram",
+					swap.Filename, swap.Zram.Algorithm, swap.Zram.OrigDataSize/1024, swap.Zram.ComprDataSize/1024)
+			}
+		}
 	}
 
 	return nil
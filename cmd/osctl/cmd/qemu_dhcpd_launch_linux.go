@@ -0,0 +1,34 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/talos-systems/talos/internal/pkg/provision/providers/qemu"
+)
+
+var qemuDHCPdLaunchCmdFlags struct {
+	ifName    string
+	statePath string
+}
+
+// qemuDHCPdLaunchCmd represents the qemu-dhcpd-launch command
+var qemuDHCPdLaunchCmd = &cobra.Command{
+	Use:    "qemu-dhcpd-launch",
+	Short:  "Internal command used by QEMU provisioner",
+	Long:   ``,
+	Args:   cobra.NoArgs,
+	Hidden: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return qemu.LaunchDHCPd(qemuDHCPdLaunchCmdFlags.ifName, qemuDHCPdLaunchCmdFlags.statePath)
+	},
+}
+
+func init() {
+	qemuDHCPdLaunchCmd.Flags().StringVar(&qemuDHCPdLaunchCmdFlags.ifName, "interface", "", "interface to listen on")
+	qemuDHCPdLaunchCmd.Flags().StringVar(&qemuDHCPdLaunchCmdFlags.statePath, "state-path", "", "path to the cluster state directory")
+	rootCmd.AddCommand(qemuDHCPdLaunchCmd)
+}
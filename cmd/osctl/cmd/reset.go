@@ -7,36 +7,211 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 
+	machineapi "github.com/talos-systems/talos/api/machine"
 	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/cmd/osctl/pkg/helpers"
 )
 
-var (
-	graceful bool
-	reboot   bool
-)
+var resetCmdFlags struct {
+	graceful           bool
+	reboot             bool
+	wipeMode           string
+	partitionWipeModes map[string]string
+	keep               []string
+	verify             bool
+}
 
 // resetCmd represents the reset command
 var resetCmd = &cobra.Command{
 	Use:   "reset",
 	Short: "Reset a node",
-	Long:  ``,
-	Args:  cobra.NoArgs,
+	Long: `Reset a node by wiping the system disk.
+
+The wipe mode is one of:
+
+  fast            remove the partition table only
+  zero            overwrite the partitions with zeroes
+  discard         discard the partition blocks (BLKDISCARD)
+  secure-discard  securely discard the partition blocks (BLKSECDISCARD)
+
+The wipe mode of individual partitions can be overridden with
+--partition-wipe-mode, and partitions can be kept with --keep, e.g.:
+
+  osctl reset --wipe-mode zero --keep STATE --verify`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		req, err := buildResetRequest()
+		if err != nil {
+			return err
+		}
+
 		return WithClient(func(ctx context.Context, c *client.Client) error {
-			if err := c.Reset(ctx, graceful, reboot); err != nil {
+			stream, err := c.ResetProgress(ctx)
+			if err != nil {
+				return fmt.Errorf("error watching reset progress: %s", err)
+			}
+
+			if err = c.ResetGeneric(ctx, req); err != nil {
 				return fmt.Errorf("error executing reset: %s", err)
 			}
 
-			return nil
+			return resetProgress(stream)
 		})
 	},
 }
 
+func parseWipeMode(mode string) (machineapi.WipeMode, error) {
+	value, ok := machineapi.WipeMode_value[strings.ToUpper(strings.ReplaceAll(mode, "-", "_"))]
+	if !ok {
+		return 0, fmt.Errorf("unknown wipe mode %q", mode)
+	}
+
+	return machineapi.WipeMode(value), nil
+}
+
+func buildResetRequest() (*machineapi.ResetRequest, error) {
+	mode, err := parseWipeMode(resetCmdFlags.wipeMode)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &machineapi.ResetRequest{
+		Graceful: resetCmdFlags.graceful,
+		Reboot:   resetCmdFlags.reboot,
+		Mode:     mode,
+		Verify:   resetCmdFlags.verify,
+	}
+
+	for _, label := range resetCmdFlags.keep {
+		if _, ok := resetCmdFlags.partitionWipeModes[label]; ok {
+			return nil, fmt.Errorf("partition %q can't be both kept and wiped", label)
+		}
+
+		req.Partitions = append(req.Partitions, &machineapi.ResetPartitionSpec{
+			Label: label,
+			Keep:  true,
+		})
+	}
+
+	for label, m := range resetCmdFlags.partitionWipeModes {
+		if mode, err = parseWipeMode(m); err != nil {
+			return nil, err
+		}
+
+		if req.Verify && mode == machineapi.WipeMode_FAST {
+			return nil, fmt.Errorf("--verify can't be used with the fast wipe mode of partition %q", label)
+		}
+
+		req.Partitions = append(req.Partitions, &machineapi.ResetPartitionSpec{
+			Label: label,
+			Mode:  mode,
+		})
+	}
+
+	return req, nil
+}
+
+func resetProgress(stream machineapi.MachineService_ResetProgressStreamClient) error {
+	defaultNode := helpers.RemotePeer(stream.Context())
+
+	var failed bool
+
+	// done tracks whether the final message was received from each node,
+	// the reset isn't complete unless every node sent it
+	done := map[string]bool{}
+
+	for {
+		progress, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return fmt.Errorf("error streaming reset progress: %s", err)
+		}
+
+		node := defaultNode
+
+		if progress.Metadata != nil && progress.Metadata.Hostname != "" {
+			node = progress.Metadata.Hostname
+		}
+
+		if progress.Metadata != nil && progress.Metadata.Error != "" {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", node, progress.Metadata.Error)
+
+			failed = true
+
+			continue
+		}
+
+		if _, ok := done[node]; !ok {
+			done[node] = false
+		}
+
+		switch progress.Stage {
+		case machineapi.WipeProgress_KEEP:
+			fmt.Printf("%s: %s: kept\n", node, progress.Label)
+		case machineapi.WipeProgress_WIPE, machineapi.WipeProgress_VERIFY:
+			var percent uint64
+
+			if progress.BytesTotal > 0 {
+				percent = progress.BytesDone * 100 / progress.BytesTotal
+			}
+
+			action := strings.ToLower(progress.Mode.String())
+			if progress.Stage == machineapi.WipeProgress_VERIFY {
+				action = "verify"
+			}
+
+			fmt.Printf("%s: %s: %s %s/%s (%d%%)\n", node, progress.Label, action,
+				humanize.Bytes(progress.BytesDone), humanize.Bytes(progress.BytesTotal), percent)
+		case machineapi.WipeProgress_DONE:
+			done[node] = true
+
+			if progress.Error != "" {
+				fmt.Fprintf(os.Stderr, "%s: reset failed: %s\n", node, progress.Error)
+
+				failed = true
+
+				continue
+			}
+
+			fmt.Printf("%s: system disk reset\n", node)
+		}
+	}
+
+	if len(done) == 0 && !failed {
+		return fmt.Errorf("reset progress stream ended before the reset was done")
+	}
+
+	for node, ok := range done {
+		if !ok {
+			fmt.Fprintf(os.Stderr, "%s: reset progress stream ended before the reset was done\n", node)
+
+			failed = true
+		}
+	}
+
+	if failed {
+		return fmt.Errorf("reset failed")
+	}
+
+	return nil
+}
+
 func init() {
-	resetCmd.Flags().BoolVar(&graceful, "graceful", true, "if true, attempt to cordon/drain node and leave etcd (if applicable)")
-	resetCmd.Flags().BoolVar(&reboot, "reboot", false, "if true, reboot the node after resetting instead of shutting down")
+	resetCmd.Flags().BoolVar(&resetCmdFlags.graceful, "graceful", true, "if true, attempt to cordon/drain node and leave etcd (if applicable)")
+	resetCmd.Flags().BoolVar(&resetCmdFlags.reboot, "reboot", false, "if true, reboot the node after resetting instead of shutting down")
+	resetCmd.Flags().StringVar(&resetCmdFlags.wipeMode, "wipe-mode", "fast", "wipe mode of the system disk partitions (fast, zero, discard, secure-discard)")
+	resetCmd.Flags().StringToStringVar(&resetCmdFlags.partitionWipeModes, "partition-wipe-mode", nil, "wipe mode of individual system disk partitions, e.g. EPHEMERAL=zero")
+	resetCmd.Flags().StringSliceVar(&resetCmdFlags.keep, "keep", nil, "labels of the system disk partitions to keep, e.g. STATE")
+	resetCmd.Flags().BoolVar(&resetCmdFlags.verify, "verify", false, "if true, verify that the wiped partitions contain only zeroes (partitions wiped with the discard modes are zero filled first)")
 	rootCmd.AddCommand(resetCmd)
 }
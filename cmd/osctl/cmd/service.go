@@ -7,11 +7,16 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
+	"math"
 	"os"
+	"strings"
 	"text/tabwriter"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
@@ -21,6 +26,11 @@ import (
 	"github.com/talos-systems/talos/cmd/osctl/pkg/helpers"
 )
 
+var (
+	serviceGraphFormat string
+	serviceEventsLimit int32
+)
+
 // serviceCmd represents the service command
 var serviceCmd = &cobra.Command{
 	Use:     "service [<id> [start|stop|restart|status]]",
@@ -28,7 +38,9 @@ var serviceCmd = &cobra.Command{
 	Short:   "Retrieve the state of a service (or all services), control service state",
 	Long: `Service control command. If run without arguments, lists all the services and their state.
 If service ID is specified, default action 'status' is executed which shows status of a single list service.
-With actions 'start', 'stop', 'restart', service state is updated respectively.`,
+With actions 'start', 'stop', 'restart', service state is updated respectively.
+With --graph flag, dependencies of the services and conditions they are waiting for are printed
+as a table or as a graph in DOT format (--graph=dot).`,
 	Args: cobra.MaximumNArgs(2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		action := "status"
@@ -42,6 +54,16 @@ With actions 'start', 'stop', 'restart', service state is updated respectively.`
 			action = args[1]
 		}
 
+		if serviceGraphFormat != "" {
+			if serviceID != "" {
+				return fmt.Errorf("--graph can't be used with service ID")
+			}
+
+			return WithClient(func(ctx context.Context, c *client.Client) error {
+				return serviceGraph(ctx, c, serviceGraphFormat)
+			})
+		}
+
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			switch action {
 			case "status":
@@ -97,6 +119,75 @@ func serviceList(ctx context.Context, c *client.Client) error {
 	return w.Flush()
 }
 
+func serviceGraph(ctx context.Context, c *client.Client, format string) error {
+	var remotePeer peer.Peer
+
+	resp, err := c.ServiceDependencies(ctx, grpc.Peer(&remotePeer))
+	if err != nil {
+		if resp == nil {
+			return fmt.Errorf("error listing service dependencies: %w", err)
+		}
+
+		helpers.Warning("%s", err)
+	}
+
+	defaultNode := helpers.AddrFromPeer(&remotePeer)
+
+	switch format {
+	case "text":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "NODE\tSERVICE\tSTATE\tDEPENDS ON\tWAITING FOR")
+
+		for _, msg := range resp.Messages {
+			node := defaultNode
+
+			if msg.Metadata != nil {
+				node = msg.Metadata.Hostname
+			}
+
+			for _, svc := range msg.Services {
+				dependsOn := strings.Join(svc.DependsOn, ",")
+				if dependsOn == "" {
+					dependsOn = "<none>"
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", node, svc.Id, svc.State, dependsOn, svc.Condition)
+			}
+		}
+
+		return w.Flush()
+	case "dot":
+		for _, msg := range resp.Messages {
+			node := defaultNode
+
+			if msg.Metadata != nil {
+				node = msg.Metadata.Hostname
+			}
+
+			fmt.Printf("digraph %q {\n", node)
+
+			for _, svc := range msg.Services {
+				label := fmt.Sprintf("%s\n%s", svc.Id, svc.State)
+				if svc.Condition != "" {
+					label += fmt.Sprintf("\nwaiting for %s", svc.Condition)
+				}
+
+				fmt.Printf("  %q [label=%q];\n", svc.Id, label)
+
+				for _, dependency := range svc.DependsOn {
+					fmt.Printf("  %q -> %q;\n", svc.Id, dependency)
+				}
+			}
+
+			fmt.Println("}")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported graph format: %q", format)
+	}
+}
+
 func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 	var remotePeer peer.Peer
 
@@ -113,6 +204,25 @@ func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 
 	defaultNode := helpers.AddrFromPeer(&remotePeer)
 
+	// persisted event history, nodes running older versions don't support it
+	eventLogs := map[string]*machineapi.ServiceEventLog{}
+
+	// nolint: errcheck
+	eventsResp, _ := c.ServiceEvents(ctx, &machineapi.ServiceEventsRequest{Id: id, Limit: serviceEventsLimit})
+	if eventsResp != nil {
+		for _, msg := range eventsResp.Messages {
+			node := defaultNode
+
+			if msg.Metadata != nil {
+				node = msg.Metadata.Hostname
+			}
+
+			for _, eventLog := range msg.Services {
+				eventLogs[node] = eventLog
+			}
+		}
+	}
+
 	for _, s := range services {
 		node := defaultNode
 
@@ -131,10 +241,24 @@ func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 			fmt.Fprintf(w, "LAST HEALTH MESSAGE\t%s\n", svc.Health.LastMessage)
 		}
 
+		if svc.Resources != nil {
+			fmt.Fprintf(w, "CGROUP\t%s\n", svc.Resources.Cgroup)
+			fmt.Fprintf(w, "MEMORY\t%s\n", svc.MemoryUsage())
+			fmt.Fprintf(w, "CPU TIME\t%s\n", time.Duration(svc.Resources.CpuUsage).Round(time.Millisecond))
+		}
+
+		serviceEvents := svc.Events.Events
+
+		if eventLog, ok := eventLogs[node]; ok {
+			serviceEvents = eventLog.Events.Events
+
+			printServiceTimings(w, eventLog.Timings)
+		}
+
 		label := "EVENTS"
 
-		for i := range svc.Events.Events {
-			event := svc.Events.Events[len(svc.Events.Events)-1-i]
+		for i := range serviceEvents {
+			event := serviceEvents[len(serviceEvents)-1-i]
 
 			// nolint: errcheck
 			ts, _ := ptypes.Timestamp(event.Ts)
@@ -150,6 +274,29 @@ func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 	return w.Flush()
 }
 
+func printServiceTimings(w io.Writer, timings *machineapi.ServiceTimings) {
+	if timings == nil {
+		return
+	}
+
+	for _, timing := range []struct {
+		label    string
+		duration *duration.Duration
+	}{
+		{"TIME WAITING", timings.Waiting},
+		{"TIME TO RUNNING", timings.ToRunning},
+		{"TIME TO HEALTHY", timings.ToHealthy},
+	} {
+		if timing.duration == nil {
+			continue
+		}
+
+		// nolint: errcheck
+		d, _ := ptypes.Duration(timing.duration)
+		fmt.Fprintf(w, "%s\t%s\n", timing.label, d.Round(time.Millisecond))
+	}
+}
+
 func serviceStart(ctx context.Context, c *client.Client, id string) error {
 	var remotePeer peer.Peer
 
@@ -275,6 +422,19 @@ func (svc serviceInfoWrapper) HealthStatus() string {
 	return "Fail"
 }
 
+func (svc serviceInfoWrapper) MemoryUsage() string {
+	// cgroup without memory limit reports limit close to max int64
+	if svc.Resources.MemoryLimit == 0 || svc.Resources.MemoryLimit >= math.MaxInt64/2 {
+		return humanize.Bytes(svc.Resources.MemoryUsage)
+	}
+
+	return fmt.Sprintf("%s / %s", humanize.Bytes(svc.Resources.MemoryUsage), humanize.Bytes(svc.Resources.MemoryLimit))
+}
+
 func init() {
+	serviceCmd.Flags().StringVar(&serviceGraphFormat, "graph", "", "print service dependency graph, format: text or dot")
+	serviceCmd.Flags().Lookup("graph").NoOptDefVal = "text"
+	serviceCmd.Flags().Int32Var(&serviceEventsLimit, "events", 50, "number of most recent events to show in service status")
+
 	rootCmd.AddCommand(serviceCmd)
 }
@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/internal/pkg/support"
+)
+
+var supportOutput string
+
+// supportCmd represents the support command
+var supportCmd = &cobra.Command{
+	Use:   "support",
+	Short: "Collect a support bundle from the nodes",
+	Long: `Collect a support bundle from the nodes.
+
+Support bundle is a tar.gz archive with a directory per node containing service logs,
+kernel log, service state with the event history, mounts, processes, network interfaces
+and routes, and the machine configuration with the secrets redacted. Kubernetes node and pod
+status is collected via the first node. Archive manifest lists collected files and the errors.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			targetNodes := md.Get("nodes")
+
+			if len(targetNodes) == 0 {
+				return fmt.Errorf("no nodes specified, please use --nodes or set nodes in the client configuration")
+			}
+
+			out, err := os.Create(supportOutput)
+			if err != nil {
+				return err
+			}
+
+			defer out.Close() //nolint: errcheck
+
+			bundle := support.NewBundle(out, "osctl support")
+
+			for _, node := range targetNodes {
+				fmt.Fprintf(os.Stderr, "collecting %s\n", node)
+
+				if err = support.CollectNode(ctx, bundle, c, node); err != nil {
+					return err
+				}
+			}
+
+			fmt.Fprintf(os.Stderr, "collecting kubernetes\n")
+
+			if err = support.CollectKubernetesFromNode(ctx, bundle, c, targetNodes[0]); err != nil {
+				return err
+			}
+
+			if err = bundle.Close(); err != nil {
+				return err
+			}
+
+			manifest := bundle.Manifest()
+
+			for _, e := range manifest.Errors {
+				fmt.Fprintf(os.Stderr, "error collecting %s: %s\n", e.Path, e.Error)
+			}
+
+			fmt.Fprintf(os.Stderr, "support bundle written to %s: %d files, %d errors\n", supportOutput, len(manifest.Files), len(manifest.Errors))
+
+			return out.Close()
+		})
+	},
+}
+
+func init() {
+	supportCmd.Flags().StringVarP(&supportOutput, "output", "o", "support.tar.gz", "path to write the support bundle to")
+	rootCmd.AddCommand(supportCmd)
+}
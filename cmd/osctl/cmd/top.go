@@ -0,0 +1,262 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	criconstants "github.com/containerd/cri/pkg/constants"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/api/common"
+	osapi "github.com/talos-systems/talos/api/os"
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/cmd/osctl/pkg/helpers"
+	"github.com/talos-systems/talos/pkg/constants"
+)
+
+var topCmdFlags struct {
+	interval time.Duration
+	sort     string
+}
+
+// topCmd represents the top command
+var topCmd = &cobra.Command{
+	Use:   "top",
+	Short: "Display the resource usage of the containers",
+	Long: `Display the resource usage of the containers, refreshed at the interval.
+
+CPU usage is in percents of a single core. IO and network usage is in bytes per
+second. IO usage is only reported with the containerd driver. Network usage is
+reported once per pod (for the pod sandbox with CRI driver), and isn't reported
+for the containers in the host network namespace.
+
+Rows could be sorted by one of: cpu, memory, io, net, id.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, ok := topSorters[topCmdFlags.sort]; !ok {
+			return fmt.Errorf("unknown sort column %q", topCmdFlags.sort)
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			namespace := constants.SystemContainerdNamespace
+			if kubernetes {
+				namespace = criconstants.K8sContainerdNamespace
+			}
+
+			driver := common.ContainerDriver_CONTAINERD
+			if useCRI {
+				driver = common.ContainerDriver_CRI
+			}
+
+			stream, err := c.StatsWatch(ctx, namespace, driver, topCmdFlags.interval)
+			if err != nil {
+				return fmt.Errorf("error watching stats: %s", err)
+			}
+
+			return topWatch(stream)
+		})
+	},
+}
+
+// topRow is the resource usage of a container over the last interval.
+type topRow struct {
+	node      string
+	namespace string
+	id        string
+
+	cpu     float64
+	memory  uint64
+	ioRead  float64
+	ioWrite float64
+	netRx   float64
+	netTx   float64
+}
+
+var topSorters = map[string]func(a, b *topRow) bool{
+	"cpu":    func(a, b *topRow) bool { return a.cpu > b.cpu },
+	"memory": func(a, b *topRow) bool { return a.memory > b.memory },
+	"io":     func(a, b *topRow) bool { return a.ioRead+a.ioWrite > b.ioRead+b.ioWrite },
+	"net":    func(a, b *topRow) bool { return a.netRx+a.netTx > b.netRx+b.netTx },
+	"id":     func(a, b *topRow) bool { return a.id < b.id },
+}
+
+// topSample is the previous sample of a container used to calculate the rates.
+type topSample struct {
+	timestamp time.Time
+	stat      *osapi.Stat
+}
+
+// topState keeps the last samples and the calculated rows per node.
+type topState struct {
+	samples map[string]topSample
+	rows    map[string][]*topRow
+	errors  map[string]string
+}
+
+func newTopState() *topState {
+	return &topState{
+		samples: map[string]topSample{},
+		rows:    map[string][]*topRow{},
+		errors:  map[string]string{},
+	}
+}
+
+// Update calculates the rows of the node from the stats sample.
+func (s *topState) Update(node string, stats *osapi.Stats) {
+	timestamp, err := ptypes.Timestamp(stats.Timestamp)
+	if err != nil {
+		timestamp = time.Now()
+	}
+
+	rows := make([]*topRow, 0, len(stats.Stats))
+
+	for _, stat := range stats.Stats {
+		key := strings.Join([]string{node, stat.Namespace, stat.Id}, "/")
+
+		row := &topRow{
+			node:      node,
+			namespace: stat.Namespace,
+			id:        stat.Id,
+			memory:    stat.MemoryUsage,
+		}
+
+		if prev, ok := s.samples[key]; ok {
+			if elapsed := timestamp.Sub(prev.timestamp).Seconds(); elapsed > 0 {
+				row.cpu = rate(prev.stat.CpuUsage, stat.CpuUsage, elapsed) / float64(time.Second) * 100
+				row.ioRead = rate(prev.stat.IoReadBytes, stat.IoReadBytes, elapsed)
+				row.ioWrite = rate(prev.stat.IoWriteBytes, stat.IoWriteBytes, elapsed)
+				row.netRx = rate(prev.stat.NetworkRxBytes, stat.NetworkRxBytes, elapsed)
+				row.netTx = rate(prev.stat.NetworkTxBytes, stat.NetworkTxBytes, elapsed)
+			}
+		}
+
+		s.samples[key] = topSample{
+			timestamp: timestamp,
+			stat:      stat,
+		}
+
+		rows = append(rows, row)
+	}
+
+	s.rows[node] = rows
+	delete(s.errors, node)
+}
+
+// Render prints the rows of all the nodes sorted by the column.
+func (s *topState) Render(out io.Writer, less func(a, b *topRow) bool) error {
+	var rows []*topRow
+
+	for _, nodeRows := range s.rows {
+		rows = append(rows, nodeRows...)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if less(rows[i], rows[j]) {
+			return true
+		}
+
+		if less(rows[j], rows[i]) {
+			return false
+		}
+
+		return rows[i].node+rows[i].id < rows[j].node+rows[j].id
+	})
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tNAMESPACE\tID\tCPU(%)\tMEMORY\tIO READ/s\tIO WRITE/s\tNET RX/s\tNET TX/s")
+
+	for _, row := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%.1f\t%s\t%s\t%s\t%s\t%s\n", row.node, row.namespace, row.id, row.cpu,
+			humanize.Bytes(row.memory),
+			humanize.Bytes(uint64(row.ioRead)), humanize.Bytes(uint64(row.ioWrite)),
+			humanize.Bytes(uint64(row.netRx)), humanize.Bytes(uint64(row.netTx)))
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	nodes := make([]string, 0, len(s.errors))
+	for node := range s.errors {
+		nodes = append(nodes, node)
+	}
+
+	sort.Strings(nodes)
+
+	for _, node := range nodes {
+		fmt.Fprintf(out, "%s: %s\n", node, s.errors[node])
+	}
+
+	return nil
+}
+
+// rate returns the per second rate of the cumulative counter.
+//
+// Counter going backwards means the container was restarted.
+func rate(prev, cur uint64, elapsed float64) float64 {
+	if cur < prev {
+		return 0
+	}
+
+	return float64(cur-prev) / elapsed
+}
+
+func topWatch(stream osapi.OSService_StatsWatchClient) error {
+	defaultNode := helpers.RemotePeer(stream.Context())
+	state := newTopState()
+	less := topSorters[topCmdFlags.sort]
+
+	for {
+		stats, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF || status.Code(err) == codes.Canceled {
+				return nil
+			}
+
+			return fmt.Errorf("error streaming stats: %s", err)
+		}
+
+		node := defaultNode
+
+		if stats.Metadata != nil && stats.Metadata.Hostname != "" {
+			node = stats.Metadata.Hostname
+		}
+
+		if stats.Metadata != nil && stats.Metadata.Error != "" {
+			state.errors[node] = stats.Metadata.Error
+
+			delete(state.rows, node)
+		} else {
+			state.Update(node, stats)
+		}
+
+		// clear the screen and move the cursor to the top left corner
+		fmt.Print("\033[H\033[2J")
+
+		if err = state.Render(os.Stdout, less); err != nil {
+			return err
+		}
+	}
+}
+
+func init() {
+	topCmd.Flags().BoolVarP(&kubernetes, "kubernetes", "k", false, "use the k8s.io containerd namespace")
+	topCmd.Flags().BoolVarP(&useCRI, "use-cri", "c", false, "use the CRI driver")
+	topCmd.Flags().DurationVarP(&topCmdFlags.interval, "interval", "i", 2*time.Second, "interval between the samples")
+	topCmd.Flags().StringVarP(&topCmdFlags.sort, "sort", "s", "cpu", "sort column, one of [cpu,memory,io,net,id]")
+	rootCmd.AddCommand(topCmd)
+}
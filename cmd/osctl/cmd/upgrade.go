@@ -7,14 +7,19 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
+	machineapi "github.com/talos-systems/talos/api/machine"
 	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
 	"github.com/talos-systems/talos/cmd/osctl/pkg/helpers"
 )
@@ -37,13 +42,39 @@ func init() {
 	rootCmd.AddCommand(upgradeCmd)
 }
 
+// upgradeProgressTimeout is the time to wait for the image pull progress
+// stream to finish once the upgrade request returns.
+const upgradeProgressTimeout = 5 * time.Second
+
 func upgrade() error {
 	return WithClient(func(ctx context.Context, c *client.Client) error {
 		var remotePeer peer.Peer
 
+		progressErr := make(chan error, 1)
+
+		stream, err := c.UpgradeProgress(ctx)
+		if err != nil {
+			progressErr <- err
+		} else {
+			go func() {
+				progressErr <- upgradeProgress(stream)
+			}()
+		}
+
 		// TODO: See if we can validate version and prevent starting upgrades to
 		// an unknown version
 		resp, err := c.Upgrade(ctx, upgradeImage, grpc.Peer(&remotePeer))
+
+		// installer image is pulled before the upgrade request returns, so the progress
+		// stream should be finished shortly
+		select {
+		case e := <-progressErr:
+			if e != nil {
+				helpers.Warning("error streaming image pull progress: %s", e)
+			}
+		case <-time.After(upgradeProgressTimeout):
+		}
+
 		if err != nil {
 			if resp == nil {
 				return fmt.Errorf("error performing upgrade: %s", err)
@@ -70,3 +101,46 @@ func upgrade() error {
 		return w.Flush()
 	})
 }
+
+func upgradeProgress(stream machineapi.MachineService_UpgradeProgressStreamClient) error {
+	defaultNode := helpers.RemotePeer(stream.Context())
+
+	for {
+		progress, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF || status.Code(err) == codes.Canceled {
+				return nil
+			}
+
+			return err
+		}
+
+		node := defaultNode
+
+		if progress.Metadata != nil && progress.Metadata.Hostname != "" {
+			node = progress.Metadata.Hostname
+		}
+
+		if progress.Metadata != nil && progress.Metadata.Error != "" {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", node, progress.Metadata.Error)
+
+			continue
+		}
+
+		switch {
+		case progress.Done && progress.Error != "":
+			fmt.Fprintf(os.Stderr, "%s: failed to pull %s: %s\n", node, progress.Image, progress.Error)
+		case progress.Done:
+			fmt.Printf("%s: pulled %s (%s)\n", node, progress.Image, humanize.Bytes(progress.BytesTotal))
+		default:
+			var percent uint64
+
+			if progress.BytesTotal > 0 {
+				percent = progress.BytesDone * 100 / progress.BytesTotal
+			}
+
+			fmt.Printf("%s: pulling %s %s/%s (%d%%)\n", node, progress.Image,
+				humanize.Bytes(progress.BytesDone), humanize.Bytes(progress.BytesTotal), percent)
+		}
+	}
+}
@@ -14,7 +14,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	stdlibnet "net"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -22,6 +25,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/talos-systems/talos/api/common"
+	imageapi "github.com/talos-systems/talos/api/image"
 	machineapi "github.com/talos-systems/talos/api/machine"
 	networkapi "github.com/talos-systems/talos/api/network"
 	osapi "github.com/talos-systems/talos/api/os"
@@ -45,6 +49,7 @@ type Client struct {
 	MachineClient machineapi.MachineServiceClient
 	TimeClient    timeapi.TimeServiceClient
 	NetworkClient networkapi.NetworkServiceClient
+	ImageClient   imageapi.ImageServiceClient
 }
 
 // NewClientContextAndCredentialsFromConfig initializes Credentials from config file.
@@ -106,12 +111,19 @@ func NewClientContextAndCredentialsFromParsedConfig(c *config.Config, ctx string
 func NewClient(cfg *tls.Config, endpoints []string, port int, opts ...grpc.DialOption) (c *Client, err error) {
 	opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(cfg)))
 
+	// TODO(smira): endpoints[0] should be replaced with proper load-balancing
+	target := fmt.Sprintf("%s:%d", net.FormatAddress(endpoints[0]), port)
 	cfg.ServerName = endpoints[0]
 
+	// endpoint might come with the port already set
+	if host, _, splitErr := stdlibnet.SplitHostPort(endpoints[0]); splitErr == nil {
+		target = endpoints[0]
+		cfg.ServerName = host
+	}
+
 	c = &Client{}
 
-	// TODO(smira): endpoints[0] should be replaced with proper load-balancing
-	c.conn, err = grpc.DialContext(context.Background(), fmt.Sprintf("%s:%d", net.FormatAddress(endpoints[0]), port), opts...)
+	c.conn, err = grpc.DialContext(context.Background(), target, opts...)
 	if err != nil {
 		return
 	}
@@ -120,6 +132,7 @@ func NewClient(cfg *tls.Config, endpoints []string, port int, opts ...grpc.DialO
 	c.MachineClient = machineapi.NewMachineServiceClient(c.conn)
 	c.TimeClient = timeapi.NewTimeServiceClient(c.conn)
 	c.NetworkClient = networkapi.NewNetworkServiceClient(c.conn)
+	c.ImageClient = imageapi.NewImageServiceClient(c.conn)
 
 	return c, nil
 }
@@ -210,6 +223,17 @@ func (c *Client) Stats(ctx context.Context, namespace string, driver common.Cont
 	return
 }
 
+// StatsWatch implements the proto.OSClient interface.
+//
+// Stats are streamed at the interval until the context is canceled.
+func (c *Client) StatsWatch(ctx context.Context, namespace string, driver common.ContainerDriver, interval time.Duration) (osapi.OSService_StatsWatchClient, error) {
+	return c.client.StatsWatch(ctx, &osapi.StatsWatchRequest{
+		Namespace: namespace,
+		Driver:    driver,
+		Interval:  ptypes.DurationProto(interval),
+	})
+}
+
 // Containers implements the proto.OSClient interface.
 func (c *Client) Containers(ctx context.Context, namespace string, driver common.ContainerDriver, callOptions ...grpc.CallOption) (resp *osapi.ContainersResponse, err error) {
 	resp, err = c.client.Containers(
@@ -241,10 +265,25 @@ func (c *Client) Restart(ctx context.Context, namespace string, driver common.Co
 
 // Reset implements the proto.OSClient interface.
 func (c *Client) Reset(ctx context.Context, graceful, reboot bool) (err error) {
-	_, err = c.MachineClient.Reset(ctx, &machineapi.ResetRequest{Graceful: graceful, Reboot: reboot})
+	return c.ResetGeneric(ctx, &machineapi.ResetRequest{Graceful: graceful, Reboot: reboot})
+}
+
+// ResetGeneric resets the node using all the options of the reset request.
+func (c *Client) ResetGeneric(ctx context.Context, req *machineapi.ResetRequest) (err error) {
+	_, err = c.MachineClient.Reset(ctx, req)
 	return
 }
 
+// ResetProgress implements the proto.OSClient interface.
+func (c *Client) ResetProgress(ctx context.Context) (stream machineapi.MachineService_ResetProgressStreamClient, err error) {
+	return c.MachineClient.ResetProgressStream(ctx, &empty.Empty{})
+}
+
+// UpgradeProgress implements the proto.OSClient interface.
+func (c *Client) UpgradeProgress(ctx context.Context) (stream machineapi.MachineService_UpgradeProgressStreamClient, err error) {
+	return c.MachineClient.UpgradeProgressStream(ctx, &empty.Empty{})
+}
+
 // Reboot implements the proto.OSClient interface.
 func (c *Client) Reboot(ctx context.Context) (err error) {
 	_, err = c.MachineClient.Reboot(ctx, &empty.Empty{})
@@ -265,6 +304,23 @@ func (c *Client) Dmesg(ctx context.Context, follow, tail bool) (osapi.OSService_
 	})
 }
 
+// Exec starts the process in the container.
+//
+// Returned stream should be used to send stdin and terminal resize events,
+// and to receive the process output and exit code.
+func (c *Client) Exec(ctx context.Context, start *osapi.ExecStart, callOptions ...grpc.CallOption) (stream osapi.OSService_ExecClient, err error) {
+	stream, err = c.client.Exec(ctx, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = stream.Send(&osapi.ExecRequest{Start: start}); err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
 // Logs implements the proto.OSClient interface.
 func (c *Client) Logs(ctx context.Context, namespace string, driver common.ContainerDriver, id string, follow bool, tailLines int32) (stream machineapi.MachineService_LogsClient, err error) {
 	stream, err = c.MachineClient.Logs(ctx, &machineapi.LogsRequest{
@@ -323,6 +379,103 @@ func (c *Client) Interfaces(ctx context.Context, callOptions ...grpc.CallOption)
 	return
 }
 
+// ImageList lists images in the containerd namespace.
+func (c *Client) ImageList(ctx context.Context, namespace string, callOptions ...grpc.CallOption) (resp *imageapi.ImageListResponse, err error) {
+	resp, err = c.ImageClient.List(
+		ctx,
+		&imageapi.ImageListRequest{
+			Namespace: namespace,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*imageapi.ImageListResponse) //nolint: errcheck
+
+	return
+}
+
+// ImagePull pulls the image into the containerd namespace.
+func (c *Client) ImagePull(ctx context.Context, namespace, reference string, callOptions ...grpc.CallOption) (resp *imageapi.ImagePullResponse, err error) {
+	resp, err = c.ImageClient.Pull(
+		ctx,
+		&imageapi.ImagePullRequest{
+			Namespace: namespace,
+			Reference: reference,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*imageapi.ImagePullResponse) //nolint: errcheck
+
+	return
+}
+
+// ImageRemove removes the image from the containerd namespace.
+func (c *Client) ImageRemove(ctx context.Context, namespace, reference string, callOptions ...grpc.CallOption) (resp *imageapi.ImageRemoveResponse, err error) {
+	resp, err = c.ImageClient.Remove(
+		ctx,
+		&imageapi.ImageRemoveRequest{
+			Namespace: namespace,
+			Reference: reference,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*imageapi.ImageRemoveResponse) //nolint: errcheck
+
+	return
+}
+
+// ImageImport imports images from the tarball (OCI image layout or docker save format)
+// into the containerd namespace.
+func (c *Client) ImageImport(ctx context.Context, namespace string, r io.Reader, callOptions ...grpc.CallOption) (resp *imageapi.ImageImportResponse, err error) {
+	stream, err := c.ImageClient.Import(ctx, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = stream.Send(&imageapi.ImageImportRequest{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 64*1024)
+
+	for {
+		n, readErr := r.Read(buf)
+		if n > 0 {
+			if err = stream.Send(&imageapi.ImageImportRequest{Data: buf[:n]}); err != nil {
+				break
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+
+		if readErr != nil {
+			return nil, readErr
+		}
+	}
+
+	// on send error, actual error is returned by CloseAndRecv
+	if resp, err = stream.CloseAndRecv(); err != nil {
+		return nil, err
+	}
+
+	// proxied errors are delivered in the response metadata, as Import is a streamed method
+	if resp.Metadata != nil && resp.Metadata.Error != "" {
+		return nil, errors.New(resp.Metadata.Error)
+	}
+
+	return resp, nil
+}
+
 // Processes implements the proto.OSClient interface.
 func (c *Client) Processes(ctx context.Context, callOptions ...grpc.CallOption) (resp *osapi.ProcessesResponse, err error) {
 	resp, err = c.client.Processes(
@@ -353,6 +506,23 @@ func (c *Client) Memory(ctx context.Context, callOptions ...grpc.CallOption) (re
 	return
 }
 
+// GrowDisk grows the last partition of an extra disk and its filesystem.
+func (c *Client) GrowDisk(ctx context.Context, device string, callOptions ...grpc.CallOption) (resp *machineapi.GrowDiskResponse, err error) {
+	resp, err = c.MachineClient.GrowDisk(
+		ctx,
+		&machineapi.GrowDiskRequest{
+			Device: device,
+		},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.GrowDiskResponse) //nolint: errcheck
+
+	return
+}
+
 // Mounts implements the proto.OSClient interface.
 func (c *Client) Mounts(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.MountsResponse, err error) {
 	resp, err = c.MachineClient.Mounts(
@@ -416,6 +586,36 @@ func (c *Client) ServiceList(ctx context.Context, callOptions ...grpc.CallOption
 	return
 }
 
+// ServiceDependencies returns dependency graph of the services
+func (c *Client) ServiceDependencies(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.ServiceDependenciesResponse, err error) {
+	resp, err = c.MachineClient.ServiceDependencies(
+		ctx,
+		&empty.Empty{},
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ServiceDependenciesResponse) //nolint: errcheck
+
+	return
+}
+
+// ServiceEvents returns history of the service events
+func (c *Client) ServiceEvents(ctx context.Context, req *machineapi.ServiceEventsRequest, callOptions ...grpc.CallOption) (resp *machineapi.ServiceEventsResponse, err error) {
+	resp, err = c.MachineClient.ServiceEvents(
+		ctx,
+		req,
+		callOptions...,
+	)
+
+	var filtered interface{}
+	filtered, err = FilterMessages(resp, err)
+	resp, _ = filtered.(*machineapi.ServiceEventsResponse) //nolint: errcheck
+
+	return
+}
+
 // ServiceInfo provides info about a service and node metadata
 type ServiceInfo struct {
 	Metadata *common.Metadata
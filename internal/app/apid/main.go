@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	"github.com/talos-systems/talos/internal/app/apid/pkg/authz"
 	apidbackend "github.com/talos-systems/talos/internal/app/apid/pkg/backend"
 	"github.com/talos-systems/talos/internal/app/apid/pkg/director"
 	"github.com/talos-systems/talos/internal/app/apid/pkg/provider"
@@ -71,12 +72,15 @@ func main() {
 
 	// all existing streaming methods
 	for _, methodName := range []string{
+		"/image.ImageService/Import",
 		"/machine.MachineService/Copy",
 		"/machine.MachineService/Kubeconfig",
 		"/machine.MachineService/List",
 		"/machine.MachineService/Logs",
 		"/machine.MachineService/Read",
 		"/os.OSService/Dmesg",
+		"/os.OSService/Exec",
+		"/os.OSService/StatsWatch",
 	} {
 		router.RegisterStreamedRegex("^" + regexp.QuoteMeta(methodName) + "$")
 	}
@@ -84,10 +88,14 @@ func main() {
 	// register future pattern: method should have suffix "Stream"
 	router.RegisterStreamedRegex("Stream$")
 
+	authorizer := authz.NewAuthorizer(serverTLSConfig.ClientCAs)
+	authorizer.Require("/os.OSService/Exec", constants.AdminRole)
+
 	err = factory.ListenAndServe(
 		router,
 		factory.Port(constants.ApidPort),
 		factory.WithDefaultLog(),
+		factory.WithStreamInterceptor(authorizer.StreamInterceptor()),
 		factory.ServerOptions(
 			grpc.Creds(
 				credentials.NewTLS(serverTLSConfig),
@@ -0,0 +1,183 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package authz provides role-based authorization of API calls.
+//
+// Roles are carried as the subject organization of the client certificate.
+package authz
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/pkg/constants"
+)
+
+// ForwardedCertificateKey is the metadata key which carries the client certificate
+// of the original caller when the request is proxied to another apid instance.
+const ForwardedCertificateKey = "proxycertificate-bin"
+
+// Authorizer checks that the client is allowed to call the method.
+//
+// Methods which are not registered via Require are allowed for any client
+// which passed the TLS authentication.
+type Authorizer struct {
+	rules map[string][]string
+	roots *x509.CertPool
+}
+
+// NewAuthorizer builds new Authorizer.
+//
+// Roots are used to verify the certificates of the original callers forwarded
+// by the proxying apid instances. If roots are nil, proxied requests to the
+// restricted methods are denied.
+func NewAuthorizer(roots *x509.CertPool) *Authorizer {
+	return &Authorizer{
+		rules: map[string][]string{},
+		roots: roots,
+	}
+}
+
+// Require registers the roles allowed to call the method.
+//
+// Method is the full gRPC method name, e.g. /os.OSService/Exec.
+func (a *Authorizer) Require(method string, roles ...string) {
+	a.rules[method] = append(a.rules[method], roles...)
+}
+
+// Authorize checks that the peer has one of the roles required for the method.
+//
+// Requests proxied by another apid instance are authorized with the roles of
+// the original caller: the peer should present the node certificate, and the
+// certificate of the original caller forwarded by the proxy should be signed
+// by the roots.
+func (a *Authorizer) Authorize(ctx context.Context, method string) error {
+	required, ok := a.rules[method]
+	if !ok {
+		return nil
+	}
+
+	roles := peerRoles(ctx)
+
+	if hasRole(roles, required...) {
+		return nil
+	}
+
+	if hasRole(roles, constants.NodeRole) {
+		forwarded, err := a.forwardedRoles(ctx)
+		if err != nil {
+			return status.Errorf(codes.PermissionDenied, "method %s requires one of roles %v: %s", method, required, err)
+		}
+
+		if hasRole(forwarded, required...) {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "method %s requires one of roles %v", method, required)
+}
+
+// StreamInterceptor returns grpc.StreamServerInterceptor which authorizes requests.
+//
+// As apid handles all the requests with the proxy handler, stream interceptor
+// is invoked both for unary and streaming methods.
+func (a *Authorizer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.Authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// ForwardCertificate puts the client certificate of the caller into the
+// metadata of the request proxied to another apid instance.
+//
+// Certificate supplied by the caller in the metadata is always dropped.
+func ForwardCertificate(ctx context.Context, md metadata.MD) {
+	delete(md, ForwardedCertificateKey)
+
+	if crt := peerCertificate(ctx); crt != nil {
+		md.Set(ForwardedCertificateKey, string(crt.Raw))
+	}
+}
+
+// forwardedRoles verifies the certificate forwarded by the proxy and returns its roles.
+func (a *Authorizer) forwardedRoles(ctx context.Context) ([]string, error) {
+	if a.roots == nil {
+		return nil, errors.New("proxied requests are not allowed")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errors.New("no forwarded certificate")
+	}
+
+	values := md[ForwardedCertificateKey]
+	if len(values) != 1 {
+		return nil, errors.New("no forwarded certificate")
+	}
+
+	crt, err := x509.ParseCertificate([]byte(values[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = crt.Verify(x509.VerifyOptions{
+		Roots:     a.roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return nil, err
+	}
+
+	return crt.Subject.Organization, nil
+}
+
+func peerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+
+	if len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil
+	}
+
+	return tlsInfo.State.PeerCertificates[0]
+}
+
+func peerRoles(ctx context.Context) []string {
+	crt := peerCertificate(ctx)
+	if crt == nil {
+		return nil
+	}
+
+	return crt.Subject.Organization
+}
+
+func hasRole(roles []string, required ...string) bool {
+	for _, role := range roles {
+		for _, r := range required {
+			if role == r {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,145 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package authz_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/talos-systems/talos/internal/app/apid/pkg/authz"
+	"github.com/talos-systems/talos/pkg/constants"
+	talosx509 "github.com/talos-systems/talos/pkg/crypto/x509"
+)
+
+type AuthzSuite struct {
+	suite.Suite
+
+	ca         *talosx509.CertificateAuthority
+	authorizer *authz.Authorizer
+}
+
+func (suite *AuthzSuite) SetupSuite() {
+	var err error
+
+	suite.ca, err = talosx509.NewSelfSignedCertificateAuthority()
+	suite.Require().NoError(err)
+}
+
+func (suite *AuthzSuite) SetupTest() {
+	roots := x509.NewCertPool()
+	roots.AddCert(suite.ca.Crt)
+
+	suite.authorizer = authz.NewAuthorizer(roots)
+	suite.authorizer.Require("/os.OSService/Exec", constants.AdminRole)
+}
+
+func (suite *AuthzSuite) certificate(ca *talosx509.CertificateAuthority, organization string) *x509.Certificate {
+	key, err := talosx509.NewEd25519Key()
+	suite.Require().NoError(err)
+
+	csr, err := talosx509.NewCertificateSigningRequest(key.PrivateKey, talosx509.Organization(organization))
+	suite.Require().NoError(err)
+
+	crt, err := talosx509.NewCertificateFromCSRBytes(ca.CrtPEM, ca.KeyPEM, csr.X509CertificateRequestPEM)
+	suite.Require().NoError(err)
+
+	return crt.X509Certificate
+}
+
+func peerContext(organization ...string) context.Context {
+	return certificateContext(&x509.Certificate{
+		Subject: pkix.Name{
+			Organization: organization,
+		},
+	})
+}
+
+func certificateContext(crt *x509.Certificate) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{crt},
+			},
+		},
+	})
+}
+
+func forwardedContext(ctx context.Context, crt *x509.Certificate) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("proxyfrom", "10.5.0.2", authz.ForwardedCertificateKey, string(crt.Raw)))
+}
+
+func (suite *AuthzSuite) TestUnrestricted() {
+	suite.Assert().NoError(suite.authorizer.Authorize(context.Background(), "/os.OSService/Containers"))
+	suite.Assert().NoError(suite.authorizer.Authorize(peerContext(), "/os.OSService/Containers"))
+}
+
+func (suite *AuthzSuite) TestAdmin() {
+	suite.Assert().NoError(suite.authorizer.Authorize(peerContext(constants.AdminRole), "/os.OSService/Exec"))
+	suite.Assert().NoError(suite.authorizer.Authorize(peerContext("talos", constants.AdminRole), "/os.OSService/Exec"))
+}
+
+func (suite *AuthzSuite) TestDenied() {
+	otherCA, err := talosx509.NewSelfSignedCertificateAuthority()
+	suite.Require().NoError(err)
+
+	admin := suite.certificate(suite.ca, constants.AdminRole)
+
+	for _, ctx := range []context.Context{
+		context.Background(),
+		peerContext(),
+		peerContext("talos"),
+		peerContext(constants.NodeRole),
+		// proxy header is not enough
+		metadata.NewIncomingContext(peerContext(constants.NodeRole), metadata.Pairs("proxyfrom", "10.5.0.2")),
+		// forwarded certificate is accepted only from the node
+		forwardedContext(peerContext("talos"), admin),
+		// forwarded certificate doesn't have the role
+		forwardedContext(peerContext(constants.NodeRole), suite.certificate(suite.ca, constants.NodeRole)),
+		// forwarded certificate is not signed by the CA
+		forwardedContext(peerContext(constants.NodeRole), suite.certificate(otherCA, constants.AdminRole)),
+		// forwarded certificate is garbage
+		metadata.NewIncomingContext(peerContext(constants.NodeRole), metadata.Pairs(authz.ForwardedCertificateKey, "garbage")),
+	} {
+		err = suite.authorizer.Authorize(ctx, "/os.OSService/Exec")
+		suite.Require().Error(err)
+		suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+	}
+}
+
+func (suite *AuthzSuite) TestProxied() {
+	ctx := forwardedContext(peerContext(constants.NodeRole), suite.certificate(suite.ca, constants.AdminRole))
+	suite.Assert().NoError(suite.authorizer.Authorize(ctx, "/os.OSService/Exec"))
+
+	authorizer := authz.NewAuthorizer(nil)
+	authorizer.Require("/os.OSService/Exec", constants.AdminRole)
+	suite.Assert().Error(authorizer.Authorize(ctx, "/os.OSService/Exec"))
+}
+
+func (suite *AuthzSuite) TestForwardCertificate() {
+	admin := suite.certificate(suite.ca, constants.AdminRole)
+	forged := suite.certificate(suite.ca, "forged")
+
+	md := metadata.Pairs(authz.ForwardedCertificateKey, string(forged.Raw))
+	authz.ForwardCertificate(certificateContext(admin), md)
+	suite.Assert().Equal([]string{string(admin.Raw)}, md[authz.ForwardedCertificateKey])
+
+	md = metadata.Pairs(authz.ForwardedCertificateKey, string(forged.Raw))
+	authz.ForwardCertificate(context.Background(), md)
+	suite.Assert().NotContains(md, authz.ForwardedCertificateKey)
+}
+
+func TestAuthzSuite(t *testing.T) {
+	suite.Run(t, new(AuthzSuite))
+}
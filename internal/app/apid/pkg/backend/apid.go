@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/talos-systems/talos/api/common"
+	"github.com/talos-systems/talos/internal/app/apid/pkg/authz"
 	"github.com/talos-systems/talos/pkg/constants"
 )
 
@@ -62,6 +63,8 @@ func (a *APID) GetConnection(ctx context.Context) (context.Context, *grpc.Client
 		md.Set("proxyfrom", "unknown")
 	}
 
+	authz.ForwardCertificate(ctx, md)
+
 	outCtx := metadata.NewOutgoingContext(ctx, md)
 
 	a.mu.Lock()
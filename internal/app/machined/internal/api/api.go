@@ -8,7 +8,7 @@ import (
 	"context"
 	"io"
 
-	"github.com/talos-systems/talos/internal/app/machined/internal/api/reg"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/reg"
 	"github.com/talos-systems/talos/internal/pkg/runtime"
 	"github.com/talos-systems/talos/pkg/constants"
 	"github.com/talos-systems/talos/pkg/grpc/factory"
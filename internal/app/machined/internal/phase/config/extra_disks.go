@@ -93,7 +93,11 @@ func mountDisks(r runtime.Runtime) (err error) {
 	for _, extra := range r.Config().Machine().Disks() {
 		for i, part := range extra.Partitions {
 			partname := util.PartPath(extra.Device, i+1)
-			mountpoints.Set(partname, mount.NewMountPoint(partname, part.MountPoint, "xfs", unix.MS_NOATIME, ""))
+
+			// Only the last partition of a disk can be grown.
+			resize := extra.Grow && i == len(extra.Partitions)-1
+
+			mountpoints.Set(partname, mount.NewMountPoint(partname, part.MountPoint, "xfs", unix.MS_NOATIME, "", mount.WithResize(resize)))
 		}
 	}
 
@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/go-multierror"
+	"golang.org/x/sys/unix"
+
+	"github.com/talos-systems/talos/internal/app/machined/internal/phase"
+	"github.com/talos-systems/talos/internal/pkg/runtime"
+	swapfs "github.com/talos-systems/talos/pkg/blockdevice/filesystem/swap"
+	"github.com/talos-systems/talos/pkg/blockdevice/probe"
+	"github.com/talos-systems/talos/pkg/config/machine"
+	"github.com/talos-systems/talos/pkg/swap"
+)
+
+// Swap represents the Swap task.
+type Swap struct{}
+
+// NewSwapTask initializes and returns a Swap task.
+func NewSwapTask() phase.Task {
+	return &Swap{}
+}
+
+// TaskFunc returns the runtime function.
+func (task *Swap) TaskFunc(mode runtime.Mode) phase.TaskFunc {
+	switch mode {
+	case runtime.Container:
+		return nil
+	default:
+		return task.runtime
+	}
+}
+
+func (task *Swap) runtime(r runtime.Runtime) (err error) {
+	var result *multierror.Error
+
+	cfg := r.Config().Machine().Swap()
+
+	for i, zram := range cfg.Zram() {
+		if err = enableZram(i, zram); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	for _, partition := range cfg.Partitions() {
+		if err = enableSwapPartition(partition); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	for _, file := range cfg.Files() {
+		if err = enableSwapFile(file); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func enableZram(id int, zram machine.ZramDevice) (err error) {
+	var z *swap.Zram
+
+	if z, err = swap.GetZram(id); err != nil {
+		return err
+	}
+
+	if err = z.Configure(uint64(zram.Size), zram.Algorithm); err != nil {
+		return err
+	}
+
+	if err = swapfs.MakeSwap(z.Path()); err != nil {
+		return fmt.Errorf("failed to create swap area on %s: %w", z.Path(), err)
+	}
+
+	log.Printf("enabling zram swap %s", z.Path())
+
+	return swap.On(z.Path(), zram.Priority)
+}
+
+func enableSwapPartition(partition machine.SwapPartition) (err error) {
+	var ok bool
+
+	if ok, err = swapfs.IsSwap(partition.Device); err != nil {
+		return err
+	}
+
+	if !ok {
+		// nolint: errcheck
+		if sb, _ := probe.FileSystem(partition.Device); sb != nil {
+			return fmt.Errorf("refusing to create swap area on %s: found %s file system", partition.Device, sb.Type())
+		}
+
+		log.Printf("creating swap area on %s", partition.Device)
+
+		if err = swapfs.MakeSwap(partition.Device); err != nil {
+			return fmt.Errorf("failed to create swap area on %s: %w", partition.Device, err)
+		}
+	}
+
+	log.Printf("enabling swap partition %s", partition.Device)
+
+	return swap.On(partition.Device, partition.Priority)
+}
+
+func enableSwapFile(file machine.SwapFile) (err error) {
+	var ok bool
+
+	st, err := os.Stat(file.Path)
+
+	switch {
+	case err == nil && st.Size() == int64(file.Size):
+		if ok, err = swapfs.IsSwap(file.Path); err != nil {
+			return err
+		}
+	case err == nil:
+		// The size changed, the swap file is recreated.
+		if err = os.Remove(file.Path); err != nil {
+			return err
+		}
+	case !os.IsNotExist(err):
+		return err
+	}
+
+	if !ok {
+		log.Printf("creating swap file %s", file.Path)
+
+		if err = createSwapFile(file.Path, int64(file.Size)); err != nil {
+			return fmt.Errorf("failed to create swap file %s: %w", file.Path, err)
+		}
+	}
+
+	log.Printf("enabling swap file %s", file.Path)
+
+	return swap.On(file.Path, file.Priority)
+}
+
+func createSwapFile(path string, size int64) (err error) {
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_TRUNC|unix.O_CLOEXEC, 0600)
+	if err != nil {
+		return err
+	}
+
+	// Swap files must not contain holes, the space is allocated upfront.
+	if err = unix.Fallocate(int(f.Fd()), 0, 0, size); err != nil {
+		// nolint: errcheck
+		f.Close()
+
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return swapfs.MakeSwap(path)
+}
@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"log"
+	"path/filepath"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/internal/app/machined/internal/phase"
+	"github.com/talos-systems/talos/internal/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/swap"
+)
+
+// SwapOff represents the SwapOff task.
+type SwapOff struct{}
+
+// NewSwapOffTask initializes and returns a SwapOff task.
+//
+// Swap files live on the EPHEMERAL partition, so swap should be disabled before
+// the system disk is unmounted.
+func NewSwapOffTask() phase.Task {
+	return &SwapOff{}
+}
+
+// TaskFunc returns the runtime function.
+func (task *SwapOff) TaskFunc(mode runtime.Mode) phase.TaskFunc {
+	switch mode {
+	case runtime.Container:
+		return nil
+	default:
+		return task.runtime
+	}
+}
+
+func (task *SwapOff) runtime(r runtime.Runtime) (err error) {
+	areas, err := swap.Areas()
+	if err != nil {
+		return err
+	}
+
+	active := map[string]struct{}{}
+
+	for _, area := range areas {
+		active[area.Filename] = struct{}{}
+	}
+
+	cfg := r.Config().Machine().Swap()
+
+	var paths []string
+
+	for _, file := range cfg.Files() {
+		paths = append(paths, file.Path)
+	}
+
+	for _, partition := range cfg.Partitions() {
+		paths = append(paths, partition.Device)
+	}
+
+	for i := range cfg.Zram() {
+		paths = append(paths, (&swap.Zram{ID: i}).Path())
+	}
+
+	var result *multierror.Error
+
+	for _, path := range paths {
+		// /proc/swaps lists the resolved paths, e.g. for /dev/disk/by-* symlinks
+		if resolved, e := filepath.EvalSymlinks(path); e == nil {
+			path = resolved
+		}
+
+		if _, ok := active[path]; !ok {
+			continue
+		}
+
+		log.Printf("disabling swap %s", path)
+
+		if err = swap.Off(path); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
@@ -5,21 +5,37 @@
 package disk
 
 import (
+	"fmt"
+	"log"
+
+	machineapi "github.com/talos-systems/talos/api/machine"
 	"github.com/talos-systems/talos/internal/app/machined/internal/phase"
+	"github.com/talos-systems/talos/internal/pkg/event"
 	"github.com/talos-systems/talos/internal/pkg/runtime"
 	"github.com/talos-systems/talos/pkg/blockdevice"
+	"github.com/talos-systems/talos/pkg/blockdevice/table"
+	gptpartition "github.com/talos-systems/talos/pkg/blockdevice/table/gpt/partition"
 )
 
-// ResetSystemDisk represents the task for stop all containerd tasks in the
-// k8s.io namespace.
+// ResetSystemDisk represents the task for wiping the system disk.
 type ResetSystemDisk struct {
-	devname string
+	devname    string
+	mode       machineapi.WipeMode
+	partitions []*machineapi.ResetPartitionSpec
+	verify     bool
 }
 
-// NewResetSystemDiskTask initializes and returns an Services task.
-func NewResetSystemDiskTask(devname string) phase.Task {
+// NewResetSystemDiskTask initializes and returns an ResetSystemDisk task.
+//
+// Every partition of the system disk is wiped using the given mode, unless
+// overridden by a partition spec. The wiped partitions are removed from the
+// partition table, partitions which are kept are left untouched.
+func NewResetSystemDiskTask(devname string, mode machineapi.WipeMode, partitions []*machineapi.ResetPartitionSpec, verify bool) phase.Task {
 	return &ResetSystemDisk{
-		devname: devname,
+		devname:    devname,
+		mode:       mode,
+		partitions: partitions,
+		verify:     verify,
 	}
 }
 
@@ -31,5 +47,163 @@ func (task *ResetSystemDisk) TaskFunc(mode runtime.Mode) phase.TaskFunc {
 }
 
 func (task *ResetSystemDisk) standard() (err error) {
-	return blockdevice.ResetDevice(task.devname)
+	var bd *blockdevice.BlockDevice
+
+	if bd, err = blockdevice.Open(task.devname); err != nil {
+		return err
+	}
+	// nolint: errcheck
+	defer bd.Close()
+
+	var pt table.PartitionTable
+
+	if pt, err = bd.PartitionTable(true); err != nil {
+		return err
+	}
+
+	specs := map[string]*machineapi.ResetPartitionSpec{}
+
+	for _, spec := range task.partitions {
+		specs[spec.GetLabel()] = spec
+	}
+
+	// Check the partition specs before wiping anything, a mistyped label
+	// must not result in the loss of a partition which should be kept.
+	found := map[string]bool{}
+
+	for _, p := range pt.Partitions() {
+		found[partitionLabel(p)] = true
+	}
+
+	for label := range specs {
+		if !found[label] {
+			return fmt.Errorf("partition %q not found on %s", label, task.devname)
+		}
+	}
+
+	if task.verify {
+		for _, p := range pt.Partitions() {
+			label := partitionLabel(p)
+
+			spec, ok := specs[label]
+			if ok && spec.GetKeep() {
+				continue
+			}
+
+			mode := task.mode
+			if ok {
+				mode = spec.GetMode()
+			}
+
+			// FAST mode doesn't touch the contents of the partition, so there is nothing to verify.
+			if mode == machineapi.WipeMode_FAST {
+				return fmt.Errorf("verify is not supported with the fast wipe mode of partition %q", label)
+			}
+		}
+	}
+
+	var wiped []table.Partition
+
+	for _, p := range pt.Partitions() {
+		label := partitionLabel(p)
+
+		mode := task.mode
+
+		if spec, ok := specs[label]; ok {
+			if spec.GetKeep() {
+				log.Printf("keeping partition %q", label)
+
+				notify(&machineapi.WipeProgress{Stage: machineapi.WipeProgress_KEEP, Label: label})
+
+				continue
+			}
+
+			mode = spec.GetMode()
+		}
+
+		if err = task.wipe(bd, p, label, mode); err != nil {
+			return fmt.Errorf("failed to wipe partition %q: %w", label, err)
+		}
+
+		wiped = append(wiped, p)
+	}
+
+	for _, p := range wiped {
+		if err = pt.Delete(p); err != nil {
+			return fmt.Errorf("failed to delete partition: %w", err)
+		}
+	}
+
+	return pt.Write()
+}
+
+func (task *ResetSystemDisk) wipe(bd *blockdevice.BlockDevice, p table.Partition, label string, mode machineapi.WipeMode) (err error) {
+	var offset, length uint64
+
+	if offset, length, err = bd.PartitionRange(p); err != nil {
+		return err
+	}
+
+	progress := func(stage machineapi.WipeProgress_Stage) blockdevice.ProgressFunc {
+		return func(done, total uint64) {
+			notify(&machineapi.WipeProgress{
+				Stage:      stage,
+				Label:      label,
+				Mode:       mode,
+				BytesDone:  done,
+				BytesTotal: total,
+			})
+		}
+	}
+
+	log.Printf("wiping partition %q using mode %s", label, mode)
+
+	switch mode {
+	case machineapi.WipeMode_FAST:
+		// The partition is only removed from the partition table.
+		progress(machineapi.WipeProgress_WIPE)(length, length)
+
+		return nil
+	case machineapi.WipeMode_ZERO:
+		err = bd.Wipe(blockdevice.WipeZero, offset, length, progress(machineapi.WipeProgress_WIPE))
+	case machineapi.WipeMode_DISCARD:
+		err = bd.Wipe(blockdevice.WipeDiscard, offset, length, progress(machineapi.WipeProgress_WIPE))
+	case machineapi.WipeMode_SECURE_DISCARD:
+		err = bd.Wipe(blockdevice.WipeSecureDiscard, offset, length, progress(machineapi.WipeProgress_WIPE))
+	default:
+		return fmt.Errorf("unknown wipe mode %s", mode)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !task.verify {
+		return nil
+	}
+
+	if mode == machineapi.WipeMode_DISCARD || mode == machineapi.WipeMode_SECURE_DISCARD {
+		// Discarded blocks aren't guaranteed to read back as zeroes.
+		log.Printf("zero filling partition %q before verifying", label)
+
+		if err = bd.Wipe(blockdevice.WipeZero, offset, length, progress(machineapi.WipeProgress_WIPE)); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("verifying partition %q", label)
+
+	return bd.Verify(offset, length, progress(machineapi.WipeProgress_VERIFY))
+}
+
+func partitionLabel(p table.Partition) string {
+	if gp, ok := p.(*gptpartition.Partition); ok {
+		return gp.Name
+	}
+
+	return fmt.Sprintf("partition%d", p.No())
+}
+
+func notify(progress *machineapi.WipeProgress) {
+	event.Bus().Notify(event.Event{Type: event.WipeProgress, Data: progress})
 }
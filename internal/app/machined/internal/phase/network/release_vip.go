@@ -0,0 +1,41 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"log"
+
+	"github.com/talos-systems/talos/internal/app/machined/internal/phase"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/pkg/runtime"
+)
+
+// ReleaseVIP represents the task for handing over the shared virtual IP to
+// another control plane node.
+type ReleaseVIP struct{}
+
+// NewReleaseVIPTask initializes and returns a ReleaseVIP task.
+func NewReleaseVIPTask() phase.Task {
+	return &ReleaseVIP{}
+}
+
+// TaskFunc returns the runtime function.
+func (task *ReleaseVIP) TaskFunc(mode runtime.Mode) phase.TaskFunc {
+	return task.standard
+}
+
+func (task *ReleaseVIP) standard(r runtime.Runtime) (err error) {
+	// vip service is only loaded if shared virtual IPs are configured
+	_, running, err := system.Services(nil).IsRunning("vip")
+	if err != nil || !running {
+		return nil
+	}
+
+	log.Println("releasing shared virtual IP")
+
+	// stopping the service removes the address and resigns from the election
+	return system.Services(nil).Stop(context.Background(), "vip")
+}
@@ -79,6 +79,11 @@ func (r *RuntimeArgs) Config() runtime.Configurator {
 	return r.config
 }
 
+// Mode returns the runtime mode of the platform.
+func (r *Runner) Mode() runtime.Mode {
+	return r.runtime.Platform().Mode()
+}
+
 // Run executes sequentially all phases known to a Runner.
 //
 // If any phase fails, Runner aborts immediately.
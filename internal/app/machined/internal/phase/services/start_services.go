@@ -5,11 +5,14 @@
 package services
 
 import (
+	"fmt"
+
 	"github.com/talos-systems/talos/internal/app/machined/internal/phase"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/services"
 	"github.com/talos-systems/talos/internal/pkg/runtime"
 	"github.com/talos-systems/talos/pkg/config/machine"
+	"github.com/talos-systems/talos/pkg/constants"
 )
 
 // StartServices represents the StartServices task.
@@ -26,8 +29,14 @@ func (task *StartServices) TaskFunc(mode runtime.Mode) phase.TaskFunc {
 }
 
 func (task *StartServices) standard(r runtime.Runtime) (err error) {
+	// /var is mounted at this point, so service events can be persisted
+	system.Services(r.Config()).EnableJournal(constants.ServiceEventsPath)
+
 	task.loadSystemServices(r)
 	task.loadKubernetesServices(r)
+	if err = task.loadUserServices(r); err != nil {
+		return err
+	}
 
 	system.Services(r.Config()).StartAll()
 
@@ -66,11 +75,24 @@ func (task *StartServices) loadSystemServices(r runtime.Runtime) {
 			&services.Etcd{},
 			&services.Trustd{},
 		)
+
+		if len(services.VIPAddresses(r.Config())) > 0 {
+			svcs.Load(
+				&services.VIP{},
+			)
+		}
 	}
 }
 
 func (task *StartServices) loadKubernetesServices(r runtime.Runtime) {
 	svcs := system.Services(r.Config())
+
+	if r.Config().Cluster().LocalLoadBalancer().Enabled() {
+		svcs.Load(
+			&services.ControlPlaneLB{},
+		)
+	}
+
 	svcs.Load(
 		&services.Kubelet{},
 	)
@@ -81,3 +103,19 @@ func (task *StartServices) loadKubernetesServices(r runtime.Runtime) {
 		)
 	}
 }
+
+// loadUserServices loads the user services, the names of the system services are
+// rejected by the config validation, so a clash is reported as an error.
+func (task *StartServices) loadUserServices(r runtime.Runtime) error {
+	svcs := system.Services(r.Config())
+
+	for _, spec := range r.Config().Machine().Services() {
+		if _, _, err := svcs.IsRunning(spec.Name); err == nil {
+			return fmt.Errorf("user service %q clashes with a system service", spec.Name)
+		}
+
+		svcs.Load(services.NewUserService(spec))
+	}
+
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/talos-systems/talos/internal/app/machined/internal/phase/signal"
 	"github.com/talos-systems/talos/internal/app/machined/internal/phase/sysctls"
 	"github.com/talos-systems/talos/internal/app/machined/internal/phase/upgrade"
+	"github.com/talos-systems/talos/internal/pkg/event"
 	"github.com/talos-systems/talos/internal/pkg/mount"
 	"github.com/talos-systems/talos/internal/pkg/runtime"
 	"github.com/talos-systems/talos/pkg/blockdevice/probe"
@@ -148,6 +149,10 @@ func (d *Sequencer) Boot() error {
 			"mount extra disks",
 			configtask.NewExtraDisksTask(),
 		),
+		phase.NewPhase(
+			"enable swap",
+			configtask.NewSwapTask(),
+		),
 		phase.NewPhase(
 			"user requests",
 			configtask.NewExtraFilesTask(),
@@ -181,6 +186,10 @@ func (d *Sequencer) Shutdown() error {
 	}
 
 	phaserunner.Add(
+		phase.NewPhase(
+			"release shared virtual IP",
+			network.NewReleaseVIPTask(),
+		),
 		phase.NewPhase(
 			"stop services",
 			services.NewStopServicesTask(false),
@@ -220,6 +229,10 @@ func (d *Sequencer) Upgrade(req *machineapi.UpgradeRequest) error {
 			"cordon and drain node",
 			kubernetes.NewCordonAndDrainTask(),
 		),
+		phase.NewPhase(
+			"release shared virtual IP",
+			network.NewReleaseVIPTask(),
+		),
 		phase.NewPhase(
 			"handle control plane requirements",
 			upgrade.NewLeaveEtcdTask(),
@@ -232,6 +245,10 @@ func (d *Sequencer) Upgrade(req *machineapi.UpgradeRequest) error {
 			"stop services",
 			services.NewStopServicesTask(true),
 		),
+		phase.NewPhase(
+			"disable swap",
+			configtask.NewSwapOffTask(),
+		),
 		phase.NewPhase(
 			"unmount system disk submounts",
 			rootfs.NewUnmountOverlayTask(),
@@ -251,7 +268,7 @@ func (d *Sequencer) Upgrade(req *machineapi.UpgradeRequest) error {
 		),
 		phase.NewPhase(
 			"reset system disk",
-			disk.NewResetSystemDiskTask(devname),
+			disk.NewResetSystemDiskTask(devname, machineapi.WipeMode_FAST, nil, false),
 		),
 		phase.NewPhase(
 			"upgrade",
@@ -263,7 +280,29 @@ func (d *Sequencer) Upgrade(req *machineapi.UpgradeRequest) error {
 }
 
 // Reset implements the Sequencer interface.
+//
+// Reset progress stream is ended with the final event once the sequence is done or failed.
 func (d *Sequencer) Reset(req *machineapi.ResetRequest) error {
+	err := d.reset(req)
+
+	progress := &machineapi.WipeProgress{
+		Stage: machineapi.WipeProgress_DONE,
+	}
+
+	if err != nil {
+		progress.Error = err.Error()
+	}
+
+	event.Bus().Notify(event.Event{Type: event.WipeProgress, Data: progress})
+
+	return err
+}
+
+// reset builds and runs the reset sequence.
+//
+// In the container mode there is no system disk, so only the graceful phases
+// (e.g. leaving etcd) are run, and the services are stopped.
+func (d *Sequencer) reset(req *machineapi.ResetRequest) error {
 	config, err := config.NewFromFile(constants.ConfigPath)
 	if err != nil {
 		return err
@@ -274,17 +313,21 @@ func (d *Sequencer) Reset(req *machineapi.ResetRequest) error {
 		return err
 	}
 
-	var dev *probe.ProbedBlockDevice
+	var devname string
 
-	dev, err = probe.GetDevWithFileSystemLabel(constants.EphemeralPartitionLabel)
-	if err != nil {
-		return err
-	}
+	if phaserunner.Mode() != runtime.Container {
+		var dev *probe.ProbedBlockDevice
 
-	devname := dev.Device().Name()
+		dev, err = probe.GetDevWithFileSystemLabel(constants.EphemeralPartitionLabel)
+		if err != nil {
+			return err
+		}
 
-	if err := dev.Close(); err != nil {
-		return err
+		devname = dev.Device().Name()
+
+		if err = dev.Close(); err != nil {
+			return err
+		}
 	}
 
 	if req.GetGraceful() {
@@ -293,6 +336,10 @@ func (d *Sequencer) Reset(req *machineapi.ResetRequest) error {
 				"cordon and drain node",
 				kubernetes.NewCordonAndDrainTask(),
 			),
+			phase.NewPhase(
+				"release shared virtual IP",
+				network.NewReleaseVIPTask(),
+			),
 			phase.NewPhase(
 				"handle control plane requirements",
 				upgrade.NewLeaveEtcdTask(),
@@ -309,6 +356,17 @@ func (d *Sequencer) Reset(req *machineapi.ResetRequest) error {
 			"stop services",
 			services.NewStopServicesTask(true),
 		),
+	)
+
+	if phaserunner.Mode() == runtime.Container {
+		return phaserunner.Run()
+	}
+
+	phaserunner.Add(
+		phase.NewPhase(
+			"disable swap",
+			configtask.NewSwapOffTask(),
+		),
 		phase.NewPhase(
 			"unmount system disk submounts",
 			rootfs.NewUnmountOverlayTask(),
@@ -320,7 +378,7 @@ func (d *Sequencer) Reset(req *machineapi.ResetRequest) error {
 		),
 		phase.NewPhase(
 			"reset system disk",
-			disk.NewResetSystemDiskTask(devname),
+			disk.NewResetSystemDiskTask(devname, req.GetMode(), req.GetPartitions(), req.GetVerify()),
 		),
 	)
 
@@ -0,0 +1,238 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package reg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/platforms"
+	criconstants "github.com/containerd/cri/pkg/constants"
+	"github.com/golang/protobuf/ptypes"
+
+	imageapi "github.com/talos-systems/talos/api/image"
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+	"github.com/talos-systems/talos/internal/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/constants"
+)
+
+// ImageRegistrator implements the imageapi.ImageServiceServer interface.
+//
+// Image service can't be implemented by the Registrator, as the method names
+// of the image and machine services clash.
+type ImageRegistrator struct {
+	config runtime.Configurator
+}
+
+// List implements the imageapi.ImageServiceServer interface.
+func (r *ImageRegistrator) List(ctx context.Context, in *imageapi.ImageListRequest) (reply *imageapi.ImageListResponse, err error) {
+	client, ctx, err := imageClient(ctx, in.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	imageList, err := client.ImageService().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*imageapi.ImageInfo, 0, len(imageList))
+
+	for _, img := range imageList {
+		infos = append(infos, imageInfo(ctx, client, img))
+	}
+
+	reply = &imageapi.ImageListResponse{
+		Messages: []*imageapi.ImageList{
+			{
+				Images: infos,
+			},
+		},
+	}
+
+	return reply, nil
+}
+
+// Pull implements the imageapi.ImageServiceServer interface.
+//
+// Images pulled into the system namespace are verified according to the image policy.
+func (r *ImageRegistrator) Pull(ctx context.Context, in *imageapi.ImagePullRequest) (reply *imageapi.ImagePullResponse, err error) {
+	client, ctx, err := imageClient(ctx, in.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	var opts []image.PullOption
+
+	if in.Namespace == constants.SystemContainerdNamespace {
+		opts = append(opts, image.WithPolicy(r.config.Machine().ImagePolicy()))
+	}
+
+	img, err := image.Pull(ctx, r.config.Machine().Registries(), client, in.Reference, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	reply = &imageapi.ImagePullResponse{
+		Messages: []*imageapi.ImagePull{
+			{
+				Image: imageInfo(ctx, client, img.Metadata()),
+			},
+		},
+	}
+
+	return reply, nil
+}
+
+// Remove implements the imageapi.ImageServiceServer interface.
+func (r *ImageRegistrator) Remove(ctx context.Context, in *imageapi.ImageRemoveRequest) (reply *imageapi.ImageRemoveResponse, err error) {
+	client, ctx, err := imageClient(ctx, in.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	if err = client.ImageService().Delete(ctx, in.Reference, images.SynchronousDelete()); err != nil {
+		return nil, fmt.Errorf("error removing image %q: %w", in.Reference, err)
+	}
+
+	reply = &imageapi.ImageRemoveResponse{
+		Messages: []*imageapi.ImageRemove{
+			{},
+		},
+	}
+
+	return reply, nil
+}
+
+// Import implements the imageapi.ImageServiceServer interface.
+//
+// Imported images are unpacked, so that they are ready to be used by the
+// containerd (CRI) without pulling.
+//
+// Imported images can't be verified according to the image policy, so imports
+// into the system namespace are rejected if the image policy is set.
+func (r *ImageRegistrator) Import(srv imageapi.ImageService_ImportServer) error {
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+
+	if req.Namespace == constants.SystemContainerdNamespace {
+		policy := r.config.Machine().ImagePolicy()
+
+		if policy.RequireDigest() || len(policy.SignatureKeys()) > 0 {
+			return errors.New("importing images into the system namespace is not allowed with the image policy")
+		}
+	}
+
+	client, ctx, err := imageClient(srv.Context(), req.Namespace)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		var e error
+
+		for {
+			if len(req.Data) > 0 {
+				if _, e = pw.Write(req.Data); e != nil {
+					return
+				}
+			}
+
+			req, e = srv.Recv()
+			if e != nil {
+				if e == io.EOF {
+					e = nil
+				}
+
+				// nolint: errcheck
+				pw.CloseWithError(e)
+
+				return
+			}
+		}
+	}()
+
+	imported, err := client.Import(ctx, pr)
+
+	// nolint: errcheck
+	pr.CloseWithError(err)
+
+	if err != nil {
+		return fmt.Errorf("error importing images: %w", err)
+	}
+
+	infos := make([]*imageapi.ImageInfo, 0, len(imported))
+
+	for _, img := range imported {
+		if err = containerd.NewImage(client, img).Unpack(ctx, containerd.DefaultSnapshotter); err != nil {
+			return fmt.Errorf("error unpacking image %q: %w", img.Name, err)
+		}
+
+		infos = append(infos, imageInfo(ctx, client, img))
+	}
+
+	return srv.SendAndClose(&imageapi.ImageImportResponse{
+		Images: infos,
+	})
+}
+
+// imageClient builds containerd client for the namespace.
+func imageClient(ctx context.Context, namespace string) (*containerd.Client, context.Context, error) {
+	var addr string
+
+	switch namespace {
+	case constants.SystemContainerdNamespace:
+		addr = constants.SystemContainerdAddress
+	case criconstants.K8sContainerdNamespace:
+		addr = constants.ContainerdAddress
+	default:
+		return nil, nil, fmt.Errorf("unsupported namespace %q", namespace)
+	}
+
+	client, err := containerd.New(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client, namespaces.WithNamespace(ctx, namespace), nil
+}
+
+func imageInfo(ctx context.Context, client *containerd.Client, img images.Image) *imageapi.ImageInfo {
+	info := &imageapi.ImageInfo{
+		Name:   img.Name,
+		Digest: img.Target.Digest.String(),
+	}
+
+	// size is not available if the image content is not fully present (e.g. platform-specific content)
+	if size, err := img.Size(ctx, client.ContentStore(), platforms.Default()); err == nil {
+		info.Size = size
+	}
+
+	// nolint: errcheck
+	info.CreatedAt, _ = ptypes.TimestampProto(img.CreatedAt)
+
+	return info
+}
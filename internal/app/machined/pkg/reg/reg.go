@@ -24,12 +24,14 @@ import (
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/oci"
 	criconstants "github.com/containerd/cri/pkg/constants"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/hashicorp/go-multierror"
 	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
 
 	"github.com/talos-systems/talos/api/common"
+	imageapi "github.com/talos-systems/talos/api/image"
 	machineapi "github.com/talos-systems/talos/api/machine"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
 	"github.com/talos-systems/talos/internal/pkg/containers"
@@ -39,10 +41,12 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/etcd"
 	"github.com/talos-systems/talos/internal/pkg/event"
 	"github.com/talos-systems/talos/internal/pkg/kubeconfig"
+	"github.com/talos-systems/talos/internal/pkg/mount"
 	"github.com/talos-systems/talos/internal/pkg/runtime"
 	"github.com/talos-systems/talos/internal/pkg/runtime/platform"
 	"github.com/talos-systems/talos/internal/pkg/tail"
 	"github.com/talos-systems/talos/pkg/archiver"
+	"github.com/talos-systems/talos/pkg/blockdevice/util"
 	"github.com/talos-systems/talos/pkg/chunker"
 	filechunker "github.com/talos-systems/talos/pkg/chunker/file"
 	"github.com/talos-systems/talos/pkg/chunker/stream"
@@ -54,30 +58,83 @@ import (
 // OSPathSeparator is the string version of the os.PathSeparator
 const OSPathSeparator = string(os.PathSeparator)
 
+// ContainerInspectorFunc builds the container inspector for the namespace and driver.
+type ContainerInspectorFunc func(ctx context.Context, namespace string, driver common.ContainerDriver) (containers.Inspector, error)
+
 // Registrator is the concrete type that implements the factory.Registrator and
 // machineapi.Machine interfaces.
 type Registrator struct {
-	config   runtime.Configurator
-	platform runtime.Platform
+	config    runtime.Configurator
+	platform  runtime.Platform
+	root      string
+	inspector ContainerInspectorFunc
+	images    imageapi.ImageServiceServer
+}
+
+// Option is the functional option func.
+type Option func(*Registrator)
+
+// WithPlatform overrides the platform discovered at runtime.
+func WithPlatform(o runtime.Platform) Option {
+	return func(r *Registrator) {
+		r.platform = o
+	}
+}
+
+// WithRootPath sets the path node paths (files, service logs) are resolved against.
+func WithRootPath(o string) Option {
+	return func(r *Registrator) {
+		r.root = o
+	}
+}
+
+// WithContainerInspector overrides the container inspector used for container logs.
+func WithContainerInspector(o ContainerInspectorFunc) Option {
+	return func(r *Registrator) {
+		r.inspector = o
+	}
+}
+
+// WithImageServer overrides the image service backed by containerd.
+func WithImageServer(o imageapi.ImageServiceServer) Option {
+	return func(r *Registrator) {
+		r.images = o
+	}
 }
 
 // NewRegistrator builds new Registrator instance
-func NewRegistrator(config runtime.Configurator) *Registrator {
-	platform, err := platform.CurrentPlatform()
-	if err != nil {
-		// should never happen
-		log.Printf("failed discovering platform: %v", err)
+func NewRegistrator(config runtime.Configurator, setters ...Option) *Registrator {
+	r := &Registrator{
+		config:    config,
+		root:      OSPathSeparator,
+		inspector: getContainerInspector,
 	}
 
-	return &Registrator{
-		config:   config,
-		platform: platform,
+	for _, setter := range setters {
+		setter(r)
+	}
+
+	if r.images == nil {
+		r.images = &ImageRegistrator{config: config}
+	}
+
+	if r.platform == nil {
+		var err error
+
+		r.platform, err = platform.CurrentPlatform()
+		if err != nil {
+			// should never happen
+			log.Printf("failed discovering platform: %v", err)
+		}
 	}
+
+	return r
 }
 
 // Register implements the factory.Registrator interface.
 func (r *Registrator) Register(s *grpc.Server) {
 	machineapi.RegisterMachineServiceServer(s, r)
+	imageapi.RegisterImageServiceServer(s, r.images)
 }
 
 // Reboot implements the machineapi.MachineServer interface.
@@ -110,7 +167,7 @@ func (r *Registrator) Shutdown(ctx context.Context, in *empty.Empty) (reply *mac
 
 // Upgrade initiates an upgrade.
 func (r *Registrator) Upgrade(ctx context.Context, in *machineapi.UpgradeRequest) (data *machineapi.UpgradeResponse, err error) {
-	if err = pullAndValidateInstallerImage(ctx, r.config.Machine().Registries(), in.GetImage()); err != nil {
+	if err = pullAndValidateInstallerImage(ctx, r.config.Machine().Registries(), r.config.Machine().ImagePolicy(), in.GetImage()); err != nil {
 		return nil, err
 	}
 
@@ -133,6 +190,17 @@ func (r *Registrator) Upgrade(ctx context.Context, in *machineapi.UpgradeRequest
 
 // Reset resets the node.
 func (r *Registrator) Reset(ctx context.Context, in *machineapi.ResetRequest) (data *machineapi.ResetResponse, err error) {
+	if in.GetVerify() {
+		// FAST mode doesn't touch the contents of the partition, so there is nothing to verify.
+		// The default mode is checked against the actual partitions once the reset starts,
+		// as it might be overridden for every one of them.
+		for _, spec := range in.GetPartitions() {
+			if !spec.GetKeep() && spec.GetMode() == machineapi.WipeMode_FAST {
+				return nil, fmt.Errorf("verify is not supported with the fast wipe mode of partition %q", spec.GetLabel())
+			}
+		}
+	}
+
 	event.Bus().Notify(event.Event{Type: event.Reset, Data: in})
 
 	return &machineapi.ResetResponse{
@@ -142,6 +210,164 @@ func (r *Registrator) Reset(ctx context.Context, in *machineapi.ResetRequest) (d
 	}, err
 }
 
+type wipeProgressObserver struct {
+	*event.Embeddable
+}
+
+// Types implements the event.Observer interface.
+func (o wipeProgressObserver) Types() []event.Type {
+	return []event.Type{event.WipeProgress}
+}
+
+// ResetProgressStream streams the progress of wiping the system disk during reset.
+//
+// The stream ends once the reset sequence is done or failed.
+func (r *Registrator) ResetProgressStream(in *empty.Empty, srv machineapi.MachineService_ResetProgressStreamServer) error {
+	observer := wipeProgressObserver{
+		&event.Embeddable{Chan: make(event.Channel, 100)},
+	}
+
+	event.Bus().Register(observer)
+
+	defer func() {
+		event.Bus().Unregister(observer)
+
+		// Drain the channel, so that a notification racing with Unregister
+		// doesn't block the reset.
+		for {
+			select {
+			case <-observer.Channel():
+			default:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return srv.Context().Err()
+		case e := <-observer.Channel():
+			progress, ok := e.Data.(*machineapi.WipeProgress)
+			if !ok {
+				continue
+			}
+
+			if err := srv.Send(progress); err != nil {
+				return err
+			}
+
+			if progress.Stage == machineapi.WipeProgress_DONE {
+				return nil
+			}
+		}
+	}
+}
+
+type imagePullProgressObserver struct {
+	*event.Embeddable
+}
+
+// Types implements the event.Observer interface.
+func (o imagePullProgressObserver) Types() []event.Type {
+	return []event.Type{event.ImagePullProgress}
+}
+
+// UpgradeProgressStream streams the progress of pulling the installer image during upgrade.
+//
+// The stream ends once the installer image was pulled and validated, or the pull failed.
+func (r *Registrator) UpgradeProgressStream(in *empty.Empty, srv machineapi.MachineService_UpgradeProgressStreamServer) error {
+	observer := imagePullProgressObserver{
+		&event.Embeddable{Chan: make(event.Channel, 100)},
+	}
+
+	event.Bus().Register(observer)
+
+	defer func() {
+		event.Bus().Unregister(observer)
+
+		// Drain the channel, so that a notification racing with Unregister
+		// doesn't block the upgrade.
+		for {
+			select {
+			case <-observer.Channel():
+			default:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return srv.Context().Err()
+		case e := <-observer.Channel():
+			progress, ok := e.Data.(*machineapi.ImagePullProgress)
+			if !ok {
+				continue
+			}
+
+			if err := srv.Send(progress); err != nil {
+				return err
+			}
+
+			if progress.Done {
+				return nil
+			}
+		}
+	}
+}
+
+// ServiceDependencies returns the dependency graph of the registered services
+func (r *Registrator) ServiceDependencies(ctx context.Context, in *empty.Empty) (result *machineapi.ServiceDependenciesResponse, err error) {
+	services := system.Services(r.config).List()
+
+	result = &machineapi.ServiceDependenciesResponse{
+		Messages: []*machineapi.ServiceDependencies{
+			{
+				Services: make([]*machineapi.ServiceDependency, len(services)),
+			},
+		},
+	}
+
+	for i := range services {
+		result.Messages[0].Services[i] = services[i].DependenciesAsProto()
+	}
+
+	return result, nil
+}
+
+// ServiceEvents returns history of the service events along with derived timings
+func (r *Registrator) ServiceEvents(ctx context.Context, in *machineapi.ServiceEventsRequest) (result *machineapi.ServiceEventsResponse, err error) {
+	var before time.Time
+
+	if in.Before != nil {
+		if before, err = ptypes.Timestamp(in.Before); err != nil {
+			return nil, err
+		}
+	}
+
+	history := &machineapi.ServiceEventHistory{}
+
+	for _, svcrunner := range system.Services(r.config).List() {
+		if in.Id != "" && svcrunner.ID() != in.Id {
+			continue
+		}
+
+		history.Services = append(history.Services, svcrunner.EventLogAsProto(before, int(in.Limit)))
+	}
+
+	if in.Id != "" && len(history.Services) == 0 {
+		return nil, fmt.Errorf("service %q not defined", in.Id)
+	}
+
+	result = &machineapi.ServiceEventsResponse{
+		Messages: []*machineapi.ServiceEventHistory{history},
+	}
+
+	return result, nil
+}
+
 // ServiceList returns list of the registered services and their status
 func (r *Registrator) ServiceList(ctx context.Context, in *empty.Empty) (result *machineapi.ServiceListResponse, err error) {
 	services := system.Services(r.config).List()
@@ -254,6 +480,8 @@ func (r *Registrator) Copy(req *machineapi.CopyRequest, s machineapi.MachineServ
 		return fmt.Errorf("path is not absolute %v", path)
 	}
 
+	path = r.hostPath(path)
+
 	pr, pw := io.Pipe()
 
 	errCh := make(chan error, 1)
@@ -315,7 +543,7 @@ func (r *Registrator) List(req *machineapi.ListRequest, s machineapi.MachineServ
 		}
 	}
 
-	files, err := archiver.Walker(s.Context(), req.Root, archiver.WithMaxRecurseDepth(maxDepth))
+	files, err := archiver.Walker(s.Context(), r.hostPath(req.Root), archiver.WithMaxRecurseDepth(maxDepth))
 	if err != nil {
 		return err
 	}
@@ -323,13 +551,13 @@ func (r *Registrator) List(req *machineapi.ListRequest, s machineapi.MachineServ
 	for fi := range files {
 		if fi.Error != nil {
 			err = s.Send(&machineapi.FileInfo{
-				Name:         fi.FullPath,
+				Name:         r.nodePath(fi.FullPath),
 				RelativeName: fi.RelPath,
 				Error:        fi.Error.Error(),
 			})
 		} else {
 			err = s.Send(&machineapi.FileInfo{
-				Name:         fi.FullPath,
+				Name:         r.nodePath(fi.FullPath),
 				RelativeName: fi.RelPath,
 				Size:         fi.FileInfo.Size(),
 				Mode:         uint32(fi.FileInfo.Mode()),
@@ -417,6 +645,76 @@ func (r *Registrator) Mounts(ctx context.Context, in *empty.Empty) (reply *machi
 	return reply, multiErr.ErrorOrNil()
 }
 
+// GrowDisk implements the machineapi.MachineServer interface.
+func (r *Registrator) GrowDisk(ctx context.Context, in *machineapi.GrowDiskRequest) (reply *machineapi.GrowDiskResponse, err error) {
+	var disk *machinecfg.Disk
+
+	for _, d := range r.config.Machine().Disks() {
+		if d.Device == in.Device {
+			d := d
+			disk = &d
+
+			break
+		}
+	}
+
+	if disk == nil {
+		return nil, fmt.Errorf("%q is not an extra disk", in.Device)
+	}
+
+	if len(disk.Partitions) == 0 {
+		return nil, fmt.Errorf("no partitions defined for %q", in.Device)
+	}
+
+	part := disk.Partitions[len(disk.Partitions)-1]
+	partname := util.PartPath(disk.Device, len(disk.Partitions))
+
+	mountpoint := mount.NewMountPoint(partname, part.MountPoint, "xfs", unix.MS_NOATIME, "")
+
+	before, err := filesystemSize(mountpoint.Target())
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("growing partition %s mounted on %s", partname, mountpoint.Target())
+
+	if err = mountpoint.ResizePartition(); err != nil {
+		return nil, fmt.Errorf("resize: %w", err)
+	}
+
+	if err = mountpoint.GrowFilesystem(); err != nil {
+		return nil, fmt.Errorf("grow: %w", err)
+	}
+
+	after, err := filesystemSize(mountpoint.Target())
+	if err != nil {
+		return nil, err
+	}
+
+	reply = &machineapi.GrowDiskResponse{
+		Messages: []*machineapi.GrowDisk{
+			{
+				Partition:  partname,
+				MountedOn:  mountpoint.Target(),
+				SizeBefore: before,
+				SizeAfter:  after,
+			},
+		},
+	}
+
+	return reply, nil
+}
+
+func filesystemSize(mountpoint string) (uint64, error) {
+	var stat unix.Statfs_t
+
+	if err := unix.Statfs(mountpoint, &stat); err != nil {
+		return 0, err
+	}
+
+	return uint64(stat.Bsize) * stat.Blocks, nil
+}
+
 // Version implements the machineapi.MachineServer interface.
 func (r *Registrator) Version(ctx context.Context, in *empty.Empty) (reply *machineapi.VersionResponse, err error) {
 	var platform *machineapi.PlatformInfo
@@ -486,7 +784,7 @@ func (r *Registrator) Logs(req *machineapi.LogsRequest, l machineapi.MachineServ
 
 	switch {
 	case req.Namespace == constants.SystemContainerdNamespace || req.Id == "kubelet":
-		filename := filepath.Join(constants.DefaultLogPath, filepath.Base(req.Id)+".log")
+		filename := filepath.Join(r.hostPath(constants.DefaultLogPath), filepath.Base(req.Id)+".log")
 
 		var file *os.File
 
@@ -513,7 +811,7 @@ func (r *Registrator) Logs(req *machineapi.LogsRequest, l machineapi.MachineServ
 	default:
 		var file io.Closer
 
-		if chunk, file, err = k8slogs(l.Context(), req); err != nil {
+		if chunk, file, err = r.k8slogs(l.Context(), req); err != nil {
 			return err
 		}
 		// nolint: errcheck
@@ -529,8 +827,8 @@ func (r *Registrator) Logs(req *machineapi.LogsRequest, l machineapi.MachineServ
 	return nil
 }
 
-func k8slogs(ctx context.Context, req *machineapi.LogsRequest) (chunker.Chunker, io.Closer, error) {
-	inspector, err := getContainerInspector(ctx, req.Namespace, req.Driver)
+func (r *Registrator) k8slogs(ctx context.Context, req *machineapi.LogsRequest) (chunker.Chunker, io.Closer, error) {
+	inspector, err := r.inspector(ctx, req.Namespace, req.Driver)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -571,14 +869,16 @@ func getContainerInspector(ctx context.Context, namespace string, driver common.
 
 // Read implements the read API.
 func (r *Registrator) Read(in *machineapi.ReadRequest, srv machineapi.MachineService_ReadServer) (err error) {
-	stat, err := os.Stat(in.Path)
+	path := r.hostPath(in.Path)
+
+	stat, err := os.Stat(path)
 	if err != nil {
 		return err
 	}
 
 	switch mode := stat.Mode(); {
 	case mode.IsRegular():
-		f, err := os.OpenFile(in.Path, os.O_RDONLY, 0)
+		f, err := os.OpenFile(path, os.O_RDONLY, 0)
 		if err != nil {
 			return err
 		}
@@ -604,7 +904,52 @@ func (r *Registrator) Read(in *machineapi.ReadRequest, srv machineapi.MachineSer
 	}
 }
 
-func pullAndValidateInstallerImage(ctx context.Context, config machinecfg.Registries, ref string) error {
+// hostPath resolves the node path against the root path.
+func (r *Registrator) hostPath(path string) string {
+	return filepath.Join(r.root, filepath.Clean(OSPathSeparator+path))
+}
+
+// nodePath is the reverse of hostPath.
+func (r *Registrator) nodePath(path string) string {
+	rel, err := filepath.Rel(r.root, path)
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(OSPathSeparator, rel)
+}
+
+func pullAndValidateInstallerImage(ctx context.Context, config machinecfg.Registries, policy machinecfg.ImagePolicy, ref string) (err error) {
+	var last image.Progress
+
+	reportProgress := func(p image.Progress) {
+		last = p
+
+		event.Bus().Notify(event.Event{
+			Type: event.ImagePullProgress,
+			Data: &machineapi.ImagePullProgress{
+				Image:      p.Ref,
+				BytesDone:  uint64(p.BytesDone),
+				BytesTotal: uint64(p.BytesTotal),
+			},
+		})
+	}
+
+	defer func() {
+		progress := &machineapi.ImagePullProgress{
+			Image:      ref,
+			BytesDone:  uint64(last.BytesDone),
+			BytesTotal: uint64(last.BytesTotal),
+			Done:       true,
+		}
+
+		if err != nil {
+			progress.Error = err.Error()
+		}
+
+		event.Bus().Notify(event.Event{Type: event.ImagePullProgress, Data: progress})
+	}()
+
 	// Pull down specified installer image early so we can bail if it doesn't exist in the upstream registry
 	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
 
@@ -613,7 +958,7 @@ func pullAndValidateInstallerImage(ctx context.Context, config machinecfg.Regist
 		return err
 	}
 
-	img, err := image.Pull(containerdctx, config, client, ref)
+	img, err := image.Pull(containerdctx, config, client, ref, image.WithPolicy(policy), image.WithProgress(reportProgress))
 	if err != nil {
 		return err
 	}
@@ -28,6 +28,7 @@ const (
 	StateFinished
 	StateFailed
 	StateSkipped
+	StateCrashLoop
 )
 
 func (state ServiceState) String() string {
@@ -48,16 +49,21 @@ func (state ServiceState) String() string {
 		return "Failed"
 	case StateSkipped:
 		return "Skipped"
+	case StateCrashLoop:
+		return "CrashLoop"
 	default:
 		return "Unknown"
 	}
 }
 
+// MessageHealthy is the message of the event recorded on successful health check
+const MessageHealthy = "Health check successful"
+
 // ServiceEvent describes state change of the running service
 type ServiceEvent struct {
-	Message   string
-	State     ServiceState
-	Timestamp time.Time
+	Message   string       `json:"msg"`
+	State     ServiceState `json:"state"`
+	Timestamp time.Time    `json:"ts"`
 }
 
 // ServiceEvents is a fixed length history of events
@@ -106,8 +112,88 @@ func (events *ServiceEvents) Get(count int) (result []ServiceEvent) {
 
 // AsProto returns protobuf-ready serialized snapshot
 func (events *ServiceEvents) AsProto(count int) *machineapi.ServiceEvents {
-	eventList := events.Get(count)
+	return EventsAsProto(events.Get(count))
+}
+
+// Timings are derived from the last start of the service.
+//
+// Zero duration means that the stage was not reached.
+type Timings struct {
+	Waiting   time.Duration
+	ToRunning time.Duration
+	ToHealthy time.Duration
+}
+
+// GetTimings calculates timings from the history of events.
+//
+// Service start is detected as the first event in Preparing or Waiting state
+// after the event in any other state.
+func GetTimings(events []ServiceEvent) (timings Timings) {
+	starting := func(state ServiceState) bool {
+		return state == StatePreparing || state == StateWaiting
+	}
+
+	start := -1
+
+	for i := len(events) - 1; i >= 0; i-- {
+		if starting(events[i].State) && (i == 0 || !starting(events[i-1].State)) {
+			start = i
+
+			break
+		}
+	}
+
+	if start == -1 {
+		return
+	}
+
+	startedAt := events[start].Timestamp
+
+	var waitingSince time.Time
+
+	for _, event := range events[start:] {
+		if !waitingSince.IsZero() && event.State != StateWaiting {
+			timings.Waiting += event.Timestamp.Sub(waitingSince)
+			waitingSince = time.Time{}
+		}
+
+		if waitingSince.IsZero() && event.State == StateWaiting {
+			waitingSince = event.Timestamp
+		}
+
+		if timings.ToRunning == 0 && event.State == StateRunning {
+			timings.ToRunning = event.Timestamp.Sub(startedAt)
+		}
+
+		if timings.ToHealthy == 0 && event.State == StateRunning && event.Message == MessageHealthy {
+			timings.ToHealthy = event.Timestamp.Sub(startedAt)
+		}
+	}
+
+	return timings
+}
+
+// AsProto returns protobuf-ready timings
+func (timings Timings) AsProto() *machineapi.ServiceTimings {
+	result := &machineapi.ServiceTimings{}
+
+	if timings.Waiting > 0 {
+		result.Waiting = ptypes.DurationProto(timings.Waiting)
+	}
+
+	if timings.ToRunning > 0 {
+		result.ToRunning = ptypes.DurationProto(timings.ToRunning)
+	}
+
+	if timings.ToHealthy > 0 {
+		result.ToHealthy = ptypes.DurationProto(timings.ToHealthy)
+	}
+
+	return result
+}
 
+// EventsAsProto converts list of events to protobuf-ready format
+func EventsAsProto(eventList []ServiceEvent) *machineapi.ServiceEvents {
 	result := &machineapi.ServiceEvents{
 		Events: make([]*machineapi.ServiceEvent, len(eventList)),
 	}
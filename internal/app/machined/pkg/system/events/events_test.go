@@ -5,8 +5,12 @@
 package events_test
 
 import (
+	"io/ioutil"
+	"os"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -77,6 +81,78 @@ func (suite *EventsSuite) TestOverflow() {
 	suite.assertEvents(expected[len(expected)-3:], e.Get(3))
 }
 
+func (suite *EventsSuite) TestJournal() {
+	dir, err := ioutil.TempDir("", "talos")
+	suite.Require().NoError(err)
+
+	defer os.RemoveAll(dir) //nolint: errcheck
+
+	j := events.NewJournal(dir)
+
+	evs, err := j.Read("svc")
+	suite.Require().NoError(err)
+	suite.Assert().Empty(evs)
+
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	suite.Require().NoError(j.Append("svc", events.ServiceEvent{Message: "0", State: events.StateWaiting, Timestamp: ts}))
+	suite.Require().NoError(j.Append("svc", events.ServiceEvent{Message: "1", State: events.StateRunning, Timestamp: ts.Add(time.Second)}))
+	suite.Require().NoError(j.Append("other", events.ServiceEvent{Message: "other"}))
+
+	evs, err = j.Read("svc")
+	suite.Require().NoError(err)
+	suite.assertEvents([]string{"0", "1"}, evs)
+	suite.Assert().Equal(events.StateRunning, evs[1].State)
+	suite.Assert().True(evs[1].Timestamp.Equal(ts.Add(time.Second)))
+
+	// push journal over the size limit to trigger rotation
+	message := strings.Repeat("x", 1024)
+
+	for i := 0; i < 2*events.MaxJournalSize/len(message)+1; i++ {
+		suite.Require().NoError(j.Append("svc", events.ServiceEvent{Message: message}))
+	}
+
+	suite.Require().NoError(j.Append("svc", events.ServiceEvent{Message: "last"}))
+
+	evs, err = j.Read("svc")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("last", evs[len(evs)-1].Message)
+	suite.Assert().NotEqual("0", evs[0].Message)
+	suite.Assert().Less(len(evs), 2*events.MaxJournalSize/len(message)+2)
+}
+
+func (suite *EventsSuite) TestGetTimings() {
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(seconds int) time.Time {
+		return ts.Add(time.Duration(seconds) * time.Second)
+	}
+
+	suite.Assert().Equal(events.Timings{}, events.GetTimings(nil))
+
+	evs := []events.ServiceEvent{
+		// previous boot
+		{State: events.StateWaiting, Timestamp: at(0)},
+		{State: events.StateRunning, Timestamp: at(1)},
+		{State: events.StateFinished, Timestamp: at(2)},
+		// last start
+		{State: events.StateWaiting, Message: "Waiting for cond1, cond2", Timestamp: at(100)},
+		{State: events.StateWaiting, Message: "Waiting for cond2", Timestamp: at(103)},
+		{State: events.StatePreparing, Timestamp: at(105)},
+		{State: events.StateRunning, Timestamp: at(106)},
+		{State: events.StateRunning, Message: events.MessageHealthy, Timestamp: at(110)},
+	}
+
+	suite.Assert().Equal(events.Timings{
+		Waiting:   5 * time.Second,
+		ToRunning: 6 * time.Second,
+		ToHealthy: 10 * time.Second,
+	}, events.GetTimings(evs))
+
+	timings := events.GetTimings(evs[:6]).AsProto()
+	suite.Assert().Nil(timings.ToRunning)
+	suite.Assert().EqualValues(5, timings.Waiting.Seconds)
+}
+
 func TestEventsSuite(t *testing.T) {
 	suite.Run(t, new(EventsSuite))
 }
@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// MaxJournalSize is the size of the journal file which triggers rotation.
+//
+// Journal keeps single rotated file, so the size of the service history on disk
+// is bounded by 2*MaxJournalSize.
+const MaxJournalSize = 256 * 1024
+
+// Journal persists service events on disk, one file per service.
+//
+// Events are stored as JSON, one event per line.
+type Journal struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewJournal creates journal storing events in the directory.
+func NewJournal(path string) *Journal {
+	return &Journal{
+		path: path,
+	}
+}
+
+func (j *Journal) filename(id string) string {
+	return filepath.Join(j.path, id+".log")
+}
+
+// Append persists events of the service.
+func (j *Journal) Append(id string, events ...ServiceEvent) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if err := os.MkdirAll(j.path, 0700); err != nil {
+		return err
+	}
+
+	filename := j.filename(id)
+
+	if st, err := os.Stat(filename); err == nil && st.Size() > MaxJournalSize {
+		if err = os.Rename(filename, filename+".1"); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	encoder := json.NewEncoder(f)
+
+	for _, event := range events {
+		if err = encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+
+	return f.Close()
+}
+
+// Read returns persisted events of the service, with most recent event being the last one.
+func (j *Journal) Read(id string) (result []ServiceEvent, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	filename := j.filename(id)
+
+	for _, name := range []string{filename + ".1", filename} {
+		var events []ServiceEvent
+
+		events, err = readJournalFile(name)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, events...)
+	}
+
+	return result, nil
+}
+
+func readJournalFile(filename string) (result []ServiceEvent, err error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		var event ServiceEvent
+
+		// skip lines which can't be decoded, e.g. partially written on power loss
+		if json.Unmarshal(scanner.Bytes(), &event) != nil {
+			continue
+		}
+
+		result = append(result, event)
+	}
+
+	return result, scanner.Err()
+}
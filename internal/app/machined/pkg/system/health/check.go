@@ -6,9 +6,15 @@ package health
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 )
 
+// ErrLivenessFailed is returned by Run when health check fails persistently
+// and liveness action should be taken.
+var ErrLivenessFailed = errors.New("liveness check failed")
+
 // Check runs the health check under given context.
 //
 // Healthcheck is considered successful when func returns no error.
@@ -17,7 +23,8 @@ type Check func(ctx context.Context) error
 
 // Run the health check publishing the results to state.
 //
-// Run aborts when context is canceled.
+// Run aborts when context is canceled, or when the check fails settings.FailureThreshold
+// times in a row and settings.LivenessAction is set (ErrLivenessFailed is returned).
 func Run(ctx context.Context, settings *Settings, state *State, check Check) error {
 	state.Init()
 
@@ -36,6 +43,7 @@ func Run(ctx context.Context, settings *Settings, state *State, check Check) err
 		message        string
 		checkCtx       context.Context
 		checkCtxCancel context.CancelFunc
+		failures       int
 	)
 
 	for {
@@ -51,10 +59,17 @@ func Run(ctx context.Context, settings *Settings, state *State, check Check) err
 
 		if !healthy {
 			message = err.Error()
+			failures++
+		} else {
+			failures = 0
 		}
 
 		state.Update(healthy, message)
 
+		if settings.LivenessAction != LivenessActionNone && settings.FailureThreshold > 0 && failures >= settings.FailureThreshold {
+			return fmt.Errorf("%w: %d consecutive failures, last error: %s", ErrLivenessFailed, failures, message)
+		}
+
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
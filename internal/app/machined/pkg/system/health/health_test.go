@@ -203,6 +203,57 @@ func (suite *CheckSuite) TestInitialState() {
 	suite.Assert().EqualError(<-errCh, context.Canceled.Error())
 }
 
+func (suite *CheckSuite) TestLiveness() {
+	settings := health.Settings{
+		InitialDelay:     time.Millisecond,
+		Period:           time.Millisecond,
+		Timeout:          time.Millisecond,
+		FailureThreshold: 3,
+		LivenessAction:   health.LivenessActionRestart,
+	}
+
+	var called uint32
+
+	// third check succeeds, which resets failure counter
+	check := func(context.Context) error {
+		if atomic.AddUint32(&called, 1) == 3 {
+			return nil
+		}
+
+		return errors.New("health failed")
+	}
+
+	var state health.State
+
+	err := health.Run(context.Background(), &settings, &state, check)
+
+	suite.Require().True(errors.Is(err, health.ErrLivenessFailed))
+	suite.Assert().EqualError(err, "liveness check failed: 3 consecutive failures, last error: health failed")
+	suite.Assert().EqualValues(6, atomic.LoadUint32(&called))
+	suite.Assert().False(*state.Get().Healthy)
+}
+
+func (suite *CheckSuite) TestLivenessNone() {
+	settings := health.Settings{
+		InitialDelay:     time.Millisecond,
+		Period:           time.Millisecond,
+		Timeout:          time.Millisecond,
+		FailureThreshold: 1,
+	}
+
+	check := func(context.Context) error {
+		return errors.New("health failed")
+	}
+
+	var state health.State
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer ctxCancel()
+
+	// without liveness action, Run keeps checking until context is canceled
+	suite.Assert().EqualError(health.Run(ctx, &settings, &state, check), context.DeadlineExceeded.Error())
+}
+
 func TestCheckSuite(t *testing.T) {
 	suite.Run(t, new(CheckSuite))
 }
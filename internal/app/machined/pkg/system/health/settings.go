@@ -6,6 +6,32 @@ package health
 
 import "time"
 
+// LivenessAction is the action taken when health check fails persistently.
+type LivenessAction int
+
+// LivenessAction constants
+const (
+	// LivenessActionNone only reports service as unhealthy
+	LivenessActionNone LivenessAction = iota
+	// LivenessActionRestart restarts the service
+	LivenessActionRestart
+	// LivenessActionReboot reboots the node
+	LivenessActionReboot
+)
+
+func (action LivenessAction) String() string {
+	switch action {
+	case LivenessActionNone:
+		return "None"
+	case LivenessActionRestart:
+		return "Restart"
+	case LivenessActionReboot:
+		return "Reboot"
+	default:
+		return "Unknown"
+	}
+}
+
 // Settings configures health check
 //
 // Fields are similar to k8s pod probe definitions.
@@ -13,6 +39,11 @@ type Settings struct {
 	InitialDelay time.Duration
 	Period       time.Duration
 	Timeout      time.Duration
+
+	// FailureThreshold is the number of consecutive failed checks
+	// after which LivenessAction is taken.
+	FailureThreshold int
+	LivenessAction   LivenessAction
 }
 
 // DefaultSettings provides some default health check settings
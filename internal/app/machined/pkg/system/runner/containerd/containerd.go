@@ -206,11 +206,29 @@ func (c *containerdRunner) newContainerOpts(image containerd.Image, specOpts []o
 func (c *containerdRunner) newOCISpecOpts(image oci.Image) []oci.SpecOpts {
 	specOpts := []oci.SpecOpts{
 		oci.WithImageConfig(image),
-		oci.WithProcessArgs(c.args.ProcessArgs...),
+	}
+
+	// keep image entrypoint if process args are not set
+	if len(c.args.ProcessArgs) > 0 {
+		specOpts = append(specOpts, oci.WithProcessArgs(c.args.ProcessArgs...))
+	}
+
+	specOpts = append(specOpts,
 		oci.WithEnv(c.opts.Env),
 		oci.WithHostHostsFile,
 		oci.WithHostResolvconf,
+	)
+
+	if c.opts.CgroupPath != "" {
+		specOpts = append(specOpts, oci.WithCgroup(c.opts.CgroupPath))
 	}
+
+	specOpts = append(specOpts, WithResources(c.opts.LinuxResources()))
+
+	if c.opts.OOMScoreAdj != 0 {
+		specOpts = append(specOpts, WithOOMScoreAdj(c.opts.OOMScoreAdj))
+	}
+
 	specOpts = append(specOpts, c.opts.OCISpecOpts...)
 
 	return specOpts
@@ -12,14 +12,32 @@ import (
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
-// WithMemoryLimit sets the linux resource memory limit field.
-func WithMemoryLimit(limit int64) oci.SpecOpts {
+// WithResources sets the linux resource memory and CPU limits.
+//
+// Limits which are not set in resources are not changed.
+func WithResources(resources *specs.LinuxResources) oci.SpecOpts {
 	return func(_ context.Context, _ oci.Client, _ *containers.Container, s *specs.Spec) error {
-		s.Linux.Resources.Memory = &specs.LinuxMemory{
-			Limit: &limit,
-			// DisableOOMKiller: &disable,
+		if s.Linux.Resources == nil {
+			s.Linux.Resources = &specs.LinuxResources{}
 		}
 
+		if resources.Memory != nil {
+			s.Linux.Resources.Memory = resources.Memory
+		}
+
+		if resources.CPU != nil {
+			s.Linux.Resources.CPU = resources.CPU
+		}
+
+		return nil
+	}
+}
+
+// WithOOMScoreAdj sets the OOM killer score adjustment of the process.
+func WithOOMScoreAdj(adj int) oci.SpecOpts {
+	return func(_ context.Context, _ oci.Client, _ *containers.Container, s *specs.Spec) error {
+		s.Process.OOMScoreAdj = &adj
+
 		return nil
 	}
 }
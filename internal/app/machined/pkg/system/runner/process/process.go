@@ -8,11 +8,15 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"strconv"
 	"syscall"
 	"time"
 
+	"github.com/containerd/cgroups"
+
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	processlogger "github.com/talos-systems/talos/internal/app/machined/pkg/system/log"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
@@ -121,6 +125,11 @@ func (p *processRunner) run(eventSink events.Recorder) error {
 
 	eventSink(events.StateRunning, "Process %s started with PID %d", p, cmd.Process.Pid)
 
+	// process is already running, so failure to apply limits is only reported
+	if err = p.applyResources(cmd.Process.Pid); err != nil {
+		eventSink(events.StateRunning, "Failed to apply resource limits to %s: %s", p, err)
+	}
+
 	waitCh := make(chan error)
 
 	go func() {
@@ -157,6 +166,30 @@ func (p *processRunner) run(eventSink events.Recorder) error {
 	return nil
 }
 
+// applyResources places the process into the cgroup and adjusts OOM score.
+func (p *processRunner) applyResources(pid int) error {
+	if p.opts.OOMScoreAdj != 0 {
+		if err := ioutil.WriteFile(fmt.Sprintf("/proc/%d/oom_score_adj", pid), []byte(strconv.Itoa(p.opts.OOMScoreAdj)), 0); err != nil {
+			return fmt.Errorf("error adjusting OOM score: %w", err)
+		}
+	}
+
+	if p.opts.CgroupPath == "" {
+		return nil
+	}
+
+	cgroup, err := cgroups.New(cgroups.V1, cgroups.StaticPath(p.opts.CgroupPath), p.opts.LinuxResources())
+	if err != nil {
+		return fmt.Errorf("error creating cgroup %q: %w", p.opts.CgroupPath, err)
+	}
+
+	if err = cgroup.Add(cgroups.Process{Pid: pid}); err != nil {
+		return fmt.Errorf("error adding process to cgroup %q: %w", p.opts.CgroupPath, err)
+	}
+
+	return nil
+}
+
 func (p *processRunner) String() string {
 	return fmt.Sprintf("Process(%q)", p.args.ProcessArgs)
 }
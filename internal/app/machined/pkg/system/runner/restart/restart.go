@@ -11,6 +11,7 @@ import (
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/pkg/retry"
 )
 
 type restarter struct {
@@ -42,7 +43,20 @@ type Options struct {
 	// Type describes the service's restart policy.
 	Type Type
 	// RestartInterval is the interval between restarts for failed runs
+	//
+	// Interval grows exponentially with each restart (RestartInterval is the unit
+	// of the backoff) up to MaxRestartInterval.
 	RestartInterval time.Duration
+	// MaxRestartInterval caps the backoff between restarts
+	MaxRestartInterval time.Duration
+	// StableRunPeriod is the run duration after which the run is considered stable:
+	// backoff and crash loop detection are reset
+	StableRunPeriod time.Duration
+	// CrashLoopThreshold is the number of unstable runs in CrashLoopWindow
+	// after which the service is reported to be in crash loop (0 disables detection)
+	CrashLoopThreshold int
+	// CrashLoopWindow is the time window for crash loop detection
+	CrashLoopWindow time.Duration
 }
 
 // Option is the functional option func.
@@ -76,8 +90,12 @@ func (t Type) String() string {
 // DefaultOptions describes the default options to a runner.
 func DefaultOptions() *Options {
 	return &Options{
-		Type:            Forever,
-		RestartInterval: 5 * time.Second,
+		Type:               Forever,
+		RestartInterval:    5 * time.Second,
+		MaxRestartInterval: 5 * time.Minute,
+		StableRunPeriod:    time.Minute,
+		CrashLoopThreshold: 5,
+		CrashLoopWindow:    10 * time.Minute,
 	}
 }
 
@@ -95,6 +113,28 @@ func WithRestartInterval(interval time.Duration) Option {
 	}
 }
 
+// WithMaxRestartInterval sets the cap of the backoff between restarts
+func WithMaxRestartInterval(interval time.Duration) Option {
+	return func(args *Options) {
+		args.MaxRestartInterval = interval
+	}
+}
+
+// WithStableRunPeriod sets the run duration which resets backoff and crash loop detection
+func WithStableRunPeriod(period time.Duration) Option {
+	return func(args *Options) {
+		args.StableRunPeriod = period
+	}
+}
+
+// WithCrashLoopDetection sets the number of unstable runs in the window which is reported as crash loop
+func WithCrashLoopDetection(threshold int, window time.Duration) Option {
+	return func(args *Options) {
+		args.CrashLoopThreshold = threshold
+		args.CrashLoopWindow = window
+	}
+}
+
 // Open implements the Runner interface
 func (r *restarter) Open(ctx context.Context) error {
 	return r.wrappedRunner.Open(ctx)
@@ -105,9 +145,18 @@ func (r *restarter) Open(ctx context.Context) error {
 func (r *restarter) Run(eventSink events.Recorder) error {
 	defer close(r.stopped)
 
+	backoff := r.newBackoff()
+
+	var (
+		unstableRuns []time.Time
+		crashLoop    bool
+	)
+
 	for {
 		errCh := make(chan error)
 
+		started := time.Now()
+
 		go func() {
 			errCh <- r.wrappedRunner.Run(eventSink)
 		}()
@@ -135,25 +184,89 @@ func (r *restarter) Run(eventSink events.Recorder) error {
 			if err == nil {
 				return nil
 			}
+		}
+
+		finished := time.Now()
+
+		if finished.Sub(started) >= r.opts.StableRunPeriod {
+			backoff = r.newBackoff()
+			unstableRuns = unstableRuns[:0]
+		} else {
+			unstableRuns = append(unstableRuns, finished)
+		}
+
+		for len(unstableRuns) > 0 && finished.Sub(unstableRuns[0]) > r.opts.CrashLoopWindow {
+			unstableRuns = unstableRuns[1:]
+		}
+
+		state := events.StateWaiting
+		if r.opts.CrashLoopThreshold > 0 && len(unstableRuns) >= r.opts.CrashLoopThreshold {
+			state = events.StateCrashLoop
+		}
+
+		interval := backoff.next()
 
-			eventSink(events.StateWaiting, "Error running %s, going to restart until it succeeds: %v", r.wrappedRunner, err)
+		switch r.opts.Type {
+		case UntilSuccess:
+			eventSink(state, "Error running %s, going to restart until it succeeds in %s: %v", r.wrappedRunner, interval, err)
 		case Forever:
 			if err == nil {
-				eventSink(events.StateWaiting, "Runner %s exited without error, going to restart it", r.wrappedRunner)
+				eventSink(state, "Runner %s exited without error, going to restart it in %s", r.wrappedRunner, interval)
 			} else {
-				eventSink(events.StateWaiting, "Error running %v, going to restart forever: %v", r.wrappedRunner, err)
+				eventSink(state, "Error running %v, going to restart forever in %s: %v", r.wrappedRunner, interval, err)
 			}
 		}
 
+		if state == events.StateCrashLoop && !crashLoop {
+			eventSink(state, "Runner %s restarted %d times in %s, crash loop detected", r.wrappedRunner, len(unstableRuns), r.opts.CrashLoopWindow)
+		}
+
+		crashLoop = state == events.StateCrashLoop
+
 		select {
 		case <-r.stop:
 			eventSink(events.StateStopping, "Aborting restart sequence")
 			return nil
-		case <-time.After(r.opts.RestartInterval):
+		case <-time.After(interval):
 		}
 	}
 }
 
+// backoff calculates intervals between restarts with truncated exponential algorithm.
+type backoff struct {
+	ticker *retry.ExponentialTicker
+	opts   *Options
+	capped bool
+}
+
+func (r *restarter) newBackoff() *backoff {
+	return &backoff{
+		ticker: retry.NewExponentialTicker(retry.NewDefaultOptions(retry.WithUnits(r.opts.RestartInterval))),
+		opts:   r.opts,
+	}
+}
+
+func (b *backoff) next() time.Duration {
+	if b.capped {
+		return b.opts.MaxRestartInterval
+	}
+
+	interval := b.ticker.Tick()
+
+	if interval < b.opts.RestartInterval {
+		interval = b.opts.RestartInterval
+	}
+
+	if b.opts.MaxRestartInterval > 0 && interval >= b.opts.MaxRestartInterval {
+		// stop ticking to avoid overflow
+		b.capped = true
+
+		return b.opts.MaxRestartInterval
+	}
+
+	return interval
+}
+
 // Stop implements the Runner interface
 func (r *restarter) Stop() error {
 	close(r.stop)
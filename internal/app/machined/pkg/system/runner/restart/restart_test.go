@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sync"
 	"testing"
 	"time"
 
@@ -72,6 +73,27 @@ func MockEventSink(state events.ServiceState, message string, args ...interface{
 	log.Printf("state %s: %s", state, fmt.Sprintf(message, args...))
 }
 
+type recorder struct {
+	mu       sync.Mutex
+	states   []events.ServiceState
+	messages []string
+}
+
+func (r *recorder) record(state events.ServiceState, message string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.states = append(r.states, state)
+	r.messages = append(r.messages, fmt.Sprintf(message, args...))
+}
+
+func (r *recorder) get() ([]events.ServiceState, []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]events.ServiceState(nil), r.states...), append([]string(nil), r.messages...)
+}
+
 func (suite *RestartSuite) TestString() {
 	suite.Assert().Equal("Restart(UntilSuccess, MockRunner())", restart.New(&MockRunner{}, restart.WithType(restart.UntilSuccess)).String())
 }
@@ -176,6 +198,175 @@ func (suite *RestartSuite) TestRunForever() {
 	suite.Assert().Equal(4, mock.times)
 }
 
+func (suite *RestartSuite) TestBackoff() {
+	mock := MockRunner{
+		exitCh: make(chan error),
+	}
+
+	r := restart.New(&mock,
+		restart.WithType(restart.Forever),
+		restart.WithRestartInterval(10*time.Millisecond),
+		restart.WithMaxRestartInterval(40*time.Millisecond),
+		restart.WithCrashLoopDetection(0, 0),
+	)
+	suite.Assert().NoError(r.Open(context.Background()))
+
+	defer func() { suite.Assert().NoError(r.Close()) }()
+
+	var rec recorder
+
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- r.Run(rec.record)
+	}()
+
+	failed := errors.New("failed")
+
+	for i := 0; i < 5; i++ {
+		mock.exitCh <- failed
+	}
+
+	// let the runner enter restart sequence
+	time.Sleep(10 * time.Millisecond)
+
+	suite.Assert().NoError(r.Stop())
+	suite.Assert().NoError(<-errCh)
+
+	_, messages := rec.get()
+
+	suite.Require().True(len(messages) >= 5)
+
+	for i, interval := range []string{"10ms", "10ms", "30ms", "40ms", "40ms"} {
+		suite.Assert().Contains(messages[i], "going to restart forever in "+interval)
+	}
+}
+
+func (suite *RestartSuite) TestCrashLoop() {
+	mock := MockRunner{
+		exitCh: make(chan error),
+	}
+
+	r := restart.New(&mock,
+		restart.WithType(restart.UntilSuccess),
+		restart.WithRestartInterval(time.Millisecond),
+		restart.WithCrashLoopDetection(3, time.Minute),
+	)
+	suite.Assert().NoError(r.Open(context.Background()))
+
+	defer func() { suite.Assert().NoError(r.Close()) }()
+
+	var rec recorder
+
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- r.Run(rec.record)
+	}()
+
+	failed := errors.New("failed")
+
+	for i := 0; i < 4; i++ {
+		mock.exitCh <- failed
+	}
+
+	mock.exitCh <- nil
+
+	suite.Assert().NoError(<-errCh)
+	suite.Assert().NoError(r.Stop())
+
+	states, messages := rec.get()
+
+	suite.Assert().Equal([]events.ServiceState{
+		events.StateWaiting,
+		events.StateWaiting,
+		events.StateCrashLoop,
+		events.StateCrashLoop,
+		events.StateCrashLoop,
+	}, states)
+	suite.Assert().Contains(messages[3], "crash loop detected")
+}
+
+func (suite *RestartSuite) TestStableRunResetsCrashLoop() {
+	mock := MockRunner{
+		exitCh: make(chan error),
+	}
+
+	r := restart.New(&mock,
+		restart.WithType(restart.Forever),
+		restart.WithRestartInterval(time.Millisecond),
+		restart.WithStableRunPeriod(20*time.Millisecond),
+		restart.WithCrashLoopDetection(2, time.Minute),
+	)
+	suite.Assert().NoError(r.Open(context.Background()))
+
+	defer func() { suite.Assert().NoError(r.Close()) }()
+
+	var rec recorder
+
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- r.Run(rec.record)
+	}()
+
+	failed := errors.New("failed")
+
+	for i := 0; i < 3; i++ {
+		time.Sleep(50 * time.Millisecond)
+
+		mock.exitCh <- failed
+	}
+
+	// let the runner enter restart sequence
+	time.Sleep(time.Millisecond)
+
+	suite.Assert().NoError(r.Stop())
+	suite.Assert().NoError(<-errCh)
+
+	states, _ := rec.get()
+
+	suite.Assert().NotContains(states, events.StateCrashLoop)
+}
+
+func (suite *RestartSuite) TestStableRunNotCounted() {
+	mock := MockRunner{
+		exitCh: make(chan error),
+	}
+
+	r := restart.New(&mock,
+		restart.WithType(restart.Forever),
+		restart.WithRestartInterval(time.Millisecond),
+		restart.WithStableRunPeriod(20*time.Millisecond),
+		restart.WithCrashLoopDetection(1, time.Minute),
+	)
+	suite.Assert().NoError(r.Open(context.Background()))
+
+	defer func() { suite.Assert().NoError(r.Close()) }()
+
+	var rec recorder
+
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- r.Run(rec.record)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	mock.exitCh <- errors.New("failed")
+
+	// let the runner enter restart sequence
+	time.Sleep(time.Millisecond)
+
+	suite.Assert().NoError(r.Stop())
+	suite.Assert().NoError(<-errCh)
+
+	states, _ := rec.get()
+
+	suite.Assert().NotContains(states, events.StateCrashLoop)
+}
+
 func TestRestartSuite(t *testing.T) {
 	suite.Run(t, new(RestartSuite))
 }
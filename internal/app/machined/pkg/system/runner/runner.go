@@ -7,10 +7,12 @@ package runner
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/oci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/pkg/constants"
@@ -51,6 +53,15 @@ type Options struct {
 	// GracefulShutdownTimeout is the time to wait for process to exit after SIGTERM
 	// before sending SIGKILL
 	GracefulShutdownTimeout time.Duration
+	// CgroupPath is the cgroup (relative to the hierarchy root) to place the process into,
+	// if empty, process is not moved out of the parent cgroup
+	CgroupPath string
+	// MemoryLimit is the memory limit of the cgroup in bytes (0 means no limit)
+	MemoryLimit int64
+	// CPUShares is the relative CPU weight of the cgroup (0 means default weight)
+	CPUShares uint64
+	// OOMScoreAdj is the OOM killer score adjustment for the process
+	OOMScoreAdj int
 }
 
 // Option is the functional option func.
@@ -122,3 +133,55 @@ func WithGracefulShutdownTimeout(timeout time.Duration) Option {
 		args.GracefulShutdownTimeout = timeout
 	}
 }
+
+// WithCgroupPath sets the cgroup to place the process into.
+func WithCgroupPath(path string) Option {
+	return func(args *Options) {
+		args.CgroupPath = path
+	}
+}
+
+// WithMemoryLimit sets the memory limit in bytes.
+func WithMemoryLimit(limit int64) Option {
+	return func(args *Options) {
+		args.MemoryLimit = limit
+	}
+}
+
+// WithCPUShares sets the relative CPU weight.
+func WithCPUShares(shares uint64) Option {
+	return func(args *Options) {
+		args.CPUShares = shares
+	}
+}
+
+// WithOOMScoreAdj sets the OOM killer score adjustment.
+func WithOOMScoreAdj(adj int) Option {
+	return func(args *Options) {
+		args.OOMScoreAdj = adj
+	}
+}
+
+// CgroupPath returns the default cgroup path for the service.
+func CgroupPath(id string) string {
+	return filepath.Join(constants.CgroupSystem, id)
+}
+
+// LinuxResources returns cgroup resource limits as configured in the options.
+func (o *Options) LinuxResources() *specs.LinuxResources {
+	resources := &specs.LinuxResources{}
+
+	if o.MemoryLimit > 0 {
+		resources.Memory = &specs.LinuxMemory{
+			Limit: &o.MemoryLimit,
+		}
+	}
+
+	if o.CPUShares > 0 {
+		resources.CPU = &specs.LinuxCPU{
+			Shares: &o.CPUShares,
+		}
+	}
+
+	return resources
+}
@@ -4,11 +4,39 @@
 
 package runner_test
 
-import "testing"
+import (
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+)
+
+type RunnerSuite struct {
+	suite.Suite
+}
+
+func (suite *RunnerSuite) TestCgroupPath() {
+	suite.Assert().Equal("/system/etcd", runner.CgroupPath("etcd"))
+}
+
+func (suite *RunnerSuite) TestLinuxResources() {
+	opts := runner.DefaultOptions()
+
+	resources := opts.LinuxResources()
+	suite.Assert().Nil(resources.Memory)
+	suite.Assert().Nil(resources.CPU)
+
+	runner.WithMemoryLimit(64 * 1024 * 1024)(opts)
+	runner.WithCPUShares(512)(opts)
+
+	resources = opts.LinuxResources()
+	suite.Require().NotNil(resources.Memory)
+	suite.Assert().EqualValues(64*1024*1024, *resources.Memory.Limit)
+	suite.Require().NotNil(resources.CPU)
+	suite.Assert().EqualValues(512, *resources.CPU.Shares)
+}
+
+func TestRunnerSuite(t *testing.T) {
+	suite.Run(t, new(RunnerSuite))
 }
@@ -6,16 +6,20 @@ package system
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"sync"
 	"time"
 
+	"github.com/containerd/cgroups"
+
 	machineapi "github.com/talos-systems/talos/api/machine"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
 	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
 	"github.com/talos-systems/talos/internal/pkg/conditions"
+	"github.com/talos-systems/talos/internal/pkg/event"
 	"github.com/talos-systems/talos/internal/pkg/runtime"
 )
 
@@ -33,11 +37,15 @@ type ServiceRunner struct {
 	service Service
 	id      string
 
-	state  events.ServiceState
-	events events.ServiceEvents
+	state   events.ServiceState
+	events  events.ServiceEvents
+	journal *events.Journal
 
 	healthState health.State
 
+	// condition the service is waiting for, if any
+	condition conditions.Condition
+
 	stateSubscribers map[StateEvent][]chan<- struct{}
 
 	ctxMu     sync.Mutex
@@ -60,6 +68,11 @@ func NewServiceRunner(service Service, config runtime.Configurator) *ServiceRunn
 	}
 }
 
+// ID returns the ID of the service
+func (svcrunner *ServiceRunner) ID() string {
+	return svcrunner.id
+}
+
 // GetState implements events.Recorder
 func (svcrunner *ServiceRunner) GetState() events.ServiceState {
 	svcrunner.mu.Lock()
@@ -79,7 +92,7 @@ func (svcrunner *ServiceRunner) UpdateState(newstate events.ServiceState, messag
 	}
 
 	svcrunner.state = newstate
-	svcrunner.events.Push(event)
+	svcrunner.pushEventLocked(event)
 
 	log.Printf("service[%s](%s): %s", svcrunner.id, svcrunner.state, event.Message)
 
@@ -107,7 +120,7 @@ func (svcrunner *ServiceRunner) healthUpdate(change health.StateChange) {
 
 	var message string
 	if *change.New.Healthy {
-		message = "Health check successful"
+		message = events.MessageHealthy
 	} else {
 		message = fmt.Sprintf("Health check failed: %s", change.New.LastMessage)
 	}
@@ -117,7 +130,7 @@ func (svcrunner *ServiceRunner) healthUpdate(change health.StateChange) {
 		State:     svcrunner.state,
 		Timestamp: time.Now(),
 	}
-	svcrunner.events.Push(event)
+	svcrunner.pushEventLocked(event)
 
 	log.Printf("service[%s](%s): %s", svcrunner.id, svcrunner.state, event.Message)
 
@@ -129,6 +142,113 @@ func (svcrunner *ServiceRunner) healthUpdate(change health.StateChange) {
 	}
 }
 
+// livenessFailed records the liveness failure and takes the configured action.
+func (svcrunner *ServiceRunner) livenessFailed(action health.LivenessAction, err error) {
+	svcrunner.mu.Lock()
+
+	serviceEvent := events.ServiceEvent{
+		Message:   fmt.Sprintf("Liveness check failed, action %s: %s", action, err),
+		State:     svcrunner.state,
+		Timestamp: time.Now(),
+	}
+	svcrunner.pushEventLocked(serviceEvent)
+
+	log.Printf("service[%s](%s): %s", svcrunner.id, svcrunner.state, serviceEvent.Message)
+
+	svcrunner.mu.Unlock()
+
+	switch action {
+	case health.LivenessActionNone:
+	case health.LivenessActionRestart:
+		// restart waits for the service run to finish, so it can't be called from within the run
+		go func() {
+			if err := Services(svcrunner.config).restart(svcrunner.id); err != nil {
+				log.Printf("service[%s]: failed to restart: %s", svcrunner.id, err)
+			}
+		}()
+	case health.LivenessActionReboot:
+		event.Bus().Notify(event.Event{Type: event.Reboot})
+	}
+}
+
+// pushEventLocked records the event in the history and in the journal (if enabled)
+func (svcrunner *ServiceRunner) pushEventLocked(event events.ServiceEvent) {
+	svcrunner.events.Push(event)
+
+	if svcrunner.journal != nil {
+		// journal is best effort, in-memory history is always available
+		// nolint: errcheck
+		svcrunner.journal.Append(svcrunner.id, event)
+	}
+}
+
+// setJournal enables persisting events to the journal
+//
+// Events recorded so far are persisted immediately.
+func (svcrunner *ServiceRunner) setJournal(journal *events.Journal) {
+	svcrunner.mu.Lock()
+	defer svcrunner.mu.Unlock()
+
+	if svcrunner.journal != nil {
+		return
+	}
+
+	svcrunner.journal = journal
+
+	if history := svcrunner.events.Get(events.MaxEventsToKeep); len(history) > 0 {
+		// nolint: errcheck
+		svcrunner.journal.Append(svcrunner.id, history...)
+	}
+}
+
+// EventLog returns full history of events for this service
+//
+// If journal is enabled, history includes events from the previous boots,
+// otherwise in-memory history is returned.
+func (svcrunner *ServiceRunner) EventLog() []events.ServiceEvent {
+	svcrunner.mu.Lock()
+	journal := svcrunner.journal
+	svcrunner.mu.Unlock()
+
+	if journal != nil {
+		if history, err := journal.Read(svcrunner.id); err == nil {
+			return history
+		}
+	}
+
+	return svcrunner.GetEventHistory(events.MaxEventsToKeep)
+}
+
+// EventLogAsProto returns protobuf struct with the event history and timings
+//
+// Only events before the timestamp (if set) are returned, limited to
+// the count most recent events (if count is not zero). Timings are always
+// derived from the full history.
+func (svcrunner *ServiceRunner) EventLogAsProto(before time.Time, count int) *machineapi.ServiceEventLog {
+	history := svcrunner.EventLog()
+	timings := events.GetTimings(history)
+
+	if !before.IsZero() {
+		n := len(history)
+
+		for n > 0 && !history[n-1].Timestamp.Before(before) {
+			n--
+		}
+
+		history = history[:n]
+	}
+
+	if count > 0 && len(history) > count {
+		history = history[len(history)-count:]
+	}
+
+	return &machineapi.ServiceEventLog{
+		Id:      svcrunner.id,
+		Events:  events.EventsAsProto(history),
+		Timings: timings.AsProto(),
+	}
+}
+
 // GetEventHistory returns history of events for this service
 func (svcrunner *ServiceRunner) GetEventHistory(count int) []events.ServiceEvent {
 	svcrunner.mu.Lock()
@@ -138,6 +258,16 @@ func (svcrunner *ServiceRunner) GetEventHistory(count int) []events.ServiceEvent
 }
 
 func (svcrunner *ServiceRunner) waitFor(ctx context.Context, condition conditions.Condition) error {
+	svcrunner.mu.Lock()
+	svcrunner.condition = condition
+	svcrunner.mu.Unlock()
+
+	defer func() {
+		svcrunner.mu.Lock()
+		svcrunner.condition = nil
+		svcrunner.mu.Unlock()
+	}()
+
 	description := condition.String()
 	svcrunner.UpdateState(events.StateWaiting, "Waiting for %s", description)
 
@@ -270,8 +400,11 @@ func (svcrunner *ServiceRunner) run(ctx context.Context, runnr runner.Runner) er
 		go func() {
 			defer healthWg.Done()
 
-			// nolint: errcheck
-			health.Run(ctx, healthSvc.HealthSettings(svcrunner.config), &svcrunner.healthState, healthSvc.HealthFunc(svcrunner.config))
+			settings := healthSvc.HealthSettings(svcrunner.config)
+
+			if err := health.Run(ctx, settings, &svcrunner.healthState, healthSvc.HealthFunc(svcrunner.config)); errors.Is(err, health.ErrLivenessFailed) {
+				svcrunner.livenessFailed(settings.LivenessAction, err)
+			}
 		}()
 
 		notifyCh := make(chan health.StateChange, 2)
@@ -328,17 +461,73 @@ func (svcrunner *ServiceRunner) Shutdown() {
 
 // AsProto returns protobuf struct with the state of the service runner
 func (svcrunner *ServiceRunner) AsProto() *machineapi.ServiceInfo {
+	resources := svcrunner.resourcesAsProto()
+
 	svcrunner.mu.Lock()
 	defer svcrunner.mu.Unlock()
 
 	return &machineapi.ServiceInfo{
-		Id:     svcrunner.id,
-		State:  svcrunner.state.String(),
-		Events: svcrunner.events.AsProto(events.MaxEventsToKeep),
-		Health: svcrunner.healthState.AsProto(),
+		Id:        svcrunner.id,
+		State:     svcrunner.state.String(),
+		Events:    svcrunner.events.AsProto(events.MaxEventsToKeep),
+		Health:    svcrunner.healthState.AsProto(),
+		Resources: resources,
 	}
 }
 
+// DependenciesAsProto returns protobuf struct with the service dependencies
+// and the condition service is waiting for
+func (svcrunner *ServiceRunner) DependenciesAsProto() *machineapi.ServiceDependency {
+	svcrunner.mu.Lock()
+	state := svcrunner.state
+	condition := svcrunner.condition
+	svcrunner.mu.Unlock()
+
+	dependency := &machineapi.ServiceDependency{
+		Id:        svcrunner.id,
+		State:     state.String(),
+		DependsOn: svcrunner.service.DependsOn(svcrunner.config),
+	}
+
+	if condition != nil {
+		dependency.Condition = condition.String()
+	}
+
+	return dependency
+}
+
+// resourcesAsProto returns current resource usage of the service cgroup.
+//
+// If the service is not placed into the cgroup, nil is returned.
+func (svcrunner *ServiceRunner) resourcesAsProto() *machineapi.ServiceResources {
+	path := runner.CgroupPath(svcrunner.id)
+
+	cgroup, err := cgroups.Load(cgroups.V1, cgroups.StaticPath(path))
+	if err != nil {
+		return nil
+	}
+
+	stats, err := cgroup.Stat(cgroups.IgnoreNotExist)
+	if err != nil {
+		return nil
+	}
+
+	resources := &machineapi.ServiceResources{
+		Cgroup: path,
+	}
+
+	if stats.Memory != nil && stats.Memory.Usage != nil {
+		resources.MemoryUsage = stats.Memory.Usage.Usage
+		resources.MemoryLimit = stats.Memory.Usage.Limit
+	}
+
+	if stats.CPU != nil && stats.CPU.Usage != nil {
+		resources.CpuUsage = stats.CPU.Usage.Total
+	}
+
+	return resources
+}
+
 // Subscribe to a specific event for this service.
 //
 // Channel `ch` should be buffered or it should have listener attached to it,
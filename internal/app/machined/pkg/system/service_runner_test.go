@@ -75,6 +75,18 @@ func (suite *ServiceRunnerSuite) TestFullFlow() {
 	suite.Assert().Equal("Finished", protoService.State)
 	suite.Assert().True(protoService.Health.Unknown)
 	suite.Assert().Len(protoService.Events.Events, 5)
+
+	eventLog := sr.EventLogAsProto(time.Time{}, 2)
+	suite.Assert().Equal("MockRunner", eventLog.Id)
+	suite.Require().Len(eventLog.Events.Events, 2)
+	suite.Assert().Equal("Running", eventLog.Events.Events[0].State)
+	suite.Assert().Equal("Finished", eventLog.Events.Events[1].State)
+	suite.Assert().NotNil(eventLog.Timings.ToRunning)
+
+	// paging back from the last event
+	lastEvent := sr.GetEventHistory(1)[0]
+	eventLog = sr.EventLogAsProto(lastEvent.Timestamp, 0)
+	suite.Assert().Len(eventLog.Events.Events, 4)
 }
 
 func (suite *ServiceRunnerSuite) TestFullFlowHealthy() {
@@ -225,6 +237,8 @@ func (suite *ServiceRunnerSuite) TestWaitingDescriptionChange() {
 		return nil
 	}))
 
+	suite.Assert().Equal("cond2", sr.DependenciesAsProto().Condition)
+
 	select {
 	case <-finished:
 		suite.Require().Fail("service running should be still running")
@@ -242,6 +256,8 @@ func (suite *ServiceRunnerSuite) TestWaitingDescriptionChange() {
 		return nil
 	}))
 
+	suite.Assert().Empty(sr.DependenciesAsProto().Condition)
+
 	sr.Shutdown()
 
 	<-finished
@@ -135,6 +135,7 @@ func (o *APID) Runner(config runtime.Configurator) (runner.Runner, error) {
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithContainerImage(image),
 		runner.WithEnv(env),
+		runner.WithCgroupPath(runner.CgroupPath(o.ID(config))),
 		runner.WithOCISpecOpts(
 			oci.WithHostNamespace(specs.NetworkNamespace),
 			oci.WithMounts(mounts),
@@ -160,5 +161,10 @@ func (o *APID) HealthFunc(runtime.Configurator) health.Check {
 
 // HealthSettings implements the HealthcheckedService interface
 func (o *APID) HealthSettings(runtime.Configurator) *health.Settings {
-	return &health.DefaultSettings
+	settings := health.DefaultSettings
+	// restart apid if it stays unhealthy for a minute
+	settings.FailureThreshold = 12
+	settings.LivenessAction = health.LivenessActionRestart
+
+	return &settings
 }
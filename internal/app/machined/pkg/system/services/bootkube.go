@@ -285,6 +285,12 @@ func generateAssets(config runtime.Configurator) (err error) {
 
 	urls := []string{config.Cluster().Endpoint().Hostname()}
 	urls = append(urls, config.Cluster().CertSANs()...)
+
+	if config.Cluster().LocalLoadBalancer().Enabled() {
+		// kubelet connects to the API server via local load balancer
+		urls = append(urls, "127.0.0.1", "localhost")
+	}
+
 	altNames := altNamesFromURLs(urls)
 
 	k8sCA, err := config.Cluster().CA().GetCert()
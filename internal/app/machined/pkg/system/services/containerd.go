@@ -75,6 +75,8 @@ func (c *Containerd) Runner(config runtime.Configurator) (runner.Runner, error)
 		config.Debug(),
 		args,
 		runner.WithEnv(env),
+		runner.WithCgroupPath(runner.CgroupPath(c.ID(config))),
+		runner.WithOOMScoreAdj(-999),
 	),
 		restart.WithType(restart.Forever),
 	), nil
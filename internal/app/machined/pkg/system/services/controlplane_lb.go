@@ -0,0 +1,217 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/goroutine"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/internal/pkg/conditions"
+	"github.com/talos-systems/talos/internal/pkg/loadbalancer"
+	"github.com/talos-systems/talos/internal/pkg/loadbalancer/upstream"
+	"github.com/talos-systems/talos/internal/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/constants"
+	"github.com/talos-systems/talos/pkg/kubernetes"
+)
+
+const (
+	controlPlaneLBDiscoveryInterval = 30 * time.Second
+	controlPlaneLBDrainTimeout      = 30 * time.Second
+)
+
+// ControlPlaneLB implements the Service interface. It runs load balancer for the control plane
+// endpoint on localhost of each node.
+//
+// Upstreams are the cluster control plane endpoint from the config and the control plane
+// nodes discovered via Kubernetes endpoints of the API server.
+type ControlPlaneLB struct{}
+
+// ID implements the Service interface.
+func (c *ControlPlaneLB) ID(config runtime.Configurator) string {
+	return "controlplane-lb"
+}
+
+// PreFunc implements the Service interface.
+func (c *ControlPlaneLB) PreFunc(ctx context.Context, config runtime.Configurator) error {
+	return nil
+}
+
+// PostFunc implements the Service interface.
+func (c *ControlPlaneLB) PostFunc(config runtime.Configurator, state events.ServiceState) (err error) {
+	return nil
+}
+
+// Condition implements the Service interface.
+func (c *ControlPlaneLB) Condition(config runtime.Configurator) conditions.Condition {
+	return nil
+}
+
+// DependsOn implements the Service interface.
+func (c *ControlPlaneLB) DependsOn(config runtime.Configurator) []string {
+	return []string{"networkd"}
+}
+
+// Runner implements the Service interface.
+func (c *ControlPlaneLB) Runner(config runtime.Configurator) (runner.Runner, error) {
+	return restart.New(goroutine.NewRunner(config, c.ID(config), c.main),
+		restart.WithType(restart.Forever),
+	), nil
+}
+
+// HealthFunc implements the HealthcheckedService interface
+func (c *ControlPlaneLB) HealthFunc(config runtime.Configurator) health.Check {
+	return func(ctx context.Context) error {
+		var d net.Dialer
+
+		conn, err := d.DialContext(ctx, "tcp", controlPlaneLBAddr(config))
+		if err != nil {
+			return err
+		}
+
+		return conn.Close()
+	}
+}
+
+// HealthSettings implements the HealthcheckedService interface
+func (c *ControlPlaneLB) HealthSettings(runtime.Configurator) *health.Settings {
+	return &health.DefaultSettings
+}
+
+// APIRestartAllowed implements the APIRestartableService interface.
+func (c *ControlPlaneLB) APIRestartAllowed(config runtime.Configurator) bool {
+	return true
+}
+
+func (c *ControlPlaneLB) main(ctx context.Context, config runtime.Configurator, logOutput io.Writer) error {
+	logger := log.New(logOutput, "", log.LstdFlags)
+
+	listenAddr := controlPlaneLBAddr(config)
+
+	lb := &loadbalancer.TCP{
+		DrainTimeout: controlPlaneLBDrainTimeout,
+	}
+
+	upstreams := controlPlaneUpstreams(config, nil)
+
+	if err := lb.AddRoute(listenAddr, upstreams, upstream.WithStrategy(upstream.LeastConnections)); err != nil {
+		return err
+	}
+
+	if err := lb.Start(); err != nil {
+		return err
+	}
+
+	logger.Printf("load balancer listening on %s, upstreams %v", listenAddr, upstreams)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- lb.Wait()
+	}()
+
+	ticker := time.NewTicker(controlPlaneLBDiscoveryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			lb.Close() //nolint: errcheck
+			<-errCh
+
+			return ctx.Err()
+		case err := <-errCh:
+			return err
+		case <-ticker.C:
+		}
+
+		newUpstreams, changed, err := reconcileUpstreams(config, upstreams, discoverControlPlaneIPs)
+		if err != nil {
+			logger.Printf("failed to discover control plane nodes: %s", err)
+
+			continue
+		}
+
+		if !changed {
+			continue
+		}
+
+		if err = lb.ReconcileRoute(listenAddr, newUpstreams); err != nil {
+			return err
+		}
+
+		logger.Printf("updated upstreams %v", newUpstreams)
+
+		upstreams = newUpstreams
+	}
+}
+
+func controlPlaneLBAddr(config runtime.Configurator) string {
+	return net.JoinHostPort("127.0.0.1", strconv.Itoa(config.Cluster().LocalLoadBalancer().Port()))
+}
+
+// controlPlaneUpstreams builds the list of upstreams: control plane endpoint and control plane node IPs.
+func controlPlaneUpstreams(config runtime.Configurator, controlPlaneIPs []string) []string {
+	endpoint := config.Cluster().Endpoint()
+
+	port := endpoint.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	upstreams := []string{net.JoinHostPort(endpoint.Hostname(), port)}
+
+	// endpoint addresses are not guaranteed to be returned in the same order
+	ips := append([]string(nil), controlPlaneIPs...)
+	sort.Strings(ips)
+
+	for _, ip := range ips {
+		upstreams = append(upstreams, net.JoinHostPort(ip, strconv.Itoa(config.Cluster().LocalAPIServerPort())))
+	}
+
+	return upstreams
+}
+
+// reconcileUpstreams discovers control plane nodes and returns the new list of upstreams
+// along with the flag whether it differs from the current one.
+func reconcileUpstreams(config runtime.Configurator, upstreams []string, discover func() ([]string, error)) ([]string, bool, error) {
+	controlPlaneIPs, err := discover()
+	if err != nil {
+		return nil, false, err
+	}
+
+	newUpstreams := controlPlaneUpstreams(config, controlPlaneIPs)
+
+	return newUpstreams, fmt.Sprint(newUpstreams) != fmt.Sprint(upstreams), nil
+}
+
+// discoverControlPlaneIPs returns addresses of the API server endpoints using kubelet credentials.
+func discoverControlPlaneIPs() ([]string, error) {
+	restConfig, err := clientcmd.BuildConfigFromFlags("", constants.KubeletKubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	restConfig.Timeout = 10 * time.Second
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.MasterIPs()
+}
@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/pkg/config/types/v1alpha1"
+)
+
+func controlPlaneLBConfig(t *testing.T, endpoint string) *v1alpha1.Config {
+	u, err := url.Parse(endpoint)
+	require.NoError(t, err)
+
+	return &v1alpha1.Config{
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			ControlPlane: &v1alpha1.ControlPlaneConfig{
+				Endpoint: &v1alpha1.Endpoint{URL: u},
+			},
+		},
+	}
+}
+
+func TestControlPlaneUpstreams(t *testing.T) {
+	config := controlPlaneLBConfig(t, "https://cluster.local")
+
+	assert.Equal(t, []string{"cluster.local:443"}, controlPlaneUpstreams(config, nil))
+
+	config = controlPlaneLBConfig(t, "https://10.5.0.1:6443")
+	config.ClusterConfig.ControlPlane.LocalAPIServerPort = 7443
+
+	assert.Equal(t,
+		[]string{"10.5.0.1:6443", "10.5.0.3:7443", "10.5.0.4:7443", "[fd00::2]:7443"},
+		controlPlaneUpstreams(config, []string{"fd00::2", "10.5.0.4", "10.5.0.3"}),
+	)
+}
+
+func TestReconcileUpstreams(t *testing.T) {
+	config := controlPlaneLBConfig(t, "https://10.5.0.1:6443")
+
+	var (
+		endpoints    []string
+		discoveryErr error
+	)
+
+	discover := func() ([]string, error) {
+		return endpoints, discoveryErr
+	}
+
+	upstreams := controlPlaneUpstreams(config, nil)
+
+	// no control plane nodes discovered yet
+	newUpstreams, changed, err := reconcileUpstreams(config, upstreams, discover)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, upstreams, newUpstreams)
+
+	// control plane nodes show up in the endpoints
+	endpoints = []string{"10.5.0.2", "10.5.0.3"}
+
+	newUpstreams, changed, err = reconcileUpstreams(config, upstreams, discover)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []string{"10.5.0.1:6443", "10.5.0.2:6443", "10.5.0.3:6443"}, newUpstreams)
+
+	upstreams = newUpstreams
+
+	// same endpoints in a different order
+	endpoints = []string{"10.5.0.3", "10.5.0.2"}
+
+	newUpstreams, changed, err = reconcileUpstreams(config, upstreams, discover)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, upstreams, newUpstreams)
+
+	// control plane node goes away
+	endpoints = []string{"10.5.0.3"}
+
+	newUpstreams, changed, err = reconcileUpstreams(config, upstreams, discover)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []string{"10.5.0.1:6443", "10.5.0.3:6443"}, newUpstreams)
+
+	upstreams = newUpstreams
+
+	// discovery failure keeps current upstreams
+	discoveryErr = errors.New("connection refused")
+
+	_, changed, err = reconcileUpstreams(config, upstreams, discover)
+	require.Error(t, err)
+	assert.False(t, changed)
+}
@@ -70,7 +70,7 @@ func (e *Etcd) PreFunc(ctx context.Context, config runtime.Configurator) (err er
 	// Pull the image and unpack it.
 
 	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
-	if _, err = image.Pull(containerdctx, config.Machine().Registries(), client, config.Cluster().Etcd().Image()); err != nil {
+	if _, err = image.Pull(containerdctx, config.Machine().Registries(), client, config.Cluster().Etcd().Image(), image.WithPolicy(config.Machine().ImagePolicy())); err != nil {
 		return fmt.Errorf("failed to pull image %q: %w", config.Cluster().Etcd().Image(), err)
 	}
 
@@ -121,6 +121,8 @@ func (e *Etcd) Runner(config runtime.Configurator) (runner.Runner, error) {
 		runner.WithNamespace(constants.SystemContainerdNamespace),
 		runner.WithContainerImage(config.Cluster().Etcd().Image()),
 		runner.WithEnv(env),
+		runner.WithCgroupPath(runner.CgroupPath(e.ID(config))),
+		runner.WithOOMScoreAdj(-998),
 		runner.WithOCISpecOpts(
 			oci.WithHostNamespace(specs.NetworkNamespace),
 			oci.WithMounts(mounts),
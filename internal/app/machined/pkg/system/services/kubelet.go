@@ -76,7 +76,7 @@ func (k *Kubelet) PreFunc(ctx context.Context, config runtime.Configurator) erro
 		BootstrapTokenID     string
 		BootstrapTokenSecret string
 	}{
-		Server:               config.Cluster().Endpoint().String(),
+		Server:               kubeletServer(config),
 		CACert:               base64.StdEncoding.EncodeToString(config.Cluster().CA().Crt),
 		BootstrapTokenID:     config.Cluster().Token().ID(),
 		BootstrapTokenSecret: config.Cluster().Token().Secret(),
@@ -116,7 +116,7 @@ func (k *Kubelet) PreFunc(ctx context.Context, config runtime.Configurator) erro
 	// Pull the image and unpack it.
 	containerdctx := namespaces.WithNamespace(ctx, "k8s.io")
 
-	_, err = image.Pull(containerdctx, config.Machine().Registries(), client, config.Machine().Kubelet().Image())
+	_, err = image.Pull(containerdctx, config.Machine().Registries(), client, config.Machine().Kubelet().Image(), image.WithPolicy(config.Machine().ImagePolicy()))
 	if err != nil {
 		return err
 	}
@@ -124,6 +124,16 @@ func (k *Kubelet) PreFunc(ctx context.Context, config runtime.Configurator) erro
 	return nil
 }
 
+// kubeletServer returns API server URL for kubelet: either control plane endpoint,
+// or local control plane load balancer, if enabled.
+func kubeletServer(config runtime.Configurator) string {
+	if config.Cluster().LocalLoadBalancer().Enabled() {
+		return "https://" + controlPlaneLBAddr(config)
+	}
+
+	return config.Cluster().Endpoint().String()
+}
+
 // PostFunc implements the Service interface.
 func (k *Kubelet) PostFunc(config runtime.Configurator, state events.ServiceState) (err error) {
 	return nil
@@ -136,7 +146,13 @@ func (k *Kubelet) Condition(config runtime.Configurator) conditions.Condition {
 
 // DependsOn implements the Service interface.
 func (k *Kubelet) DependsOn(config runtime.Configurator) []string {
-	return []string{"containerd", "networkd"}
+	deps := []string{"containerd", "networkd"}
+
+	if config.Cluster().LocalLoadBalancer().Enabled() {
+		deps = append(deps, (&ControlPlaneLB{}).ID(config))
+	}
+
+	return deps
 }
 
 // Runner implements the Service interface.
@@ -191,6 +207,8 @@ func (k *Kubelet) Runner(config runtime.Configurator) (runner.Runner, error) {
 		runner.WithNamespace(criconstants.K8sContainerdNamespace),
 		runner.WithContainerImage(config.Machine().Kubelet().Image()),
 		runner.WithEnv(env),
+		runner.WithCgroupPath(runner.CgroupPath(k.ID(config))),
+		runner.WithOOMScoreAdj(-999),
 		runner.WithOCISpecOpts(
 			containerd.WithRootfsPropagation("shared"),
 			oci.WithMounts(mounts),
@@ -316,6 +334,16 @@ func writeKubeletConfig(config runtime.Configurator) error {
 
 	kubeletConfiguration := newKubeletConfiguration(dnsServiceIPs)
 
+	// Swap is always allowed on the node, workloads are only allowed to use
+	// swap if requested (the kubelet version is checked by the config validation).
+	if swap := config.Machine().Swap(); swap.Enabled() && swap.NodeSwap() {
+		if kubeletConfiguration.FeatureGates == nil {
+			kubeletConfiguration.FeatureGates = map[string]bool{}
+		}
+
+		kubeletConfiguration.FeatureGates["NodeSwap"] = true
+	}
+
 	serializer := json.NewSerializerWithOptions(
 		json.DefaultMetaFactory,
 		nil,
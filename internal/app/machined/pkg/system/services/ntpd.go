@@ -91,8 +91,9 @@ func (n *NTPd) Runner(config runtime.Configurator) (runner.Runner, error) {
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithContainerImage(image),
 		runner.WithEnv(env),
+		runner.WithCgroupPath(runner.CgroupPath(n.ID(config))),
+		runner.WithMemoryLimit(int64(1000000*32)),
 		runner.WithOCISpecOpts(
-			containerd.WithMemoryLimit(int64(1000000*32)),
 			oci.WithCapabilities([]string{
 				strings.ToUpper("CAP_" + capability.CAP_SYS_TIME.String()),
 			}),
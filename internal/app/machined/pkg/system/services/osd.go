@@ -108,6 +108,7 @@ func (o *OSD) Runner(config runtime.Configurator) (runner.Runner, error) {
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithContainerImage(image),
 		runner.WithEnv(env),
+		runner.WithCgroupPath(runner.CgroupPath(o.ID(config))),
 		runner.WithOCISpecOpts(
 			oci.WithCapabilities([]string{
 				strings.ToUpper("CAP_" + capability.CAP_SYS_PTRACE.String()),
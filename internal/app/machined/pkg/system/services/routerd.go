@@ -109,6 +109,7 @@ func (o *Routerd) Runner(config runtime.Configurator) (runner.Runner, error) {
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithContainerImage(image),
 		runner.WithEnv(env),
+		runner.WithCgroupPath(runner.CgroupPath(o.ID(config))),
 		runner.WithOCISpecOpts(
 			oci.WithMounts(mounts),
 		),
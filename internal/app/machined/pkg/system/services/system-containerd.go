@@ -72,6 +72,8 @@ func (c *SystemContainerd) Runner(config runtime.Configurator) (runner.Runner, e
 		config.Debug(),
 		args,
 		runner.WithEnv(env),
+		runner.WithCgroupPath(runner.CgroupPath(c.ID(config))),
+		runner.WithOOMScoreAdj(-999),
 	),
 		restart.WithType(restart.Forever),
 	), nil
@@ -73,6 +73,7 @@ func (c *Udevd) Runner(config runtime.Configurator) (runner.Runner, error) {
 		config.Debug(),
 		args,
 		runner.WithEnv(env),
+		runner.WithCgroupPath(runner.CgroupPath(c.ID(config))),
 	),
 		restart.WithType(restart.Forever),
 	), nil
@@ -66,6 +66,7 @@ func (c *UdevdTrigger) Runner(config runtime.Configurator) (runner.Runner, error
 		config.Debug(),
 		args,
 		runner.WithEnv(env),
+		runner.WithCgroupPath(runner.CgroupPath(c.ID(config))),
 	),
 		restart.WithType(restart.Once),
 	), nil
@@ -0,0 +1,224 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	containerdapi "github.com/containerd/containerd"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/oci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/containerd"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/internal/pkg/conditions"
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+	"github.com/talos-systems/talos/internal/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/config/machine"
+	"github.com/talos-systems/talos/pkg/constants"
+)
+
+// UserService implements the Service interface for the user-defined services
+// from the machine configuration (machine.services).
+type UserService struct {
+	Spec machine.Service
+}
+
+// HealthcheckedUserService is a UserService with health check.
+type HealthcheckedUserService struct {
+	UserService
+}
+
+// NewUserService builds the service from the user-defined service spec.
+//
+// Services with health check configured are wrapped to implement
+// HealthcheckedService interface.
+func NewUserService(spec machine.Service) system.Service {
+	if spec.HealthCheck != nil {
+		return &HealthcheckedUserService{UserService{Spec: spec}}
+	}
+
+	return &UserService{Spec: spec}
+}
+
+// ID implements the Service interface.
+func (u *UserService) ID(config runtime.Configurator) string {
+	return u.Spec.Name
+}
+
+// PreFunc implements the Service interface.
+func (u *UserService) PreFunc(ctx context.Context, config runtime.Configurator) error {
+	client, err := containerdapi.New(constants.ContainerdAddress)
+	if err != nil {
+		return err
+	}
+	// nolint: errcheck
+	defer client.Close()
+
+	// Pull the image and unpack it.
+
+	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
+	if _, err = image.Pull(containerdctx, config.Machine().Registries(), client, u.Spec.Image, image.WithPolicy(config.Machine().ImagePolicy())); err != nil {
+		return fmt.Errorf("failed to pull image %q: %w", u.Spec.Image, err)
+	}
+
+	return nil
+}
+
+// PostFunc implements the Service interface.
+func (u *UserService) PostFunc(config runtime.Configurator, state events.ServiceState) (err error) {
+	return nil
+}
+
+// Condition implements the Service interface.
+func (u *UserService) Condition(config runtime.Configurator) conditions.Condition {
+	return nil
+}
+
+// DependsOn implements the Service interface.
+func (u *UserService) DependsOn(config runtime.Configurator) []string {
+	return append([]string{"containerd", "networkd"}, u.Spec.DependsOn...)
+}
+
+// Runner implements the Service interface.
+func (u *UserService) Runner(config runtime.Configurator) (runner.Runner, error) {
+	args := runner.Args{
+		ID:          u.ID(config),
+		ProcessArgs: u.Spec.Args,
+	}
+
+	env := []string{}
+	for key, val := range config.Machine().Env() {
+		env = append(env, fmt.Sprintf("%s=%s", key, val))
+	}
+
+	for key, val := range u.Spec.Env {
+		env = append(env, fmt.Sprintf("%s=%s", key, val))
+	}
+
+	ociSpecOpts := []oci.SpecOpts{
+		oci.WithHostNamespace(specs.NetworkNamespace),
+		oci.WithMounts(u.Spec.Mounts),
+	}
+
+	if len(u.Spec.Capabilities) > 0 {
+		capabilities := make([]string, len(u.Spec.Capabilities))
+
+		for i, capability := range u.Spec.Capabilities {
+			capability = strings.ToUpper(capability)
+			if !strings.HasPrefix(capability, "CAP_") {
+				capability = "CAP_" + capability
+			}
+
+			capabilities[i] = capability
+		}
+
+		ociSpecOpts = append(ociSpecOpts, oci.WithAddedCapabilities(capabilities))
+	}
+
+	restartType := restart.Forever
+
+	switch u.Spec.Restart {
+	case machine.ServiceRestartOnFailure:
+		restartType = restart.UntilSuccess
+	case machine.ServiceRestartNever:
+		restartType = restart.Once
+	}
+
+	return restart.New(containerd.NewRunner(
+		config.Debug(),
+		&args,
+		runner.WithNamespace(constants.SystemContainerdNamespace),
+		runner.WithContainerImage(u.Spec.Image),
+		runner.WithEnv(env),
+		runner.WithCgroupPath(runner.CgroupPath(u.ID(config))),
+		runner.WithOCISpecOpts(ociSpecOpts...),
+	),
+		restart.WithType(restartType),
+	), nil
+}
+
+// APIStartAllowed implements the APIStartableService interface.
+func (u *UserService) APIStartAllowed(config runtime.Configurator) bool {
+	return true
+}
+
+// APIStopAllowed implements the APIStoppableService interface.
+func (u *UserService) APIStopAllowed(config runtime.Configurator) bool {
+	return true
+}
+
+// APIRestartAllowed implements the APIRestartableService interface.
+func (u *UserService) APIRestartAllowed(config runtime.Configurator) bool {
+	return true
+}
+
+// HealthFunc implements the HealthcheckedService interface
+func (u *HealthcheckedUserService) HealthFunc(runtime.Configurator) health.Check {
+	check := u.Spec.HealthCheck
+
+	if check.TCP != "" {
+		return func(ctx context.Context) error {
+			var d net.Dialer
+
+			conn, err := d.DialContext(ctx, "tcp", check.TCP)
+			if err != nil {
+				return err
+			}
+
+			return conn.Close()
+		}
+	}
+
+	return func(ctx context.Context) error {
+		req, err := http.NewRequest("GET", check.HTTP, nil)
+		if err != nil {
+			return err
+		}
+
+		req = req.WithContext(ctx)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		// nolint: errcheck
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("unexpected HTTP status %s", resp.Status)
+		}
+
+		return nil
+	}
+}
+
+// HealthSettings implements the HealthcheckedService interface
+func (u *HealthcheckedUserService) HealthSettings(runtime.Configurator) *health.Settings {
+	settings := health.DefaultSettings
+
+	if u.Spec.HealthCheck.InitialDelay != 0 {
+		settings.InitialDelay = u.Spec.HealthCheck.InitialDelay
+	}
+
+	if u.Spec.HealthCheck.Period != 0 {
+		settings.Period = u.Spec.HealthCheck.Period
+	}
+
+	if u.Spec.HealthCheck.Timeout != 0 {
+		settings.Timeout = u.Spec.HealthCheck.Timeout
+	}
+
+	return &settings
+}
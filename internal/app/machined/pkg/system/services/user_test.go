@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/health"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/services"
+	"github.com/talos-systems/talos/pkg/config/machine"
+)
+
+func TestUserServiceInterfaces(t *testing.T) {
+	assert.Implements(t, (*system.APIStartableService)(nil), new(services.UserService))
+	assert.Implements(t, (*system.APIStoppableService)(nil), new(services.UserService))
+	assert.Implements(t, (*system.APIRestartableService)(nil), new(services.UserService))
+	assert.Implements(t, (*system.HealthcheckedService)(nil), new(services.HealthcheckedUserService))
+
+	_, healthchecked := services.NewUserService(machine.Service{Name: "foo"}).(system.HealthcheckedService)
+	assert.False(t, healthchecked)
+
+	_, healthchecked = services.NewUserService(machine.Service{Name: "foo", HealthCheck: &machine.ServiceHealthCheck{TCP: "127.0.0.1:80"}}).(system.HealthcheckedService)
+	assert.True(t, healthchecked)
+}
+
+func TestUserServiceDependsOn(t *testing.T) {
+	svc := services.NewUserService(machine.Service{Name: "foo", DependsOn: []string{"udevd", "bar"}})
+
+	assert.Equal(t, "foo", svc.ID(nil))
+	assert.Equal(t, []string{"containerd", "networkd", "udevd", "bar"}, svc.DependsOn(nil))
+}
+
+func TestUserServiceHealthSettings(t *testing.T) {
+	svc := services.NewUserService(machine.Service{
+		Name: "foo",
+		HealthCheck: &machine.ServiceHealthCheck{
+			TCP:    "127.0.0.1:80",
+			Period: 30 * time.Second,
+		},
+	}).(system.HealthcheckedService)
+
+	settings := svc.HealthSettings(nil)
+
+	assert.Equal(t, health.DefaultSettings.InitialDelay, settings.InitialDelay)
+	assert.Equal(t, 30*time.Second, settings.Period)
+	assert.Equal(t, health.DefaultSettings.Timeout, settings.Timeout)
+}
+
+func TestUserServiceHealthFuncTCP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := l.Addr().String()
+
+	svc := services.NewUserService(machine.Service{Name: "foo", HealthCheck: &machine.ServiceHealthCheck{TCP: addr}}).(system.HealthcheckedService)
+	check := svc.HealthFunc(nil)
+
+	assert.NoError(t, check(context.Background()))
+
+	require.NoError(t, l.Close())
+
+	assert.Error(t, check(context.Background()))
+}
+
+func TestUserServiceHealthFuncHTTP(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/unhealthy" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	for _, tc := range []struct {
+		url     string
+		healthy bool
+	}{
+		{srv.URL + "/healthz", true},
+		{srv.URL + "/unhealthy", false},
+	} {
+		svc := services.NewUserService(machine.Service{Name: "foo", HealthCheck: &machine.ServiceHealthCheck{HTTP: tc.url}}).(system.HealthcheckedService)
+		err := svc.HealthFunc(nil)(context.Background())
+
+		if tc.healthy {
+			assert.NoError(t, err, tc.url)
+		} else {
+			assert.Error(t, err, tc.url)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+	"google.golang.org/grpc"
+
+	networkapi "github.com/talos-systems/talos/api/network"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/goroutine"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/talos-systems/talos/internal/pkg/conditions"
+	"github.com/talos-systems/talos/internal/pkg/etcd"
+	"github.com/talos-systems/talos/internal/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/constants"
+	"github.com/talos-systems/talos/pkg/grpc/dialer"
+)
+
+const (
+	vipElectionPrefix = "/talos/vip/"
+	vipLeaseTTL       = 10 // seconds
+	vipReleaseTimeout = 10 * time.Second
+)
+
+// VIP implements the Service interface. It runs leader election between
+// control plane nodes for each shared virtual IP in the config and asks
+// networkd to assign the address on the elected node.
+type VIP struct{}
+
+// ID implements the Service interface.
+func (v *VIP) ID(config runtime.Configurator) string {
+	return "vip"
+}
+
+// PreFunc implements the Service interface.
+func (v *VIP) PreFunc(ctx context.Context, config runtime.Configurator) error {
+	return nil
+}
+
+// PostFunc implements the Service interface.
+func (v *VIP) PostFunc(config runtime.Configurator, state events.ServiceState) (err error) {
+	return nil
+}
+
+// Condition implements the Service interface.
+func (v *VIP) Condition(config runtime.Configurator) conditions.Condition {
+	return nil
+}
+
+// DependsOn implements the Service interface.
+func (v *VIP) DependsOn(config runtime.Configurator) []string {
+	return []string{"etcd", "networkd"}
+}
+
+// Runner implements the Service interface.
+func (v *VIP) Runner(config runtime.Configurator) (runner.Runner, error) {
+	return restart.New(goroutine.NewRunner(config, v.ID(config), v.main),
+		restart.WithType(restart.Forever),
+	), nil
+}
+
+// APIRestartAllowed implements the APIRestartableService interface.
+func (v *VIP) APIRestartAllowed(config runtime.Configurator) bool {
+	return true
+}
+
+func (v *VIP) main(ctx context.Context, config runtime.Configurator, logOutput io.Writer) error {
+	logger := log.New(logOutput, "", log.LstdFlags)
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+
+	client, err := etcd.NewClient([]string{"127.0.0.1:" + constants.KubernetesEtcdListenClientPort})
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ips := VIPAddresses(config)
+	if len(ips) == 0 {
+		<-ctx.Done()
+
+		return ctx.Err()
+	}
+
+	errCh := make(chan error, len(ips))
+
+	var wg sync.WaitGroup
+
+	for _, ip := range ips {
+		wg.Add(1)
+
+		go func(ip string) {
+			defer wg.Done()
+
+			errCh <- runVIPElection(ctx, logger, client, hostname, ip)
+		}(ip)
+	}
+
+	// the first campaign to fail stops the others, so that the service gets restarted as a whole
+	err = <-errCh
+
+	cancel()
+	wg.Wait()
+
+	return err
+}
+
+func runVIPElection(ctx context.Context, logger *log.Logger, client *clientv3.Client, hostname, ip string) error {
+	election, err := etcd.NewElection(client, vipElectionPrefix+ip, vipLeaseTTL)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer election.Close()
+
+	return campaignVIP(ctx, logger, election, hostname, ip)
+}
+
+// campaignVIP holds the shared virtual IP for as long as the node is the
+// elected leader, and releases it once leadership is lost or ctx is canceled.
+func campaignVIP(ctx context.Context, logger *log.Logger, election *etcd.Election, hostname, ip string) error {
+	logger.Printf("campaigning for shared virtual IP %s", ip)
+
+	if err := election.Campaign(ctx, hostname); err != nil {
+		return err
+	}
+
+	logger.Printf("elected as the holder of shared virtual IP %s", ip)
+
+	err := vipRequest(ctx, ip, true)
+	if err == nil {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-election.Lost():
+			err = errors.New("etcd lease lost")
+		}
+	}
+
+	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), vipReleaseTimeout)
+	defer releaseCancel()
+
+	logger.Printf("releasing shared virtual IP %s: %s", ip, err)
+
+	// the address should be removed before the leadership is handed over
+	if releaseErr := vipRequest(releaseCtx, ip, false); releaseErr != nil {
+		logger.Printf("failed to release shared virtual IP %s: %s", ip, releaseErr)
+	}
+
+	if resignErr := election.Resign(releaseCtx); resignErr != nil {
+		logger.Printf("failed to resign shared virtual IP %s election: %s", ip, resignErr)
+	}
+
+	return err
+}
+
+// vipRequest asks networkd to acquire or release the shared virtual IP.
+func vipRequest(ctx context.Context, ip string, acquire bool) error {
+	conn, err := grpc.DialContext(
+		ctx,
+		fmt.Sprintf("%s://%s", "unix", constants.NetworkSocketPath),
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(dialer.DialUnix()),
+	)
+	if err != nil {
+		return err
+	}
+
+	// nolint: errcheck
+	defer conn.Close()
+
+	client := networkapi.NewNetworkServiceClient(conn)
+	req := &networkapi.VIPRequest{Ip: ip}
+
+	if acquire {
+		_, err = client.AcquireVIP(ctx, req)
+	} else {
+		_, err = client.ReleaseVIP(ctx, req)
+	}
+
+	return err
+}
+
+// VIPAddresses returns the shared virtual IPs configured on the machine
+// network interfaces.
+func VIPAddresses(config runtime.Configurator) (ips []string) {
+	for _, device := range config.Machine().Network().Devices() {
+		if device.Ignore || device.VIP == nil {
+			continue
+		}
+
+		ips = append(ips, device.VIP.IP)
+	}
+
+	return ips
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
 	"github.com/talos-systems/talos/internal/pkg/conditions"
 	"github.com/talos-systems/talos/internal/pkg/runtime"
 )
@@ -33,8 +34,14 @@ type singleton struct {
 	mu          sync.Mutex
 	wg          sync.WaitGroup
 	terminating bool
+
+	// journal persists service events, if enabled
+	journal *events.Journal
 }
 
+// restartTimeout is the time to wait for the service to stop on restart.
+const restartTimeout = time.Minute
+
 var (
 	instance *singleton
 	once     sync.Once
@@ -77,12 +84,36 @@ func (s *singleton) Load(services ...Service) []string {
 		}
 
 		svcrunner := NewServiceRunner(service, s.Config)
+
+		if s.journal != nil {
+			svcrunner.setJournal(s.journal)
+		}
+
 		s.state[id] = svcrunner
 	}
 
 	return ids
 }
 
+// EnableJournal enables persisting service events to the directory.
+//
+// Journal should be enabled once the directory is available (e.g. /var is mounted),
+// events recorded before that are persisted when journal is enabled.
+func (s *singleton) EnableJournal(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.journal != nil {
+		return
+	}
+
+	s.journal = events.NewJournal(path)
+
+	for _, svcrunner := range s.state {
+		svcrunner.setJournal(s.journal)
+	}
+}
+
 // Start will invoke the service's Pre, Condition, and Type funcs. If the any
 // error occurs in the Pre or Condition invocations, it is up to the caller to
 // to restart the service.
@@ -214,6 +245,9 @@ func (s *singleton) Shutdown() {
 }
 
 // List returns snapshot of ServiceRunner instances
+//
+// Services are sorted topologically, so that dependencies come before
+// the services which depend on them.
 func (s *singleton) List() (result []*ServiceRunner) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -223,11 +257,75 @@ func (s *singleton) List() (result []*ServiceRunner) {
 		result = append(result, svcrunner)
 	}
 
-	// TODO: results should be sorted properly with topological sort on dependencies
-	//       but, we don't have dependencies yet, so sort by service id for now to get stable order
+	// sort by service id first to get stable order
 	sort.Slice(result, func(i, j int) bool { return result[i].id < result[j].id })
 
-	return
+	return sortByDependencies(s.Config, result)
+}
+
+// sortByDependencies sorts service runners topologically on dependencies.
+//
+// Services which are ready to be listed are picked in the original order.
+// Dependencies on services which are not loaded are ignored, services with
+// cyclic dependencies are appended at the end.
+func sortByDependencies(config runtime.Configurator, runners []*ServiceRunner) []*ServiceRunner {
+	loaded := make(map[string]struct{}, len(runners))
+
+	for _, svcrunner := range runners {
+		loaded[svcrunner.id] = struct{}{}
+	}
+
+	// number of dependencies not listed yet
+	pending := make(map[string]int, len(runners))
+	reverseDependencies := make(map[string][]string)
+
+	for _, svcrunner := range runners {
+		for _, dependency := range svcrunner.service.DependsOn(config) {
+			if _, ok := loaded[dependency]; !ok {
+				continue
+			}
+
+			pending[svcrunner.id]++
+			reverseDependencies[dependency] = append(reverseDependencies[dependency], svcrunner.id)
+		}
+	}
+
+	result := make([]*ServiceRunner, 0, len(runners))
+	listed := make(map[string]struct{}, len(runners))
+
+	for len(result) < len(runners) {
+		var next *ServiceRunner
+
+		for _, svcrunner := range runners {
+			if _, ok := listed[svcrunner.id]; ok || pending[svcrunner.id] > 0 {
+				continue
+			}
+
+			next = svcrunner
+
+			break
+		}
+
+		if next == nil {
+			// dependency cycle, list the rest as is
+			for _, svcrunner := range runners {
+				if _, ok := listed[svcrunner.id]; !ok {
+					result = append(result, svcrunner)
+				}
+			}
+
+			break
+		}
+
+		result = append(result, next)
+		listed[next.id] = struct{}{}
+
+		for _, dependent := range reverseDependencies[next.id] {
+			pending[dependent]--
+		}
+	}
+
+	return result
 }
 
 // Stop will initiate a shutdown of the specified service.
@@ -343,8 +441,49 @@ func (s *singleton) APIRestart(ctx context.Context, id string) error {
 			return err
 		}
 
+		if err := s.waitStopped(ctx, id); err != nil {
+			return err
+		}
+
 		return s.Start(id)
 	}
 
 	return fmt.Errorf("service %q doesn't support restart operation via API", id)
 }
+
+// restart stops and starts the service again.
+//
+// It is used to restart services failing liveness checks.
+func (s *singleton) restart(id string) error {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), restartTimeout)
+	defer ctxCancel()
+
+	if err := s.Stop(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.waitStopped(ctx, id); err != nil {
+		return err
+	}
+
+	return s.Start(id)
+}
+
+// waitStopped waits for the service runner to finish after Stop(),
+// as Start() skips services which are still running.
+func (s *singleton) waitStopped(ctx context.Context, id string) error {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		if _, running, err := s.IsRunning(id); err != nil || !running {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
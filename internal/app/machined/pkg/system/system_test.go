@@ -28,6 +28,24 @@ func (suite *SystemServicesSuite) TestStartShutdown() {
 	system.Services(nil).Shutdown()
 }
 
+func (suite *SystemServicesSuite) TestList() {
+	system.Services(nil).LoadAndStart(
+		&MockService{name: "apid", dependencies: []string{"cri"}},
+		&MockService{name: "cri", dependencies: []string{"networkd"}},
+		&MockService{name: "networkd", dependencies: []string{"udevd"}},
+		&MockService{name: "udevd"},
+		&MockService{name: "zz", dependencies: []string{"notloaded"}},
+	)
+
+	ids := []string{}
+
+	for _, svcrunner := range system.Services(nil).List() {
+		ids = append(ids, svcrunner.AsProto().Id)
+	}
+
+	suite.Assert().Equal([]string{"udevd", "networkd", "cri", "apid", "zz"}, ids)
+}
+
 func TestSystemServicesSuite(t *testing.T) {
 	suite.Run(t, new(SystemServicesSuite))
 }
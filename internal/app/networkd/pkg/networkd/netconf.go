@@ -48,6 +48,10 @@ func buildOptions(device machine.Device, hostname string) (name string, opts []n
 		opts = append(opts, nic.WithAddressing(d))
 	}
 
+	if device.VIP != nil {
+		opts = append(opts, nic.WithVIP(device.VIP.IP))
+	}
+
 	// Configure Bonding
 	if device.Bond == nil {
 		return device.Interface, opts, err
@@ -236,6 +236,46 @@ func (n *Networkd) Reset() {
 	}
 }
 
+// AcquireVIP assigns the shared virtual IP to the interface it is configured
+// on.
+func (n *Networkd) AcquireVIP(ip net.IP) error {
+	netif, err := n.vipInterface(ip)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("acquiring shared virtual IP %s on %s", ip, netif.Name)
+
+	return netif.AcquireVIP()
+}
+
+// ReleaseVIP removes the shared virtual IP from the interface it is
+// configured on.
+func (n *Networkd) ReleaseVIP(ip net.IP) error {
+	netif, err := n.vipInterface(ip)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("releasing shared virtual IP %s on %s", ip, netif.Name)
+
+	return netif.ReleaseVIP()
+}
+
+func (n *Networkd) vipInterface(ip net.IP) (*nic.NetworkInterface, error) {
+	for _, netif := range n.Interfaces {
+		if netif.IsIgnored() || netif.VIP == nil {
+			continue
+		}
+
+		if netif.VIP.Equal(ip) {
+			return netif, nil
+		}
+	}
+
+	return nil, fmt.Errorf("shared virtual IP %s is not configured on any interface", ip)
+}
+
 // Hostname returns the first hostname found from the addressing methods.
 // Create /etc/hosts and set hostname.
 // Priority is:
@@ -37,6 +37,13 @@ func (suite *NetworkdSuite) TestNetworkd() {
 	suite.Assert().True(nwd.Interfaces["bond0"].Bonded)
 	suite.Assert().Equal(1, len(nwd.Interfaces["bond0"].SubInterfaces))
 	suite.Require().Contains(nwd.Interfaces, "lo")
+
+	netif, err := nwd.vipInterface(net.ParseIP("192.168.0.100"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("eth0", netif.Name)
+
+	_, err = nwd.vipInterface(net.ParseIP("192.168.0.101"))
+	suite.Require().Error(err)
 }
 
 func (suite *NetworkdSuite) TestHostname() {
@@ -176,6 +183,9 @@ func sampleConfigFile() runtime.Configurator {
 						Interface: "eth0",
 						CIDR:      "192.168.0.10/24",
 						MTU:       9100,
+						VIP: &machine.DeviceVIPConfig{
+							IP: "192.168.0.100",
+						},
 					},
 					{
 						Interface: "bond0",
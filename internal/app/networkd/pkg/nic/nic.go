@@ -49,6 +49,7 @@ type NetworkInterface struct {
 	SubInterfaces []*net.Interface
 	AddressMethod []address.Addressing
 	BondSettings  *netlink.AttributeEncoder
+	VIP           net.IP
 
 	rtConn   *rtnetlink.Conn
 	rtnlConn *rtnl.Conn
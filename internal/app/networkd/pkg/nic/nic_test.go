@@ -5,6 +5,7 @@
 package nic
 
 import (
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -105,3 +106,22 @@ func (suite *NicSuite) TestBond() {
 		suite.Assert().True(mynic.Bonded)
 	}
 }
+
+func (suite *NicSuite) TestVIP() {
+	mynic, err := New(WithName("yolo"), WithVIP("192.168.1.100"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("192.168.1.100", mynic.VIP.String())
+
+	_, err = New(WithName("yolo"), WithVIP("192.168.1"))
+	suite.Require().Error(err)
+}
+
+func (suite *NicSuite) TestGratuitousARP() {
+	hw := net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01}
+
+	suite.Assert().Equal([]byte{
+		0x00, 0x01, 0x08, 0x00, 0x06, 0x04, 0x00, 0x01,
+		0x02, 0x00, 0x00, 0x00, 0x00, 0x01, 192, 168, 1, 100,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 192, 168, 1, 100,
+	}, gratuitousARP(hw, net.ParseIP("192.168.1.100")))
+}
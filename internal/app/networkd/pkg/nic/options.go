@@ -5,6 +5,9 @@
 package nic
 
 import (
+	"fmt"
+	"net"
+
 	"github.com/mdlayher/netlink"
 
 	"github.com/talos-systems/talos/internal/app/networkd/pkg/address"
@@ -39,6 +42,20 @@ func WithName(o string) Option {
 	}
 }
 
+// WithVIP sets the shared virtual IP which may be acquired on the interface.
+func WithVIP(o string) Option {
+	return func(n *NetworkInterface) (err error) {
+		ip := net.ParseIP(o)
+		if ip == nil {
+			return fmt.Errorf("invalid shared virtual IP %q", o)
+		}
+
+		n.VIP = ip
+
+		return err
+	}
+}
+
 // WithAddressing defines how the addressing for a given interface
 // should be configured
 func WithAddressing(a address.Addressing) Option {
@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nic
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+
+	"github.com/mdlayher/ethernet"
+	"github.com/mdlayher/netlink"
+	"github.com/mdlayher/raw"
+)
+
+// ErrNoVIP indicates that no shared virtual IP is configured on the interface.
+var ErrNoVIP = errors.New("no shared virtual IP configured")
+
+// AcquireVIP assigns the shared virtual IP to the interface and announces
+// the takeover to the rest of the segment via gratuitous ARP.
+func (n *NetworkInterface) AcquireVIP() (err error) {
+	if n.VIP == nil {
+		return ErrNoVIP
+	}
+
+	var link *net.Interface
+
+	if link, err = net.InterfaceByName(n.Name); err != nil {
+		return err
+	}
+
+	if err = n.rtnlConn.AddrAdd(link, vipNet(n.VIP)); err != nil {
+		var opErr *netlink.OpError
+		if !errors.As(err, &opErr) || !os.IsExist(opErr.Err) {
+			return fmt.Errorf("failed to add shared virtual IP %s to %s: %w", n.VIP, n.Name, err)
+		}
+	}
+
+	// Neighbor advertisement for IPv6 addresses is left to the kernel.
+	if n.VIP.To4() == nil {
+		return nil
+	}
+
+	return sendGratuitousARP(link, n.VIP)
+}
+
+// ReleaseVIP removes the shared virtual IP from the interface.
+func (n *NetworkInterface) ReleaseVIP() (err error) {
+	if n.VIP == nil {
+		return ErrNoVIP
+	}
+
+	var link *net.Interface
+
+	if link, err = net.InterfaceByName(n.Name); err != nil {
+		return err
+	}
+
+	if err = n.rtnlConn.AddrDel(link, vipNet(n.VIP)); err != nil {
+		var opErr *netlink.OpError
+		if !errors.As(err, &opErr) || opErr.Err != syscall.EADDRNOTAVAIL {
+			return fmt.Errorf("failed to remove shared virtual IP %s from %s: %w", n.VIP, n.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// vipNet returns the host network for the shared virtual IP.
+func vipNet(ip net.IP) *net.IPNet {
+	if ip.To4() != nil {
+		return &net.IPNet{IP: ip.To4(), Mask: net.CIDRMask(32, 32)}
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
+
+func sendGratuitousARP(link *net.Interface, ip net.IP) (err error) {
+	var conn *raw.Conn
+
+	if conn, err = raw.ListenPacket(link, uint16(ethernet.EtherTypeARP), nil); err != nil {
+		return fmt.Errorf("failed to open raw socket on %s: %w", link.Name, err)
+	}
+
+	// nolint: errcheck
+	defer conn.Close()
+
+	frame := &ethernet.Frame{
+		Destination: ethernet.Broadcast,
+		Source:      link.HardwareAddr,
+		EtherType:   ethernet.EtherTypeARP,
+		Payload:     gratuitousARP(link.HardwareAddr, ip),
+	}
+
+	var b []byte
+
+	if b, err = frame.MarshalBinary(); err != nil {
+		return err
+	}
+
+	if _, err = conn.WriteTo(b, &raw.Addr{HardwareAddr: ethernet.Broadcast}); err != nil {
+		return fmt.Errorf("failed to send gratuitous ARP on %s: %w", link.Name, err)
+	}
+
+	return nil
+}
+
+// gratuitousARP builds an ARP request payload announcing that ip is
+// reachable at hw.
+//
+// ref: https://tools.ietf.org/html/rfc5227#section-3
+func gratuitousARP(hw net.HardwareAddr, ip net.IP) []byte {
+	b := make([]byte, 8+2*len(hw)+2*net.IPv4len)
+
+	binary.BigEndian.PutUint16(b[0:2], 1)                              // hardware type: ethernet
+	binary.BigEndian.PutUint16(b[2:4], uint16(ethernet.EtherTypeIPv4)) // protocol type
+	b[4] = byte(len(hw))
+	b[5] = net.IPv4len
+	binary.BigEndian.PutUint16(b[6:8], 1) // operation: request
+
+	offset := 8
+
+	for _, field := range [][]byte{hw, ip.To4(), make([]byte, len(hw)), ip.To4()} {
+		offset += copy(b[offset:], field)
+	}
+
+	return b
+}
@@ -130,6 +130,44 @@ func (r *Registrator) Interfaces(ctx context.Context, in *empty.Empty) (reply *n
 	}, nil
 }
 
+// AcquireVIP assigns the requested shared virtual IP to the interface it is
+// configured on.
+func (r *Registrator) AcquireVIP(ctx context.Context, in *networkapi.VIPRequest) (reply *networkapi.VIPResponse, err error) {
+	ip := net.ParseIP(in.Ip)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid shared virtual IP %q", in.Ip)
+	}
+
+	if err = r.Networkd.AcquireVIP(ip); err != nil {
+		return nil, err
+	}
+
+	return &networkapi.VIPResponse{
+		Messages: []*networkapi.VIP{
+			{},
+		},
+	}, nil
+}
+
+// ReleaseVIP removes the requested shared virtual IP from the interface it is
+// configured on.
+func (r *Registrator) ReleaseVIP(ctx context.Context, in *networkapi.VIPRequest) (reply *networkapi.VIPResponse, err error) {
+	ip := net.ParseIP(in.Ip)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid shared virtual IP %q", in.Ip)
+	}
+
+	if err = r.Networkd.ReleaseVIP(ip); err != nil {
+		return nil, err
+	}
+
+	return &networkapi.VIPResponse{
+		Messages: []*networkapi.VIP{
+			{},
+		},
+	}, nil
+}
+
 func toCIDR(family uint8, prefix net.IP, prefixLen int) string {
 	netLen := 32
 
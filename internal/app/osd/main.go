@@ -7,7 +7,7 @@ package main
 import (
 	"log"
 
-	"github.com/talos-systems/talos/internal/app/osd/internal/reg"
+	"github.com/talos-systems/talos/internal/app/osd/pkg/reg"
 	"github.com/talos-systems/talos/pkg/constants"
 	"github.com/talos-systems/talos/pkg/grpc/factory"
 	"github.com/talos-systems/talos/pkg/startup"
@@ -23,7 +23,7 @@ func main() {
 	}
 
 	log.Fatalf("%+v", factory.ListenAndServe(
-		&reg.Registrator{},
+		reg.NewRegistrator(),
 		factory.Network("unix"),
 		factory.SocketPath(constants.OSSocketPath),
 		factory.WithDefaultLog(),
@@ -8,12 +8,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	criconstants "github.com/containerd/cri/pkg/constants"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/prometheus/procfs"
 	"google.golang.org/grpc"
@@ -25,11 +28,40 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/containers/cri"
 	"github.com/talos-systems/talos/internal/pkg/kmsg"
 	"github.com/talos-systems/talos/pkg/constants"
+	"github.com/talos-systems/talos/pkg/swap"
 )
 
+// ContainerInspectorFunc builds the container inspector for the namespace and driver.
+type ContainerInspectorFunc func(ctx context.Context, namespace string, driver common.ContainerDriver) (containers.Inspector, error)
+
 // Registrator is the concrete type that implements the factory.Registrator and
 // osapi.OSDServer interfaces.
-type Registrator struct{}
+type Registrator struct {
+	inspector ContainerInspectorFunc
+}
+
+// Option is the functional option func.
+type Option func(*Registrator)
+
+// WithContainerInspector overrides the container inspector.
+func WithContainerInspector(o ContainerInspectorFunc) Option {
+	return func(r *Registrator) {
+		r.inspector = o
+	}
+}
+
+// NewRegistrator builds new Registrator instance.
+func NewRegistrator(setters ...Option) *Registrator {
+	r := &Registrator{
+		inspector: getContainerInspector,
+	}
+
+	for _, setter := range setters {
+		setter(r)
+	}
+
+	return r
+}
 
 // Register implements the factory.Registrator interface.
 func (r *Registrator) Register(s *grpc.Server) {
@@ -38,7 +70,7 @@ func (r *Registrator) Register(s *grpc.Server) {
 
 // Containers implements the osapi.OSDServer interface.
 func (r *Registrator) Containers(ctx context.Context, in *osapi.ContainersRequest) (reply *osapi.ContainersResponse, err error) {
-	inspector, err := getContainerInspector(ctx, in.Namespace, in.Driver)
+	inspector, err := r.inspector(ctx, in.Namespace, in.Driver)
 	if err != nil {
 		return nil, err
 	}
@@ -84,60 +116,79 @@ func (r *Registrator) Containers(ctx context.Context, in *osapi.ContainersReques
 }
 
 // Stats implements the osapi.OSDServer interface.
-// nolint: gocyclo
 func (r *Registrator) Stats(ctx context.Context, in *osapi.StatsRequest) (reply *osapi.StatsResponse, err error) {
-	inspector, err := getContainerInspector(ctx, in.Namespace, in.Driver)
+	inspector, err := r.inspector(ctx, in.Namespace, in.Driver)
 	if err != nil {
 		return nil, err
 	}
 	// nolint: errcheck
 	defer inspector.Close()
 
-	pods, err := inspector.Pods()
+	stats, err := collectStats(inspector, in.Namespace)
 	if err != nil {
-		// fatal error
-		if pods == nil {
-			return nil, err
-		}
-		// TODO: only some failed, need to handle it better via client
-		log.Println(err.Error())
+		return nil, err
 	}
 
-	stats := []*osapi.Stat{}
+	reply = &osapi.StatsResponse{
+		Messages: []*osapi.Stats{
+			stats,
+		},
+	}
 
-	for _, pod := range pods {
-		for _, container := range pod.Containers {
-			if container.Metrics == nil {
-				continue
-			}
+	return reply, nil
+}
 
-			stat := &osapi.Stat{
-				Namespace:   in.Namespace,
-				Id:          container.Display,
-				PodId:       pod.Name,
-				Name:        container.Name,
-				MemoryUsage: container.Metrics.MemoryUsage,
-				CpuUsage:    container.Metrics.CPUUsage,
-			}
+// StatsWatch implements the osapi.OSDServer interface.
+//
+// Stats are sampled at the requested interval until the client cancels the stream.
+func (r *Registrator) StatsWatch(in *osapi.StatsWatchRequest, srv osapi.OSService_StatsWatchServer) error {
+	interval := defaultStatsInterval
 
-			stats = append(stats, stat)
+	if in.Interval != nil {
+		var err error
+
+		if interval, err = ptypes.Duration(in.Interval); err != nil {
+			return err
+		}
+
+		if interval < minStatsInterval {
+			return fmt.Errorf("stats interval should be at least %s", minStatsInterval)
 		}
 	}
 
-	reply = &osapi.StatsResponse{
-		Messages: []*osapi.Stats{
-			{
-				Stats: stats,
-			},
-		},
+	inspector, err := r.inspector(srv.Context(), in.Namespace, in.Driver)
+	if err != nil {
+		return err
 	}
+	// nolint: errcheck
+	defer inspector.Close()
 
-	return reply, nil
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		var stats *osapi.Stats
+
+		stats, err = collectStats(inspector, in.Namespace)
+		if err != nil {
+			return err
+		}
+
+		if err = srv.Send(stats); err != nil {
+			return err
+		}
+
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
 }
 
 // Restart implements the osapi.OSDServer interface.
 func (r *Registrator) Restart(ctx context.Context, in *osapi.RestartRequest) (*osapi.RestartResponse, error) {
-	inspector, err := getContainerInspector(ctx, in.Namespace, in.Driver)
+	inspector, err := r.inspector(ctx, in.Namespace, in.Driver)
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +267,157 @@ func (r *Registrator) Dmesg(req *osapi.DmesgRequest, srv osapi.OSService_DmesgSe
 	}
 }
 
+// Exec implements the osapi.OSDServer interface.
+//
+//nolint: gocyclo
+func (r *Registrator) Exec(srv osapi.OSService_ExecServer) error {
+	ctx := srv.Context()
+
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+
+	start := req.Start
+	if start == nil {
+		return errors.New("exec stream should start with the process description")
+	}
+
+	if len(start.Args) == 0 {
+		return errors.New("command to run should be specified")
+	}
+
+	inspector, err := r.inspector(ctx, start.Namespace, start.Driver)
+	if err != nil {
+		return err
+	}
+	// nolint: errcheck
+	defer inspector.Close()
+
+	container, err := inspector.Container(start.Id)
+	if err != nil {
+		return err
+	}
+
+	if container == nil {
+		return fmt.Errorf("container %q not found", start.Id)
+	}
+
+	stream := &execStream{srv: srv}
+
+	stdinR, stdinW := io.Pipe()
+	// unblocks stdin writes if the process exits without reading stdin
+	// nolint: errcheck
+	defer stdinR.Close()
+
+	process, err := container.Exec(containers.ExecOptions{
+		Args:   start.Args,
+		Env:    start.Env,
+		TTY:    start.Tty,
+		Stdin:  stdinR,
+		Stdout: stream.writer(false),
+		Stderr: stream.writer(true),
+	})
+	if err != nil {
+		return err
+	}
+	// nolint: errcheck
+	defer process.Close()
+
+	if start.Tty && start.Size != nil {
+		if err = process.Resize(start.Size.Width, start.Size.Height); err != nil {
+			log.Printf("failed to resize exec terminal: %s", err)
+		}
+	}
+
+	var closeStdinOnce sync.Once
+
+	closeStdin := func() {
+		closeStdinOnce.Do(func() {
+			// nolint: errcheck
+			stdinW.Close()
+
+			if e := process.CloseStdin(); e != nil {
+				log.Printf("failed to close exec stdin: %s", e)
+			}
+		})
+	}
+
+	go func() {
+		defer closeStdin()
+
+		for {
+			msg, e := srv.Recv()
+			if e != nil {
+				return
+			}
+
+			if len(msg.Stdin) > 0 {
+				if _, e = stdinW.Write(msg.Stdin); e != nil {
+					return
+				}
+			}
+
+			if msg.Resize != nil {
+				if e = process.Resize(msg.Resize.Width, msg.Resize.Height); e != nil {
+					log.Printf("failed to resize exec terminal: %s", e)
+				}
+			}
+
+			if msg.CloseStdin {
+				closeStdin()
+			}
+		}
+	}()
+
+	exitCode, err := process.Wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	return stream.send(&osapi.ExecResponse{
+		Exited:   true,
+		ExitCode: exitCode,
+	})
+}
+
+// execStream serializes sending exec output to the client.
+type execStream struct {
+	mu  sync.Mutex
+	srv osapi.OSService_ExecServer
+}
+
+func (s *execStream) send(resp *osapi.ExecResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.srv.Send(resp)
+}
+
+func (s *execStream) writer(stderr bool) io.Writer {
+	return execWriterFunc(func(p []byte) (int, error) {
+		resp := &osapi.ExecResponse{}
+
+		if stderr {
+			resp.Stderr = p
+		} else {
+			resp.Stdout = p
+		}
+
+		if err := s.send(resp); err != nil {
+			return 0, err
+		}
+
+		return len(p), nil
+	})
+}
+
+type execWriterFunc func(p []byte) (int, error)
+
+func (f execWriterFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
 // Processes implements the osapi.OSDServer interface
 func (r *Registrator) Processes(ctx context.Context, in *empty.Empty) (reply *osapi.ProcessesResponse, err error) {
 	procs, err := procfs.AllProcs()
@@ -363,13 +565,67 @@ func (r *Registrator) Memory(ctx context.Context, in *empty.Empty) (reply *osapi
 		Directmap1G:       info.DirectMap1G,
 	}
 
+	swaps, err := swapAreas()
+	if err != nil {
+		return nil, err
+	}
+
 	reply = &osapi.MemoryResponse{
 		Messages: []*osapi.Memory{
 			{
 				Meminfo: meminfo,
+				Swaps:   swaps,
 			},
 		},
 	}
 
 	return reply, err
 }
+
+func swapAreas() (swaps []*osapi.SwapArea, err error) {
+	areas, err := swap.Areas()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, area := range areas {
+		s := &osapi.SwapArea{
+			Filename: area.Filename,
+			Type:     area.Type,
+			Size:     area.Size,
+			Used:     area.Used,
+			Priority: int32(area.Priority),
+		}
+
+		// zram stats are best-effort, the swap area is reported without them
+		if swap.IsZram(area.Filename) {
+			if stats, zramErr := zramStats(area.Filename); zramErr != nil {
+				log.Printf("failed to read zram stats of %q: %s", area.Filename, zramErr)
+			} else {
+				s.Zram = stats
+			}
+		}
+
+		swaps = append(swaps, s)
+	}
+
+	return swaps, nil
+}
+
+func zramStats(path string) (*osapi.ZramStats, error) {
+	z, err := swap.ZramFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := z.Stats()
+	if err != nil {
+		return nil, err
+	}
+
+	return &osapi.ZramStats{
+		Algorithm:     stats.Algorithm,
+		OrigDataSize:  stats.OrigDataSize,
+		ComprDataSize: stats.ComprDataSize,
+	}, nil
+}
@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package reg
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/prometheus/procfs"
+
+	osapi "github.com/talos-systems/talos/api/os"
+	"github.com/talos-systems/talos/internal/pkg/containers"
+)
+
+const (
+	defaultStatsInterval = time.Second
+	minStatsInterval     = 100 * time.Millisecond
+)
+
+// collectStats samples the stats of the containers in the namespace.
+func collectStats(inspector containers.Inspector, namespace string) (*osapi.Stats, error) {
+	pods, err := inspector.Pods()
+	if err != nil {
+		// fatal error
+		if pods == nil {
+			return nil, err
+		}
+		// TODO: only some failed, need to handle it better via client
+		log.Println(err.Error())
+	}
+
+	stats := &osapi.Stats{
+		Stats:     []*osapi.Stat{},
+		Timestamp: ptypes.TimestampNow(),
+	}
+
+	netns := newNetworkNamespaces()
+
+	for _, pod := range pods {
+		for _, container := range pod.Containers {
+			if container.Metrics == nil {
+				continue
+			}
+
+			stat := &osapi.Stat{
+				Namespace:    namespace,
+				Id:           container.Display,
+				PodId:        pod.Name,
+				Name:         container.Name,
+				MemoryUsage:  container.Metrics.MemoryUsage,
+				CpuUsage:     container.Metrics.CPUUsage,
+				IoReadBytes:  container.Metrics.IOReadBytes,
+				IoWriteBytes: container.Metrics.IOWriteBytes,
+			}
+
+			if rx, tx, ok := netns.Stats(container.Pid); ok {
+				stat.NetworkRxBytes = rx
+				stat.NetworkTxBytes = tx
+			}
+
+			stats.Stats = append(stats.Stats, stat)
+		}
+	}
+
+	return stats, nil
+}
+
+// networkNamespaces reads the network counters of the container network namespaces.
+//
+// Containers of the pod share the network namespace, so the counters are reported
+// only for the first container of the namespace (pod sandbox for CRI). Host network
+// namespace is never reported.
+type networkNamespaces struct {
+	seen map[uint64]struct{}
+}
+
+func newNetworkNamespaces() *networkNamespaces {
+	n := &networkNamespaces{
+		seen: map[uint64]struct{}{},
+	}
+
+	if ino, err := netnsInode(1); err == nil {
+		n.seen[ino] = struct{}{}
+	}
+
+	return n
+}
+
+// Stats returns the received and transmitted bytes of the process network namespace.
+func (n *networkNamespaces) Stats(pid uint32) (rx, tx uint64, ok bool) {
+	if pid == 0 {
+		return 0, 0, false
+	}
+
+	ino, err := netnsInode(pid)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	if _, seen := n.seen[ino]; seen {
+		return 0, 0, false
+	}
+
+	n.seen[ino] = struct{}{}
+
+	proc, err := procfs.NewProc(int(pid))
+	if err != nil {
+		return 0, 0, false
+	}
+
+	netDev, err := proc.NetDev()
+	if err != nil {
+		return 0, 0, false
+	}
+
+	for name, line := range netDev {
+		if name == "lo" {
+			continue
+		}
+
+		rx += line.RxBytes
+		tx += line.TxBytes
+	}
+
+	return rx, tx, true
+}
+
+func netnsInode(pid uint32) (uint64, error) {
+	st, err := os.Stat(fmt.Sprintf("/proc/%d/ns/net", pid))
+	if err != nil {
+		return 0, err
+	}
+
+	sys, ok := st.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, errors.New("unexpected stat type")
+	}
+
+	return sys.Ino, nil
+}
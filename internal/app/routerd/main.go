@@ -30,6 +30,7 @@ func main() {
 	// TODO: this should be dynamic based on plugin registration
 	router.RegisterLocalBackend("os.OSService", backend.NewLocal("osd", constants.OSSocketPath))
 	router.RegisterLocalBackend("machine.MachineService", backend.NewLocal("machined", constants.MachineSocketPath))
+	router.RegisterLocalBackend("image.ImageService", backend.NewLocal("machined", constants.MachineSocketPath))
 	router.RegisterLocalBackend("time.TimeService", backend.NewLocal("timed", constants.TimeSocketPath))
 	router.RegisterLocalBackend("network.NetworkService", backend.NewLocal("networkd", constants.NetworkSocketPath))
 
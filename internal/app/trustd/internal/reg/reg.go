@@ -15,6 +15,7 @@ import (
 
 	securityapi "github.com/talos-systems/talos/api/security"
 	"github.com/talos-systems/talos/internal/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/constants"
 	"github.com/talos-systems/talos/pkg/crypto/x509"
 )
 
@@ -33,7 +34,10 @@ func (r *Registrator) Register(s *grpc.Server) {
 func (r *Registrator) Certificate(ctx context.Context, in *securityapi.CertificateRequest) (resp *securityapi.CertificateResponse, err error) {
 	// TODO: Verify that the request is coming from the IP addresss declared in
 	// the CSR.
-	signed, err := x509.NewCertificateFromCSRBytes(r.Config.Machine().Security().CA().Crt, r.Config.Machine().Security().CA().Key, in.Csr)
+	//
+	// The organization carries the role of the certificate, so it is never
+	// taken from the CSR: any machine token holder gets the node role only.
+	signed, err := x509.NewCertificateFromCSRBytes(r.Config.Machine().Security().CA().Crt, r.Config.Machine().Security().CA().Key, in.Csr, x509.Organization(constants.NodeRole))
 	if err != nil {
 		return
 	}
@@ -4,11 +4,66 @@
 
 package reg_test
 
-import "testing"
+import (
+	"context"
+	stdlibx509 "crypto/x509"
+	"encoding/pem"
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/suite"
+
+	securityapi "github.com/talos-systems/talos/api/security"
+	"github.com/talos-systems/talos/internal/app/trustd/internal/reg"
+	"github.com/talos-systems/talos/pkg/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/constants"
+	"github.com/talos-systems/talos/pkg/crypto/x509"
+)
+
+type RegistratorSuite struct {
+	suite.Suite
+}
+
+func TestRegistratorSuite(t *testing.T) {
+	suite.Run(t, new(RegistratorSuite))
+}
+
+func (suite *RegistratorSuite) TestCertificateRole() {
+	ca, err := x509.NewSelfSignedCertificateAuthority()
+	suite.Require().NoError(err)
+
+	r := &reg.Registrator{
+		Config: &v1alpha1.Config{
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineCA: &x509.PEMEncodedCertificateAndKey{
+					Crt: ca.CrtPEM,
+					Key: ca.KeyPEM,
+				},
+			},
+		},
+	}
+
+	key, err := x509.NewEd25519Key()
+	suite.Require().NoError(err)
+
+	for _, organization := range []string{constants.AdminRole, constants.NodeRole, ""} {
+		var (
+			csr  *x509.CertificateSigningRequest
+			resp *securityapi.CertificateResponse
+			crt  *stdlibx509.Certificate
+		)
+
+		csr, err = x509.NewCertificateSigningRequest(key.PrivateKey, x509.Organization(organization))
+		suite.Require().NoError(err)
+
+		resp, err = r.Certificate(context.Background(), &securityapi.CertificateRequest{Csr: csr.X509CertificateRequestPEM})
+		suite.Require().NoError(err)
+
+		block, _ := pem.Decode(resp.Crt)
+		suite.Require().NotNil(block)
+
+		crt, err = stdlibx509.ParseCertificate(block.Bytes)
+		suite.Require().NoError(err)
+
+		suite.Assert().Equal([]string{constants.NodeRole}, crt.Subject.Organization)
+	}
 }
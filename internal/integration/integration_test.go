@@ -8,8 +8,10 @@
 package integration_test
 
 import (
+	"bytes"
 	"context"
 	"flag"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
@@ -22,8 +24,10 @@ import (
 	"github.com/talos-systems/talos/internal/integration/cli"
 	"github.com/talos-systems/talos/internal/integration/k8s"
 	provision_test "github.com/talos-systems/talos/internal/integration/provision"
+	"github.com/talos-systems/talos/internal/pkg/fakenode"
 	"github.com/talos-systems/talos/internal/pkg/provision"
 	"github.com/talos-systems/talos/internal/pkg/provision/providers"
+	"github.com/talos-systems/talos/internal/pkg/support"
 	"github.com/talos-systems/talos/pkg/version"
 )
 
@@ -41,9 +45,24 @@ var (
 	provisionerName string
 	clusterName     string
 	stateDir        string
+	crashdumpPath   string
+	fakeNode        bool
 )
 
 func TestIntegration(t *testing.T) {
+	if fakeNode {
+		// run the tests against in-process fake node
+		node, err := fakenode.New()
+		if err != nil {
+			t.Fatal("error starting fake node", err)
+		}
+
+		defer node.Close() //nolint: errcheck
+
+		talosConfig = node.TalosconfigPath()
+		endpoint = node.Endpoint()
+	}
+
 	if talosConfig == "" {
 		t.Error("--talos.config is not provided")
 	}
@@ -72,6 +91,7 @@ func TestIntegration(t *testing.T) {
 	}
 
 	provision_test.DefaultSettings.CurrentVersion = expectedVersion
+	provision_test.DefaultSettings.CrashdumpPath = crashdumpPath
 
 	for _, s := range allSuites {
 		if configuredSuite, ok := s.(base.ConfiguredSuite); ok {
@@ -103,7 +123,29 @@ func TestIntegration(t *testing.T) {
 	if t.Failed() && cluster != nil && provisioner != nil {
 		// if provisioner & cluster are available,
 		// debugging failed test is easier with crashdump
-		provisioner.CrashDump(context.Background(), cluster, os.Stderr)
+		var (
+			opts         []provision.Option
+			clientConfig *config.Config
+			buf          bytes.Buffer
+		)
+
+		if clientConfig, err = config.Open(talosConfig); err == nil {
+			opts = append(opts, provision.WithTalosConfig(clientConfig))
+		}
+
+		provisioner.CrashDump(context.Background(), cluster, &buf, opts...)
+
+		if err = support.PrintBundle(os.Stderr, bytes.NewReader(buf.Bytes())); err != nil {
+			t.Log("error printing crash dump", err)
+		}
+
+		if err = ioutil.WriteFile(crashdumpPath, buf.Bytes(), 0644); err != nil {
+			t.Log("error writing crash dump", err)
+
+			return
+		}
+
+		t.Log("crash dump written to", crashdumpPath)
 	}
 }
 
@@ -116,6 +158,7 @@ func init() {
 	}
 
 	flag.BoolVar(&failFast, "talos.failfast", false, "fail the test run on the first failed test")
+	flag.BoolVar(&fakeNode, "talos.fake", false, "run the tests against in-process fake Talos node (overrides config and endpoint)")
 
 	flag.StringVar(&talosConfig, "talos.config", defaultTalosConfig, "The path to the Talos configuration file")
 	flag.StringVar(&endpoint, "talos.endpoint", "", "endpoint to use (overrides config)")
@@ -125,6 +168,7 @@ func init() {
 	flag.StringVar(&clusterName, "talos.name", "talos-default", "the name of the cluster")
 	flag.StringVar(&expectedVersion, "talos.version", version.Tag, "expected Talos version")
 	flag.StringVar(&osctlPath, "talos.osctlpath", "osctl", "The path to 'osctl' binary")
+	flag.StringVar(&crashdumpPath, "talos.crashdump", "crashdump.tar.gz", "The path to write crash dump (support bundle) to on failure")
 
 	flag.StringVar(&provision_test.DefaultSettings.CIDR, "talos.provision.cidr", provision_test.DefaultSettings.CIDR, "CIDR to use to provision clusters (provision tests only)")
 	flag.Var(&provision_test.DefaultSettings.RegistryMirrors, "talos.provision.registry-mirror", "registry mirrors to use (provision tests only)")
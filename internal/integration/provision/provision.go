@@ -41,6 +41,8 @@ type Settings struct {
 	TargetInstallImageRegistry string
 	// Current version of the cluster (built in the CI pass)
 	CurrentVersion string
+	// Path to write crash dump to on failure
+	CrashdumpPath string
 }
 
 // DefaultSettings filled in by test runner.
@@ -53,4 +55,5 @@ var DefaultSettings Settings = Settings{
 	MasterNodes:                3,
 	WorkerNodes:                1,
 	TargetInstallImageRegistry: "docker.io",
+	CrashdumpPath:              "crashdump.tar.gz",
 }
@@ -7,6 +7,7 @@
 package provision
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"fmt"
@@ -29,6 +30,7 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/provision/check"
 	"github.com/talos-systems/talos/internal/pkg/provision/providers/firecracker"
 	"github.com/talos-systems/talos/internal/pkg/runtime"
+	"github.com/talos-systems/talos/internal/pkg/support"
 	"github.com/talos-systems/talos/pkg/config"
 	"github.com/talos-systems/talos/pkg/config/machine"
 	"github.com/talos-systems/talos/pkg/config/types/v1alpha1"
@@ -149,7 +151,7 @@ func (suite *UpgradeSuite) TearDownSuite() {
 	if suite.T().Failed() && suite.Cluster != nil {
 		// for failed tests, produce crash dump for easier debugging,
 		// as cluster is going to be torn down below
-		suite.provisioner.CrashDump(suite.ctx, suite.Cluster, os.Stderr)
+		suite.crashDump()
 	}
 
 	if suite.clusterAccess != nil {
@@ -171,6 +173,26 @@ func (suite *UpgradeSuite) TearDownSuite() {
 	}
 }
 
+// crashDump writes crash dump (support bundle) of the cluster to the file,
+// and prints its contents to stderr.
+func (suite *UpgradeSuite) crashDump() {
+	var buf bytes.Buffer
+
+	suite.provisioner.CrashDump(suite.ctx, suite.Cluster, &buf, provision.WithTalosConfig(suite.configBundle.TalosConfig()))
+
+	if err := support.PrintBundle(os.Stderr, bytes.NewReader(buf.Bytes())); err != nil {
+		suite.T().Logf("error printing crash dump: %s", err)
+	}
+
+	if err := ioutil.WriteFile(DefaultSettings.CrashdumpPath, buf.Bytes(), 0644); err != nil {
+		suite.T().Logf("error writing crash dump: %s", err)
+
+		return
+	}
+
+	suite.T().Logf("crash dump written to %s", DefaultSettings.CrashdumpPath)
+}
+
 // setupCluster provisions source clusters and waits for health
 func (suite *UpgradeSuite) setupCluster() {
 	shortNameHash := sha256.Sum256([]byte(suite.spec.ShortName))
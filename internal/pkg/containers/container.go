@@ -39,8 +39,10 @@ type Container struct {
 
 // ContainerMetrics represents container cgroup stats
 type ContainerMetrics struct {
-	MemoryUsage uint64
-	CPUUsage    uint64
+	MemoryUsage  uint64
+	CPUUsage     uint64
+	IOReadBytes  uint64
+	IOWriteBytes uint64
 }
 
 // GetProcessStderr returns process stderr
@@ -66,6 +68,11 @@ func (c *Container) Kill(signal syscall.Signal) error {
 	return c.Inspector.Kill(c.ID, c.IsPodSandbox, signal)
 }
 
+// Exec starts new process in the container
+func (c *Container) Exec(options ExecOptions) (Process, error) {
+	return c.Inspector.Exec(c.ID, options)
+}
+
 // GetLogChunker returns chunker for container log file
 func (c *Container) GetLogChunker(follow bool, tailLines int) (chunker.Chunker, io.Closer, error) {
 	logFile := c.GetLogFile()
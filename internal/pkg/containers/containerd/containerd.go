@@ -9,13 +9,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"path"
 	"strings"
 	"syscall"
+	"time"
 
 	v1 "github.com/containerd/cgroups/stats/v1"
 	"github.com/containerd/containerd"
 	tasks "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/cio"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/typeurl"
@@ -185,6 +188,17 @@ func (i *inspector) containerInfo(cntr containerd.Container, imageList map[strin
 		if cpu != nil && cpu.Usage != nil {
 			cp.Metrics.CPUUsage = cpu.Usage.Total
 		}
+
+		if data.Blkio != nil {
+			for _, entry := range data.Blkio.IoServiceBytesRecursive {
+				switch entry.Op {
+				case "Read":
+					cp.Metrics.IOReadBytes += entry.Value
+				case "Write":
+					cp.Metrics.IOWriteBytes += entry.Value
+				}
+			}
+		}
 	}
 
 	// Save off an identifier for the pod
@@ -388,3 +402,116 @@ func (i *inspector) Kill(id string, isPodSandbox bool, signal syscall.Signal) er
 	_, err := i.client.TaskService().Kill(i.nsctx, &tasks.KillRequest{ContainerID: id, Signal: uint32(signal)})
 	return err
 }
+
+// Exec starts new process in the container task
+func (i *inspector) Exec(id string, options ctrs.ExecOptions) (ctrs.Process, error) {
+	container, err := i.client.LoadContainer(i.nsctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := container.Task(i.nsctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := container.Spec(i.nsctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// process inherits user, working directory, capabilities, etc. from the container process
+	pspec := *spec.Process
+	pspec.Args = options.Args
+	pspec.Env = append(append([]string(nil), pspec.Env...), options.Env...)
+	pspec.Terminal = options.TTY
+
+	ioOpts := []cio.Opt{
+		cio.WithStreams(options.Stdin, options.Stdout, options.Stderr),
+		// FIFOs should be created in the directory shared with containerd
+		cio.WithFIFODir("/tmp"),
+	}
+
+	if options.TTY {
+		ioOpts = append(ioOpts, cio.WithTerminal)
+	}
+
+	execID := fmt.Sprintf("exec-%d", time.Now().UnixNano())
+
+	process, err := task.Exec(i.nsctx, execID, &pspec, cio.NewCreator(ioOpts...))
+	if err != nil {
+		return nil, err
+	}
+
+	statusC, err := process.Wait(i.nsctx)
+	if err != nil {
+		// nolint: errcheck
+		process.Delete(i.nsctx)
+
+		return nil, err
+	}
+
+	if err = process.Start(i.nsctx); err != nil {
+		// nolint: errcheck
+		process.Delete(i.nsctx)
+
+		return nil, err
+	}
+
+	return &execProcess{
+		ctx:     i.nsctx,
+		process: process,
+		statusC: statusC,
+	}, nil
+}
+
+type execProcess struct {
+	ctx     context.Context
+	process containerd.Process
+	statusC <-chan containerd.ExitStatus
+}
+
+func (p *execProcess) Resize(width, height uint32) error {
+	return p.process.Resize(p.ctx, width, height)
+}
+
+func (p *execProcess) CloseStdin() error {
+	return p.process.CloseIO(p.ctx, containerd.WithStdinCloser)
+}
+
+func (p *execProcess) Kill(signal syscall.Signal) error {
+	return p.process.Kill(p.ctx, signal)
+}
+
+func (p *execProcess) Wait(ctx context.Context) (uint32, error) {
+	var status containerd.ExitStatus
+
+	select {
+	case status = <-p.statusC:
+	case <-ctx.Done():
+		if err := p.process.Kill(p.ctx, syscall.SIGKILL); err != nil && !errdefs.IsNotFound(err) {
+			log.Printf("failed to kill exec process %q: %s", p.process.ID(), err)
+		}
+
+		if _, err := p.process.Delete(p.ctx, containerd.WithProcessKill); err != nil && !errdefs.IsNotFound(err) {
+			log.Printf("failed to delete exec process %q: %s", p.process.ID(), err)
+		}
+
+		return 0, ctx.Err()
+	}
+
+	code, _, err := status.Result()
+	if err != nil {
+		return 0, err
+	}
+
+	p.process.IO().Wait()
+
+	return code, nil
+}
+
+func (p *execProcess) Close() error {
+	_, err := p.process.Delete(p.ctx, containerd.WithProcessKill)
+
+	return err
+}
@@ -8,6 +8,7 @@ package cri
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strings"
 	"syscall"
 	"time"
@@ -344,3 +345,9 @@ func (i *inspector) Kill(id string, isPodSandbox bool, signal syscall.Signal) er
 
 	return i.client.StopContainer(i.ctx, id, 10)
 }
+
+// Exec starts new process in the container
+func (i *inspector) Exec(id string, options ctrs.ExecOptions) (ctrs.Process, error) {
+	// CRI exec is served via streaming server URL, use containerd inspector instead
+	return nil, errors.New("exec is not supported by CRI inspector, use containerd driver")
+}
@@ -0,0 +1,43 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package containers
+
+import (
+	"context"
+	"io"
+	"syscall"
+)
+
+// ExecOptions describes the process started in the container with Exec
+type ExecOptions struct {
+	Args []string
+	Env  []string
+	TTY  bool
+
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer // ignored if TTY is set
+}
+
+// Process is a process started in the container with Exec
+type Process interface {
+	// Resize changes the size of the process terminal.
+	Resize(width, height uint32) error
+	// CloseStdin signals end of stdin to the process.
+	//
+	// It should be called after the stdin reader returns io.EOF.
+	CloseStdin() error
+	// Kill sends signal to the process.
+	Kill(signal syscall.Signal) error
+	// Wait waits for the process to exit and returns its exit code.
+	//
+	// Wait returns only after process output was copied to stdout and stderr.
+	//
+	// If the context is canceled, the process is killed and deleted, and the context
+	// error is returned.
+	Wait(ctx context.Context) (uint32, error)
+	// Close frees associated resources, killing the process if it's still running.
+	Close() error
+}
@@ -7,21 +7,97 @@ package image
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/images"
+
 	"github.com/talos-systems/talos/pkg/config/machine"
 	"github.com/talos-systems/talos/pkg/retry"
-
-	"github.com/containerd/containerd"
 )
 
+// PullOption configures the image pull.
+type PullOption func(*PullOptions)
+
+// PullOptions describes the image pull options.
+type PullOptions struct {
+	Policy   machine.ImagePolicy
+	Progress ProgressFunc
+}
+
+// WithPolicy enforces the image verification policy.
+func WithPolicy(policy machine.ImagePolicy) PullOption {
+	return func(o *PullOptions) {
+		o.Policy = policy
+	}
+}
+
+// WithProgress reports the pull progress to the callback.
+func WithProgress(f ProgressFunc) PullOption {
+	return func(o *PullOptions) {
+		o.Progress = f
+	}
+}
+
 // Pull is a convenience function that wraps the containerd image pull func with
 // retry functionality.
-func Pull(ctx context.Context, config machine.Registries, client *containerd.Client, ref string) (img containerd.Image, err error) {
+//
+// If the image policy is set, the image reference should be pinned to a digest
+// (if required), and the image signature is verified once the image is pulled.
+// Images which fail the verification are removed.
+//
+//nolint: gocyclo
+func Pull(ctx context.Context, config machine.Registries, client *containerd.Client, ref string, setters ...PullOption) (img containerd.Image, err error) {
+	var opts PullOptions
+
+	for _, setter := range setters {
+		setter(&opts)
+	}
+
+	var keys []PublicKey
+
+	if opts.Policy != nil {
+		if opts.Policy.RequireDigest() {
+			if _, err = PinnedDigest(ref); err != nil {
+				return nil, err
+			}
+		}
+
+		if keys, err = ParseSignatureKeys(opts.Policy.SignatureKeys()); err != nil {
+			return nil, err
+		}
+	}
+
 	resolver := NewResolver(config)
 
+	pullOpts := []containerd.RemoteOpt{containerd.WithPullUnpack, containerd.WithResolver(resolver)}
+
+	if opts.Progress != nil {
+		tracker := newProgressTracker(ref, client.ContentStore())
+
+		pullOpts = append(pullOpts, containerd.WithImageHandler(tracker.Handler()))
+
+		progressCtx, progressCancel := context.WithCancel(ctx)
+
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+
+			tracker.Run(progressCtx, opts.Progress)
+		}()
+
+		defer func() {
+			progressCancel()
+			<-done
+
+			opts.Progress(tracker.Progress(ctx))
+		}()
+	}
+
 	err = retry.Exponential(1*time.Minute, retry.WithUnits(1*time.Second)).Retry(func() error {
-		if img, err = client.Pull(ctx, ref, containerd.WithPullUnpack, containerd.WithResolver(resolver)); err != nil {
+		if img, err = client.Pull(ctx, ref, pullOpts...); err != nil {
 			return retry.ExpectedError(fmt.Errorf("failed to pull image %q: %w", ref, err))
 		}
 
@@ -32,5 +108,15 @@ func Pull(ctx context.Context, config machine.Registries, client *containerd.Cli
 		return nil, err
 	}
 
+	if len(keys) > 0 {
+		if err = VerifySignature(ctx, resolver, ref, img.Target(), keys); err != nil {
+			if e := client.ImageService().Delete(ctx, img.Name(), images.SynchronousDelete()); e != nil {
+				log.Printf("failed to remove unverified image %q: %s", ref, e)
+			}
+
+			return nil, err
+		}
+	}
+
 	return img, nil
 }
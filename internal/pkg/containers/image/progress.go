@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/images"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// progressInterval is the interval between the progress reports.
+const progressInterval = time.Second
+
+// Progress describes the image pull progress.
+type Progress struct {
+	Ref        string
+	BytesDone  int64
+	BytesTotal int64
+}
+
+// ProgressFunc is called periodically while the image is pulled.
+type ProgressFunc func(Progress)
+
+// progressTracker collects the sizes of the content being fetched, and
+// reports the progress based on the content store ingest statuses.
+type progressTracker struct {
+	ref   string
+	store content.Store
+
+	mu    sync.Mutex
+	sizes map[digest.Digest]int64
+}
+
+func newProgressTracker(ref string, store content.Store) *progressTracker {
+	return &progressTracker{
+		ref:   ref,
+		store: store,
+		sizes: map[digest.Digest]int64{},
+	}
+}
+
+// Handler records the descriptors fetched during the pull.
+func (t *progressTracker) Handler() images.Handler {
+	return images.HandlerFunc(func(ctx context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		t.sizes[desc.Digest] = desc.Size
+
+		return nil, nil
+	})
+}
+
+// Run reports the progress periodically until the context is canceled.
+func (t *progressTracker) Run(ctx context.Context, f ProgressFunc) {
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f(t.Progress(ctx))
+		}
+	}
+}
+
+// Progress calculates the current pull progress.
+func (t *progressTracker) Progress(ctx context.Context) Progress {
+	t.mu.Lock()
+
+	sizes := make(map[digest.Digest]int64, len(t.sizes))
+	for dgst, size := range t.sizes {
+		sizes[dgst] = size
+	}
+
+	t.mu.Unlock()
+
+	active := map[digest.Digest]int64{}
+
+	// error is ignored, as progress is reported on a best effort basis
+	// nolint: errcheck
+	statuses, _ := t.store.ListStatuses(ctx)
+
+	for _, status := range statuses {
+		active[status.Expected] = status.Offset
+	}
+
+	p := Progress{
+		Ref: t.ref,
+	}
+
+	for dgst, size := range sizes {
+		p.BytesTotal += size
+
+		if offset, ok := active[dgst]; ok {
+			p.BytesDone += offset
+
+			continue
+		}
+
+		if _, err := t.store.Info(ctx, dgst); err == nil {
+			p.BytesDone += size
+		}
+	}
+
+	return p
+}
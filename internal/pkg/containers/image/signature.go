@@ -0,0 +1,232 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/containerd/containerd/reference"
+	"github.com/containerd/containerd/remotes"
+	digest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// SignatureAnnotation is the annotation of the signature manifest layer which
+// carries the base64-encoded signature of the layer (payload).
+const SignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+// maxSignatureBlobSize limits the size of the signature manifest and payloads.
+const maxSignatureBlobSize = 1 << 20
+
+// ErrNotPinned is returned when the image reference is not pinned to a digest.
+var ErrNotPinned = errors.New("image reference is not pinned to a digest")
+
+// ErrSignatureNotVerified is returned when no valid signature was found for the image.
+var ErrSignatureNotVerified = errors.New("image signature could not be verified")
+
+// PublicKey is a public key used to verify image signatures.
+type PublicKey = crypto.PublicKey
+
+// ParseSignatureKeys parses PEM-encoded public keys.
+func ParseSignatureKeys(keys []string) ([]PublicKey, error) {
+	result := make([]PublicKey, 0, len(keys))
+
+	for i, key := range keys {
+		block, _ := pem.Decode([]byte(key))
+		if block == nil {
+			return nil, fmt.Errorf("signature key %d is not PEM-encoded", i)
+		}
+
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing signature key %d: %w", i, err)
+		}
+
+		result = append(result, pub)
+	}
+
+	return result, nil
+}
+
+// PinnedDigest returns the digest the image reference is pinned to.
+func PinnedDigest(ref string) (digest.Digest, error) {
+	spec, err := reference.Parse(ref)
+	if err != nil {
+		return "", fmt.Errorf("error parsing image reference %q: %w", ref, err)
+	}
+
+	dgst := spec.Digest()
+	if dgst == "" {
+		return "", fmt.Errorf("%q: %w", ref, ErrNotPinned)
+	}
+
+	if err = dgst.Validate(); err != nil {
+		return "", fmt.Errorf("%q: %w", ref, err)
+	}
+
+	return dgst, nil
+}
+
+// SignatureReference returns the reference of the signature image for the
+// image manifest digest.
+//
+// Signatures are stored in the same repository under the tag derived from
+// the image manifest digest: <repository>:sha256-<hex>.sig.
+func SignatureReference(ref string, dgst digest.Digest) (string, error) {
+	spec, err := reference.Parse(ref)
+	if err != nil {
+		return "", fmt.Errorf("error parsing image reference %q: %w", ref, err)
+	}
+
+	return fmt.Sprintf("%s:%s-%s.sig", spec.Locator, dgst.Algorithm(), dgst.Hex()), nil
+}
+
+// signaturePayload is the signed document (simple signing format).
+type signaturePayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// VerifyPayload checks that the payload refers to the image manifest digest
+// and that the signature is valid for one of the keys.
+func VerifyPayload(payload, signature []byte, dgst digest.Digest, keys []PublicKey) error {
+	var p signaturePayload
+
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("error decoding signature payload: %w", err)
+	}
+
+	if p.Critical.Image.DockerManifestDigest != dgst.String() {
+		return fmt.Errorf("signature payload digest %q doesn't match image digest %q", p.Critical.Image.DockerManifestDigest, dgst)
+	}
+
+	for _, key := range keys {
+		if verifySignature(key, payload, signature) {
+			return nil
+		}
+	}
+
+	return ErrSignatureNotVerified
+}
+
+// VerifySignature fetches the detached signatures of the image and checks
+// that at least one of them is valid for one of the keys.
+func VerifySignature(ctx context.Context, resolver remotes.Resolver, ref string, target ocispec.Descriptor, keys []PublicKey) error {
+	sigRef, err := SignatureReference(ref, target.Digest)
+	if err != nil {
+		return err
+	}
+
+	name, desc, err := resolver.Resolve(ctx, sigRef)
+	if err != nil {
+		return fmt.Errorf("error resolving signature %q for image %q: %w", sigRef, ref, err)
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	var manifest ocispec.Manifest
+
+	manifestData, err := fetchBlob(ctx, fetcher, desc)
+	if err != nil {
+		return fmt.Errorf("error fetching signature manifest %q: %w", sigRef, err)
+	}
+
+	if err = json.Unmarshal(manifestData, &manifest); err != nil {
+		return fmt.Errorf("error decoding signature manifest %q: %w", sigRef, err)
+	}
+
+	for _, layer := range manifest.Layers {
+		encoded, ok := layer.Annotations[SignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		signature, e := base64.StdEncoding.DecodeString(encoded)
+		if e != nil {
+			continue
+		}
+
+		payload, e := fetchBlob(ctx, fetcher, layer)
+		if e != nil {
+			return fmt.Errorf("error fetching signature payload %q: %w", sigRef, e)
+		}
+
+		if VerifyPayload(payload, signature, target.Digest, keys) == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%q: %w", ref, ErrSignatureNotVerified)
+}
+
+// fetchBlob reads the blob and verifies its digest.
+func fetchBlob(ctx context.Context, fetcher remotes.Fetcher, desc ocispec.Descriptor) ([]byte, error) {
+	if desc.Size > maxSignatureBlobSize {
+		return nil, fmt.Errorf("blob %s is too large: %d bytes", desc.Digest, desc.Size)
+	}
+
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: errcheck
+	defer rc.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(rc, maxSignatureBlobSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if dgst := desc.Digest.Algorithm().FromBytes(data); dgst != desc.Digest {
+		return nil, fmt.Errorf("blob digest mismatch: expected %s, got %s", desc.Digest, dgst)
+	}
+
+	return data, nil
+}
+
+func verifySignature(key PublicKey, payload, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		var sig struct {
+			R, S *big.Int
+		}
+
+		if rest, err := asn1.Unmarshal(signature, &sig); err != nil || len(rest) > 0 {
+			return false
+		}
+
+		return ecdsa.Verify(k, hash[:], sig.R, sig.S)
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature) == nil
+	default:
+		return false
+	}
+}
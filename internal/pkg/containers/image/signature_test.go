@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"testing"
+
+	digest "github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/pkg/containers/image"
+)
+
+const testDigest = digest.Digest("sha256:4b7ce07a2ab2f1f8e0bcbb1e3a5b0d5d9a5bf3a0bb6d8f6e8c7e1a1d2f0a9b8c")
+
+type SignatureSuite struct {
+	suite.Suite
+}
+
+func encodeKey(suite *SignatureSuite, key crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	suite.Require().NoError(err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func payload(dgst digest.Digest) []byte {
+	return []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"docker.io/example/installer"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, dgst))
+}
+
+func (suite *SignatureSuite) TestPinnedDigest() {
+	dgst, err := image.PinnedDigest("docker.io/example/installer@" + testDigest.String())
+	suite.Require().NoError(err)
+	suite.Assert().Equal(testDigest, dgst)
+
+	dgst, err = image.PinnedDigest("docker.io/example/installer:v0.4.0@" + testDigest.String())
+	suite.Require().NoError(err)
+	suite.Assert().Equal(testDigest, dgst)
+
+	_, err = image.PinnedDigest("docker.io/example/installer:v0.4.0")
+	suite.Assert().True(errors.Is(err, image.ErrNotPinned))
+
+	_, err = image.PinnedDigest("docker.io/example/installer@sha256:1234")
+	suite.Assert().Error(err)
+}
+
+func (suite *SignatureSuite) TestSignatureReference() {
+	ref, err := image.SignatureReference("docker.io/example/installer:v0.4.0", testDigest)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("docker.io/example/installer:sha256-"+testDigest.Hex()+".sig", ref)
+
+	ref, err = image.SignatureReference("127.0.0.1:5000/installer@"+testDigest.String(), testDigest)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("127.0.0.1:5000/installer:sha256-"+testDigest.Hex()+".sig", ref)
+}
+
+func (suite *SignatureSuite) TestParseSignatureKeys() {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+
+	ed25519Key, _, err := ed25519.GenerateKey(rand.Reader)
+	suite.Require().NoError(err)
+
+	keys, err := image.ParseSignatureKeys([]string{encodeKey(suite, &ecdsaKey.PublicKey), encodeKey(suite, ed25519Key)})
+	suite.Require().NoError(err)
+	suite.Assert().Len(keys, 2)
+
+	_, err = image.ParseSignatureKeys([]string{"not a key"})
+	suite.Assert().Error(err)
+}
+
+func (suite *SignatureSuite) TestVerifyPayload() {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+
+	ed25519Public, ed25519Private, err := ed25519.GenerateKey(rand.Reader)
+	suite.Require().NoError(err)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+
+	p := payload(testDigest)
+	hash := sha256.Sum256(p)
+
+	ecdsaSignature, err := ecdsaKey.Sign(rand.Reader, hash[:], crypto.SHA256)
+	suite.Require().NoError(err)
+
+	ed25519Signature := ed25519.Sign(ed25519Private, p)
+
+	keys := []image.PublicKey{&otherKey.PublicKey, &ecdsaKey.PublicKey, ed25519Public}
+
+	suite.Assert().NoError(image.VerifyPayload(p, ecdsaSignature, testDigest, keys))
+	suite.Assert().NoError(image.VerifyPayload(p, ed25519Signature, testDigest, keys))
+
+	// signature by the key which is not trusted
+	err = image.VerifyPayload(p, ecdsaSignature, testDigest, []image.PublicKey{&otherKey.PublicKey, ed25519Public})
+	suite.Assert().True(errors.Is(err, image.ErrSignatureNotVerified))
+
+	// payload for another image
+	otherDigest := digest.FromString("other")
+	otherPayload := payload(otherDigest)
+	otherHash := sha256.Sum256(otherPayload)
+
+	otherSignature, err := ecdsaKey.Sign(rand.Reader, otherHash[:], crypto.SHA256)
+	suite.Require().NoError(err)
+
+	suite.Assert().Error(image.VerifyPayload(otherPayload, otherSignature, testDigest, keys))
+
+	// tampered payload
+	suite.Assert().Error(image.VerifyPayload(append(p, ' '), ecdsaSignature, testDigest, keys))
+}
+
+func TestSignatureSuite(t *testing.T) {
+	suite.Run(t, new(SignatureSuite))
+}
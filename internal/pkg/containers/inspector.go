@@ -20,4 +20,6 @@ type Inspector interface {
 	GetProcessStderr(ID string) (string, error)
 	// Kill sends signal to container's process
 	Kill(ID string, isPodSandbox bool, signal syscall.Signal) error
+	// Exec starts new process in the container's task
+	Exec(ID string, options ExecOptions) (Process, error)
 }
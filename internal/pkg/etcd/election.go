@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/clientv3/concurrency"
+)
+
+// Election runs a leader election backed by an etcd lease.
+//
+// Leadership is held as long as the lease is kept alive; if the lease
+// expires (e.g. the node loses connectivity to etcd), Lost() is closed and
+// another candidate takes over.
+type Election struct {
+	session  *concurrency.Session
+	election *concurrency.Election
+}
+
+// NewElection creates a lease with the specified TTL (in seconds) and prepares
+// an election under the key prefix.
+func NewElection(client *clientv3.Client, prefix string, ttl int) (*Election, error) {
+	session, err := concurrency.NewSession(client, concurrency.WithTTL(ttl))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Election{
+		session:  session,
+		election: concurrency.NewElection(session, prefix),
+	}, nil
+}
+
+// Campaign blocks until the candidate is elected or the context is canceled.
+func (e *Election) Campaign(ctx context.Context, value string) error {
+	return e.election.Campaign(ctx, value)
+}
+
+// Resign gives up the leadership so that another candidate can be elected.
+func (e *Election) Resign(ctx context.Context) error {
+	return e.election.Resign(ctx)
+}
+
+// Lost is closed when the lease backing the election is lost.
+func (e *Election) Lost() <-chan struct{} {
+	return e.session.Done()
+}
+
+// Close revokes the lease.
+func (e *Election) Close() error {
+	return e.session.Close()
+}
@@ -18,6 +18,10 @@ const (
 	Upgrade
 	// Reset is the reset event.
 	Reset
+	// WipeProgress is the system disk wipe progress event sent during reset.
+	WipeProgress
+	// ImagePullProgress is the installer image pull progress event sent during upgrade.
+	ImagePullProgress
 )
 
 // Event represents an event in the observer pattern.
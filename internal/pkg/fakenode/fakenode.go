@@ -0,0 +1,419 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package fakenode provides an in-process fake Talos node for API and client testing.
+//
+// The node runs the real machined, osd, networkd and ntpd registrators behind
+// routerd and apid style proxies. Files and service logs are served from a
+// temporary root directory, containers come from a static fake inspector, images
+// are kept in memory and system services follow the scripted states.
+//
+// As services are tracked by the process-wide system.Services singleton, only
+// a single fake node should be running in the process at any given time.
+package fakenode
+
+import (
+	"context"
+	stdlibtls "crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/talos-systems/grpc-proxy/proxy"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/talos-systems/talos/api/common"
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/internal/app/apid/pkg/authz"
+	apidbackend "github.com/talos-systems/talos/internal/app/apid/pkg/backend"
+	apiddirector "github.com/talos-systems/talos/internal/app/apid/pkg/director"
+	machinedreg "github.com/talos-systems/talos/internal/app/machined/pkg/reg"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system"
+	"github.com/talos-systems/talos/internal/app/networkd/pkg/networkd"
+	networkdreg "github.com/talos-systems/talos/internal/app/networkd/pkg/reg"
+	"github.com/talos-systems/talos/internal/app/ntpd/pkg/ntp"
+	ntpdreg "github.com/talos-systems/talos/internal/app/ntpd/pkg/reg"
+	osdreg "github.com/talos-systems/talos/internal/app/osd/pkg/reg"
+	routerddirector "github.com/talos-systems/talos/internal/app/routerd/pkg/director"
+	"github.com/talos-systems/talos/internal/pkg/containers"
+	"github.com/talos-systems/talos/internal/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/config/machine"
+	"github.com/talos-systems/talos/pkg/config/types/v1alpha1/generate"
+	"github.com/talos-systems/talos/pkg/constants"
+	"github.com/talos-systems/talos/pkg/grpc/factory"
+	"github.com/talos-systems/talos/pkg/grpc/proxy/backend"
+	"github.com/talos-systems/talos/pkg/grpc/tls"
+)
+
+// Node is an in-process fake Talos node.
+type Node struct {
+	clusterName string
+	services    []*Service
+	pods        map[string][]*containers.Pod
+
+	root        string
+	config      runtime.Configurator
+	input       *generate.Input
+	talosconfig string
+	endpoint    string
+
+	servers []*grpc.Server
+
+	mu sync.Mutex
+}
+
+// Option is the functional option func.
+type Option func(*Node)
+
+// WithClusterName sets the name of the cluster (and of the talosconfig context).
+func WithClusterName(o string) Option {
+	return func(n *Node) {
+		n.clusterName = o
+	}
+}
+
+// WithService adds a scripted system service to the node.
+func WithService(o *Service) Option {
+	return func(n *Node) {
+		n.services = append(n.services, o)
+	}
+}
+
+// WithPod adds a pod to the fake containerd namespace.
+func WithPod(namespace string, o *containers.Pod) Option {
+	return func(n *Node) {
+		n.pods[namespace] = append(n.pods[namespace], o)
+	}
+}
+
+// New starts a fake node.
+//
+// The node should be stopped with Close.
+func New(setters ...Option) (n *Node, err error) {
+	n = &Node{
+		clusterName: "fakenode",
+		pods:        map[string][]*containers.Pod{},
+	}
+
+	for _, setter := range setters {
+		setter(n)
+	}
+
+	if n.root, err = ioutil.TempDir("", "fakenode"); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			// nolint: errcheck
+			n.Close()
+		}
+	}()
+
+	if err = os.MkdirAll(n.Path(constants.DefaultLogPath), 0755); err != nil {
+		return nil, err
+	}
+
+	if err = n.generateConfig(); err != nil {
+		return nil, err
+	}
+
+	if err = n.serveLocal(); err != nil {
+		return nil, err
+	}
+
+	if err = n.serveAPI(); err != nil {
+		return nil, err
+	}
+
+	if err = n.writeTalosconfig(); err != nil {
+		return nil, err
+	}
+
+	n.startServices()
+
+	return n, nil
+}
+
+// Root returns the host path the node root filesystem is mapped to.
+func (n *Node) Root() string {
+	return n.root
+}
+
+// Path resolves the node path against the node root.
+func (n *Node) Path(path string) string {
+	return filepath.Join(n.root, filepath.Clean("/"+path))
+}
+
+// Config returns the machine configuration of the node.
+func (n *Node) Config() runtime.Configurator {
+	return n.config
+}
+
+// Endpoint returns the host:port apid of the node is listening on.
+func (n *Node) Endpoint() string {
+	return n.endpoint
+}
+
+// TalosconfigPath returns the path to the talosconfig with the node as the endpoint.
+func (n *Node) TalosconfigPath() string {
+	return n.talosconfig
+}
+
+// Client builds a client connected to the node with admin credentials.
+func (n *Node) Client() (*client.Client, error) {
+	cert, err := stdlibtls.X509KeyPair(n.input.Certs.Admin.Crt, n.input.Certs.Admin.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := tls.New(
+		tls.WithClientAuthType(tls.Mutual),
+		tls.WithCACertPEM(n.input.Certs.OS.Crt),
+		tls.WithKeypair(cert),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.NewClient(tlsConfig, []string{n.endpoint}, constants.ApidPort)
+}
+
+// Close stops the node services and API servers, and removes the node root.
+func (n *Node) Close() error {
+	if n.config != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		for _, svc := range n.services {
+			if _, running, _ := system.Services(nil).IsRunning(svc.ID(n.config)); running {
+				// nolint: errcheck
+				system.Services(nil).Stop(ctx, svc.ID(n.config))
+			}
+		}
+	}
+
+	n.mu.Lock()
+	servers := n.servers
+	n.servers = nil
+	n.mu.Unlock()
+
+	for i := len(servers) - 1; i >= 0; i-- {
+		servers[i].Stop()
+	}
+
+	return os.RemoveAll(n.root)
+}
+
+func (n *Node) generateConfig() (err error) {
+	if n.input, err = generate.NewInput(n.clusterName, "https://127.0.0.1:6443", constants.DefaultKubernetesVersion); err != nil {
+		return fmt.Errorf("failed to generate input: %w", err)
+	}
+
+	if n.config, err = generate.Config(machine.TypeInit, n.input); err != nil {
+		return fmt.Errorf("failed to generate config: %w", err)
+	}
+
+	return nil
+}
+
+func (n *Node) writeTalosconfig() error {
+	cfg, err := generate.Talosconfig(n.input, generate.WithEndpointList([]string{n.endpoint}))
+	if err != nil {
+		return err
+	}
+
+	n.talosconfig = filepath.Join(n.root, "talosconfig")
+
+	return cfg.Save(n.talosconfig)
+}
+
+func (n *Node) socketPath(name string) string {
+	return filepath.Join(n.root, "system", "run", name, name+".sock")
+}
+
+// serveLocal starts the node services listening on unix sockets and the
+// routerd proxy in front of them.
+func (n *Node) serveLocal() error {
+	ntpClient, err := ntp.NewNTPClient()
+	if err != nil {
+		return err
+	}
+
+	network, err := networkd.New(nil)
+	if err != nil {
+		return err
+	}
+
+	registrators := map[string]factory.Registrator{
+		"machined": machinedreg.NewRegistrator(
+			n.config,
+			machinedreg.WithPlatform(&Platform{}),
+			machinedreg.WithRootPath(n.root),
+			machinedreg.WithContainerInspector(n.inspector),
+			machinedreg.WithImageServer(&ImageService{}),
+		),
+		"osd":      osdreg.NewRegistrator(osdreg.WithContainerInspector(n.inspector)),
+		"networkd": networkdreg.NewRegistrator(network),
+		"timed":    ntpdreg.NewRegistrator(ntpClient),
+	}
+
+	for name, r := range registrators {
+		if err = n.serve(r, factory.Network("unix"), factory.SocketPath(n.socketPath(name))); err != nil {
+			return fmt.Errorf("failed to start %s: %w", name, err)
+		}
+	}
+
+	router := routerddirector.NewRouter()
+
+	router.RegisterLocalBackend("os.OSService", backend.NewLocal("osd", n.socketPath("osd")))
+	router.RegisterLocalBackend("machine.MachineService", backend.NewLocal("machined", n.socketPath("machined")))
+	router.RegisterLocalBackend("image.ImageService", backend.NewLocal("machined", n.socketPath("machined")))
+	router.RegisterLocalBackend("time.TimeService", backend.NewLocal("timed", n.socketPath("timed")))
+	router.RegisterLocalBackend("network.NetworkService", backend.NewLocal("networkd", n.socketPath("networkd")))
+
+	return n.serve(
+		router,
+		factory.Network("unix"),
+		factory.SocketPath(n.socketPath("routerd")),
+		factory.ServerOptions(
+			grpc.CustomCodec(proxy.Codec()),
+			grpc.UnknownServiceHandler(
+				proxy.TransparentHandler(
+					router.Director,
+				)),
+		),
+	)
+}
+
+// serveAPI starts apid on a random loopback port.
+func (n *Node) serveAPI() error {
+	provider, err := tls.NewLocalRenewingFileCertificateProvider(
+		n.input.Certs.OS.Key,
+		n.input.Certs.OS.Crt,
+		[]string{"localhost"},
+		[]net.IP{net.ParseIP("127.0.0.1")},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create certificate provider: %w", err)
+	}
+
+	ca, err := provider.GetCA()
+	if err != nil {
+		return fmt.Errorf("failed to get root CA: %w", err)
+	}
+
+	serverTLSConfig, err := tls.New(
+		tls.WithClientAuthType(tls.Mutual),
+		tls.WithCACertPEM(ca),
+		tls.WithServerCertificateProvider(provider),
+	)
+	if err != nil {
+		return err
+	}
+
+	clientTLSConfig, err := tls.New(
+		tls.WithClientAuthType(tls.Mutual),
+		tls.WithCACertPEM(ca),
+		tls.WithClientCertificateProvider(provider),
+	)
+	if err != nil {
+		return err
+	}
+
+	router := apiddirector.NewRouter(
+		apidbackend.NewAPIDFactory(clientTLSConfig).Get,
+		backend.NewLocal("routerd", n.socketPath("routerd")),
+	)
+
+	// all existing streaming methods
+	for _, methodName := range []string{
+		"/image.ImageService/Import",
+		"/machine.MachineService/Copy",
+		"/machine.MachineService/Kubeconfig",
+		"/machine.MachineService/List",
+		"/machine.MachineService/Logs",
+		"/machine.MachineService/Read",
+		"/os.OSService/Dmesg",
+		"/os.OSService/Exec",
+		"/os.OSService/StatsWatch",
+	} {
+		router.RegisterStreamedRegex("^" + regexp.QuoteMeta(methodName) + "$")
+	}
+
+	router.RegisterStreamedRegex("Stream$")
+
+	authorizer := authz.NewAuthorizer(serverTLSConfig.ClientCAs)
+	authorizer.Require("/os.OSService/Exec", constants.AdminRole)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+
+	n.endpoint = listener.Addr().String()
+
+	server := factory.NewServer(
+		router,
+		factory.WithStreamInterceptor(authorizer.StreamInterceptor()),
+		factory.ServerOptions(
+			grpc.Creds(
+				credentials.NewTLS(serverTLSConfig),
+			),
+			grpc.CustomCodec(proxy.Codec()),
+			grpc.UnknownServiceHandler(
+				proxy.TransparentHandler(
+					router.Director,
+					proxy.WithStreamedDetector(router.StreamedDetector),
+				)),
+		),
+	)
+
+	n.start(server, listener)
+
+	return nil
+}
+
+func (n *Node) serve(r factory.Registrator, setters ...factory.Option) error {
+	listener, err := factory.NewListener(setters...)
+	if err != nil {
+		return err
+	}
+
+	n.start(factory.NewServer(r, setters...), listener)
+
+	return nil
+}
+
+func (n *Node) start(server *grpc.Server, listener net.Listener) {
+	n.mu.Lock()
+	n.servers = append(n.servers, server)
+	n.mu.Unlock()
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Printf("fake node server stopped: %s", err)
+		}
+	}()
+}
+
+func (n *Node) startServices() {
+	for _, svc := range n.services {
+		svc.logPath = filepath.Join(n.Path(constants.DefaultLogPath), svc.ID(n.config)+".log")
+
+		system.Services(n.config).LoadAndStart(svc)
+	}
+}
+
+// inspector implements the machined and osd ContainerInspectorFunc.
+func (n *Node) inspector(ctx context.Context, namespace string, driver common.ContainerDriver) (containers.Inspector, error) {
+	return &Inspector{node: n, namespace: namespace}, nil
+}
@@ -0,0 +1,292 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package fakenode_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/api/common"
+	osapi "github.com/talos-systems/talos/api/os"
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/internal/pkg/containers"
+	"github.com/talos-systems/talos/internal/pkg/fakenode"
+	"github.com/talos-systems/talos/pkg/constants"
+)
+
+type FakeNodeSuite struct {
+	suite.Suite
+
+	node   *fakenode.Node
+	client *client.Client
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func (suite *FakeNodeSuite) SetupSuite() {
+	var err error
+
+	suite.node, err = fakenode.New(
+		fakenode.WithService(&fakenode.Service{
+			Name: "fake",
+			Steps: []fakenode.Step{
+				{State: events.StateRunning, Message: "Started"},
+			},
+			Log: "hello from fake\n",
+		}),
+		fakenode.WithPod(constants.SystemContainerdNamespace, &containers.Pod{
+			Name: "fake",
+			Containers: []*containers.Container{
+				{
+					Display: "fake",
+					Name:    "fake",
+					ID:      "fake",
+					Image:   "docker.io/autonomy/fake:latest",
+					Status:  "RUNNING",
+					Pid:     1,
+					Metrics: &containers.ContainerMetrics{
+						MemoryUsage: 1 << 20,
+						CPUUsage:    1000,
+						IOReadBytes: 4096,
+					},
+				},
+			},
+		}),
+	)
+	suite.Require().NoError(err)
+
+	suite.client, err = suite.node.Client()
+	suite.Require().NoError(err)
+
+	suite.ctx, suite.cancel = context.WithTimeout(context.Background(), time.Minute)
+}
+
+func (suite *FakeNodeSuite) TearDownSuite() {
+	suite.cancel()
+
+	suite.Require().NoError(suite.client.Close())
+	suite.Require().NoError(suite.node.Close())
+}
+
+func (suite *FakeNodeSuite) TestTalosconfig() {
+	_, err := os.Stat(suite.node.TalosconfigPath())
+	suite.Require().NoError(err)
+
+	configContext, _, err := client.NewClientContextAndCredentialsFromConfig(suite.node.TalosconfigPath(), "")
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]string{suite.node.Endpoint()}, configContext.Endpoints)
+}
+
+func (suite *FakeNodeSuite) TestVersion() {
+	resp, err := suite.client.Version(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Messages, 1)
+	suite.Assert().NotEmpty(resp.Messages[0].Version.Tag)
+}
+
+func (suite *FakeNodeSuite) TestServices() {
+	suite.Require().NoError(retry(func() error {
+		services, err := suite.client.ServiceInfo(suite.ctx, "fake")
+		if err != nil {
+			return err
+		}
+
+		if len(services) != 1 || services[0].Service.State != events.StateRunning.String() {
+			return errors.New("service is not running")
+		}
+
+		return nil
+	}))
+}
+
+func (suite *FakeNodeSuite) TestContainers() {
+	resp, err := suite.client.Containers(suite.ctx, constants.SystemContainerdNamespace, common.ContainerDriver_CONTAINERD)
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Messages, 1)
+	suite.Require().Len(resp.Messages[0].Containers, 1)
+	suite.Assert().Equal("fake", resp.Messages[0].Containers[0].Id)
+
+	suite.Require().NoError(suite.client.Restart(suite.ctx, constants.SystemContainerdNamespace, common.ContainerDriver_CONTAINERD, "fake"))
+}
+
+func (suite *FakeNodeSuite) TestStatsWatch() {
+	ctx, cancel := context.WithCancel(suite.ctx)
+	defer cancel()
+
+	stream, err := suite.client.StatsWatch(ctx, constants.SystemContainerdNamespace, common.ContainerDriver_CONTAINERD, 100*time.Millisecond)
+	suite.Require().NoError(err)
+
+	var timestamps []time.Time
+
+	for i := 0; i < 2; i++ {
+		var stats *osapi.Stats
+
+		stats, err = stream.Recv()
+		suite.Require().NoError(err)
+
+		suite.Require().Len(stats.Stats, 1)
+		suite.Assert().Equal("fake", stats.Stats[0].Id)
+		suite.Assert().EqualValues(1<<20, stats.Stats[0].MemoryUsage)
+		suite.Assert().EqualValues(4096, stats.Stats[0].IoReadBytes)
+
+		var timestamp time.Time
+
+		timestamp, err = ptypes.Timestamp(stats.Timestamp)
+		suite.Require().NoError(err)
+
+		timestamps = append(timestamps, timestamp)
+	}
+
+	suite.Assert().True(timestamps[1].After(timestamps[0]))
+}
+
+func (suite *FakeNodeSuite) TestExec() {
+	stream, err := suite.client.Exec(suite.ctx, &osapi.ExecStart{
+		Namespace: constants.SystemContainerdNamespace,
+		Driver:    common.ContainerDriver_CONTAINERD,
+		Id:        "fake",
+		Args:      []string{"echo", "hello"},
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(stream.Send(&osapi.ExecRequest{
+		Stdin:      []byte("world\n"),
+		CloseStdin: true,
+	}))
+
+	var stdout []byte
+
+	for {
+		var resp *osapi.ExecResponse
+
+		resp, err = stream.Recv()
+		suite.Require().NoError(err)
+
+		stdout = append(stdout, resp.Stdout...)
+
+		if resp.Exited {
+			suite.Assert().EqualValues(0, resp.ExitCode)
+
+			break
+		}
+	}
+
+	suite.Assert().Equal("echo hello\nworld\n", string(stdout))
+}
+
+func (suite *FakeNodeSuite) TestRead() {
+	suite.Require().NoError(os.MkdirAll(suite.node.Path("/etc"), 0755))
+	suite.Require().NoError(ioutil.WriteFile(suite.node.Path("/etc/hostname"), []byte("fake\n"), 0644))
+
+	r, errCh, err := suite.client.Read(suite.ctx, "/etc/hostname")
+	suite.Require().NoError(err)
+
+	b, err := ioutil.ReadAll(r)
+	suite.Require().NoError(err)
+	suite.Require().NoError(r.Close())
+	suite.Require().NoError(<-errCh)
+
+	suite.Assert().Equal("fake\n", string(b))
+}
+
+func (suite *FakeNodeSuite) TestLogs() {
+	suite.Require().NoError(retry(func() error {
+		_, err := os.Stat(filepath.Join(suite.node.Path(constants.DefaultLogPath), "fake.log"))
+
+		return err
+	}))
+
+	stream, err := suite.client.Logs(suite.ctx, constants.SystemContainerdNamespace, common.ContainerDriver_CONTAINERD, "fake", false, -1)
+	suite.Require().NoError(err)
+
+	r, errCh, err := client.ReadStream(stream)
+	suite.Require().NoError(err)
+
+	b, err := ioutil.ReadAll(r)
+	suite.Require().NoError(err)
+	suite.Require().NoError(r.Close())
+	suite.Require().NoError(<-errCh)
+
+	suite.Assert().Equal("hello from fake\n", string(b))
+}
+
+func (suite *FakeNodeSuite) TestImages() {
+	const reference = "docker.io/autonomy/pulled:latest"
+
+	pullResp, err := suite.client.ImagePull(suite.ctx, constants.SystemContainerdNamespace, reference)
+	suite.Require().NoError(err)
+	suite.Require().Len(pullResp.Messages, 1)
+	suite.Assert().Equal(reference, pullResp.Messages[0].Image.Name)
+	suite.Assert().NotEmpty(pullResp.Messages[0].Image.Digest)
+
+	suite.Assert().Contains(suite.listImages(), reference)
+
+	_, err = suite.client.ImageRemove(suite.ctx, constants.SystemContainerdNamespace, reference)
+	suite.Require().NoError(err)
+
+	suite.Assert().NotContains(suite.listImages(), reference)
+
+	_, err = suite.client.ImageRemove(suite.ctx, constants.SystemContainerdNamespace, reference)
+	suite.Assert().Error(err)
+}
+
+func (suite *FakeNodeSuite) TestImageImport() {
+	// several chunks of the import stream
+	data := bytes.Repeat([]byte("fake image layer"), 16*1024)
+
+	resp, err := suite.client.ImageImport(suite.ctx, constants.SystemContainerdNamespace, bytes.NewReader(data))
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Images, 1)
+	suite.Assert().Equal(fakenode.ImportedImageName, resp.Images[0].Name)
+	suite.Assert().Equal(fmt.Sprintf("sha256:%x", sha256.Sum256(data)), resp.Images[0].Digest)
+	suite.Assert().EqualValues(len(data), resp.Images[0].Size)
+
+	suite.Assert().Contains(suite.listImages(), fakenode.ImportedImageName)
+
+	_, err = suite.client.ImageRemove(suite.ctx, constants.SystemContainerdNamespace, fakenode.ImportedImageName)
+	suite.Require().NoError(err)
+}
+
+func (suite *FakeNodeSuite) listImages() []string {
+	resp, err := suite.client.ImageList(suite.ctx, constants.SystemContainerdNamespace)
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Messages, 1)
+
+	names := make([]string, 0, len(resp.Messages[0].Images))
+
+	for _, image := range resp.Messages[0].Images {
+		names = append(names, image.Name)
+	}
+
+	return names
+}
+
+func retry(f func() error) (err error) {
+	for i := 0; i < 50; i++ {
+		if err = f(); err == nil {
+			return nil
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return err
+}
+
+func TestFakeNodeSuite(t *testing.T) {
+	suite.Run(t, new(FakeNodeSuite))
+}
@@ -0,0 +1,141 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package fakenode
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	imageapi "github.com/talos-systems/talos/api/image"
+)
+
+// ImportedImageName is the name of the image created by the fake Import.
+const ImportedImageName = "docker.io/autonomy/imported:latest"
+
+// ImageService implements imageapi.ImageServiceServer over in-memory image lists.
+//
+// Pulled images get a digest derived from the reference, Import doesn't parse
+// the tarball, it creates a single image with the digest and size of the
+// streamed data.
+type ImageService struct {
+	mu     sync.Mutex
+	images map[string]map[string]*imageapi.ImageInfo
+}
+
+// List implements the imageapi.ImageServiceServer interface.
+func (s *ImageService) List(ctx context.Context, in *imageapi.ImageListRequest) (*imageapi.ImageListResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	infos := make([]*imageapi.ImageInfo, 0, len(s.images[in.Namespace]))
+
+	for _, info := range s.images[in.Namespace] {
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+
+	return &imageapi.ImageListResponse{
+		Messages: []*imageapi.ImageList{
+			{
+				Images: infos,
+			},
+		},
+	}, nil
+}
+
+// Pull implements the imageapi.ImageServiceServer interface.
+func (s *ImageService) Pull(ctx context.Context, in *imageapi.ImagePullRequest) (*imageapi.ImagePullResponse, error) {
+	info := s.add(in.Namespace, in.Reference, []byte(in.Reference))
+
+	return &imageapi.ImagePullResponse{
+		Messages: []*imageapi.ImagePull{
+			{
+				Image: info,
+			},
+		},
+	}, nil
+}
+
+// Remove implements the imageapi.ImageServiceServer interface.
+func (s *ImageService) Remove(ctx context.Context, in *imageapi.ImageRemoveRequest) (*imageapi.ImageRemoveResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.images[in.Namespace][in.Reference]; !ok {
+		return nil, status.Errorf(codes.NotFound, "image %q not found", in.Reference)
+	}
+
+	delete(s.images[in.Namespace], in.Reference)
+
+	return &imageapi.ImageRemoveResponse{
+		Messages: []*imageapi.ImageRemove{
+			{},
+		},
+	}, nil
+}
+
+// Import implements the imageapi.ImageServiceServer interface.
+func (s *ImageService) Import(srv imageapi.ImageService_ImportServer) error {
+	var (
+		namespace string
+		data      []byte
+	)
+
+	for {
+		req, err := srv.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if req.Namespace != "" {
+			namespace = req.Namespace
+		}
+
+		data = append(data, req.Data...)
+	}
+
+	return srv.SendAndClose(&imageapi.ImageImportResponse{
+		Images: []*imageapi.ImageInfo{
+			s.add(namespace, ImportedImageName, data),
+		},
+	})
+}
+
+func (s *ImageService) add(namespace, name string, content []byte) *imageapi.ImageInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info := &imageapi.ImageInfo{
+		Name:      name,
+		Digest:    fmt.Sprintf("sha256:%x", sha256.Sum256(content)),
+		Size:      int64(len(content)),
+		CreatedAt: ptypes.TimestampNow(),
+	}
+
+	if s.images == nil {
+		s.images = map[string]map[string]*imageapi.ImageInfo{}
+	}
+
+	if s.images[namespace] == nil {
+		s.images[namespace] = map[string]*imageapi.ImageInfo{}
+	}
+
+	s.images[namespace][name] = info
+
+	return info
+}
@@ -0,0 +1,178 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package fakenode
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/talos-systems/talos/internal/pkg/containers"
+)
+
+// Inspector implements containers.Inspector over the pods of the fake node.
+type Inspector struct {
+	node      *Node
+	namespace string
+}
+
+// Pods collects information about running pods & containers.
+func (i *Inspector) Pods() ([]*containers.Pod, error) {
+	i.node.mu.Lock()
+	defer i.node.mu.Unlock()
+
+	pods := i.node.pods[i.namespace]
+
+	for _, pod := range pods {
+		for _, container := range pod.Containers {
+			container.Inspector = i
+		}
+	}
+
+	return pods, nil
+}
+
+// Container returns info about a single container.
+//
+// If container is not found, Container returns nil
+func (i *Inspector) Container(id string) (*containers.Container, error) {
+	pods, err := i.Pods()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pod := range pods {
+		for _, container := range pod.Containers {
+			if container.ID == id || container.Display == id {
+				return container, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// Images returns a hash of image digest -> name.
+func (i *Inspector) Images() (map[string]string, error) {
+	pods, err := i.Pods()
+	if err != nil {
+		return nil, err
+	}
+
+	images := map[string]string{}
+
+	for _, pod := range pods {
+		for _, container := range pod.Containers {
+			if container.Digest != "" {
+				images[container.Digest] = container.Image
+			}
+		}
+	}
+
+	return images, nil
+}
+
+// Close frees associated resources.
+func (i *Inspector) Close() error {
+	return nil
+}
+
+// GetProcessStderr returns the path to the container log.
+func (i *Inspector) GetProcessStderr(id string) (string, error) {
+	container, err := i.Container(id)
+	if err != nil {
+		return "", err
+	}
+
+	if container == nil {
+		return "", fmt.Errorf("container %q not found", id)
+	}
+
+	return container.GetLogFile(), nil
+}
+
+// Kill simulates container restart by bumping the restart counter.
+func (i *Inspector) Kill(id string, isPodSandbox bool, signal syscall.Signal) error {
+	container, err := i.Container(id)
+	if err != nil {
+		return err
+	}
+
+	if container == nil {
+		return fmt.Errorf("container %q not found", id)
+	}
+
+	i.node.mu.Lock()
+	defer i.node.mu.Unlock()
+
+	// nolint: errcheck
+	restarts, _ := strconv.Atoi(container.RestartCount)
+	container.RestartCount = strconv.Itoa(restarts + 1)
+
+	return nil
+}
+
+// Exec simulates the process in the container which echoes its arguments and stdin.
+func (i *Inspector) Exec(id string, options containers.ExecOptions) (containers.Process, error) {
+	container, err := i.Container(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if container == nil {
+		return nil, fmt.Errorf("container %q not found", id)
+	}
+
+	p := &process{
+		done: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(p.done)
+
+		// nolint: errcheck
+		fmt.Fprintln(options.Stdout, strings.Join(options.Args, " "))
+
+		if options.Stdin != nil {
+			// nolint: errcheck
+			io.Copy(options.Stdout, options.Stdin)
+		}
+	}()
+
+	return p, nil
+}
+
+// process is a fake process started with Exec.
+type process struct {
+	done chan struct{}
+}
+
+func (p *process) Resize(width, height uint32) error {
+	return nil
+}
+
+func (p *process) CloseStdin() error {
+	return nil
+}
+
+func (p *process) Kill(signal syscall.Signal) error {
+	return nil
+}
+
+func (p *process) Wait(ctx context.Context) (uint32, error) {
+	select {
+	case <-p.done:
+		return 0, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+func (p *process) Close() error {
+	return nil
+}
@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package fakenode
+
+import (
+	"net"
+
+	"github.com/talos-systems/go-procfs/procfs"
+
+	"github.com/talos-systems/talos/internal/pkg/runtime"
+)
+
+// Platform is the runtime.Platform of the fake node.
+type Platform struct{}
+
+// Name implements the platform.Platform interface.
+func (p *Platform) Name() string {
+	return "fake"
+}
+
+// Configuration implements the platform.Platform interface.
+func (p *Platform) Configuration() ([]byte, error) {
+	return nil, nil
+}
+
+// Hostname implements the platform.Platform interface.
+func (p *Platform) Hostname() ([]byte, error) {
+	return nil, nil
+}
+
+// Mode implements the platform.Platform interface.
+func (p *Platform) Mode() runtime.Mode {
+	return runtime.Container
+}
+
+// ExternalIPs implements the platform.Platform interface.
+func (p *Platform) ExternalIPs() ([]net.IP, error) {
+	return []net.IP{net.ParseIP("127.0.0.1")}, nil
+}
+
+// KernelArgs implements the platform.Platform interface.
+func (p *Platform) KernelArgs() procfs.Parameters {
+	return []*procfs.Parameter{}
+}
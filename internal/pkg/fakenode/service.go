@@ -0,0 +1,138 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package fakenode
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/events"
+	"github.com/talos-systems/talos/internal/app/machined/pkg/system/runner"
+	"github.com/talos-systems/talos/internal/pkg/conditions"
+	"github.com/talos-systems/talos/internal/pkg/runtime"
+)
+
+// Step is a single scripted service state transition.
+type Step struct {
+	// Delay is the time to wait before the transition.
+	Delay   time.Duration
+	State   events.ServiceState
+	Message string
+}
+
+// Service is a scripted system service.
+//
+// Once started, the service goes through the Steps and then keeps running
+// until it is stopped. Log is written to the service log file, so that it
+// can be retrieved with the Logs API.
+type Service struct {
+	Name         string
+	Steps        []Step
+	Log          string
+	Dependencies []string
+
+	logPath string
+}
+
+// ID implements the Service interface.
+func (s *Service) ID(config runtime.Configurator) string {
+	return s.Name
+}
+
+// PreFunc implements the Service interface.
+func (s *Service) PreFunc(ctx context.Context, config runtime.Configurator) error {
+	return ioutil.WriteFile(s.logPath, []byte(s.Log), 0644)
+}
+
+// PostFunc implements the Service interface.
+func (s *Service) PostFunc(config runtime.Configurator, state events.ServiceState) (err error) {
+	return nil
+}
+
+// Condition implements the Service interface.
+func (s *Service) Condition(config runtime.Configurator) conditions.Condition {
+	return nil
+}
+
+// DependsOn implements the Service interface.
+func (s *Service) DependsOn(config runtime.Configurator) []string {
+	return s.Dependencies
+}
+
+// Runner implements the Service interface.
+func (s *Service) Runner(config runtime.Configurator) (runner.Runner, error) {
+	steps := s.Steps
+	if len(steps) == 0 {
+		steps = []Step{{State: events.StateRunning, Message: "Service started"}}
+	}
+
+	return &scriptedRunner{
+		name:   s.Name,
+		steps:  steps,
+		stopCh: make(chan struct{}),
+	}, nil
+}
+
+// APIStartAllowed implements the APIStartableService interface.
+func (s *Service) APIStartAllowed(config runtime.Configurator) bool {
+	return true
+}
+
+// APIStopAllowed implements the APIStoppableService interface.
+func (s *Service) APIStopAllowed(config runtime.Configurator) bool {
+	return true
+}
+
+// APIRestartAllowed implements the APIRestartableService interface.
+func (s *Service) APIRestartAllowed(config runtime.Configurator) bool {
+	return true
+}
+
+// scriptedRunner replays the service steps.
+type scriptedRunner struct {
+	name   string
+	steps  []Step
+	stopCh chan struct{}
+}
+
+// Open implements the Runner interface.
+func (r *scriptedRunner) Open(ctx context.Context) error {
+	return nil
+}
+
+// Run implements the Runner interface.
+func (r *scriptedRunner) Run(eventSink events.Recorder) error {
+	for _, step := range r.steps {
+		select {
+		case <-r.stopCh:
+			return nil
+		case <-time.After(step.Delay):
+		}
+
+		eventSink(step.State, step.Message)
+	}
+
+	<-r.stopCh
+
+	return nil
+}
+
+// Stop implements the Runner interface.
+func (r *scriptedRunner) Stop() error {
+	close(r.stopCh)
+
+	return nil
+}
+
+// Close implements the Runner interface.
+func (r *scriptedRunner) Close() error {
+	return nil
+}
+
+func (r *scriptedRunner) String() string {
+	return fmt.Sprintf("Scripted(%q)", r.name)
+}
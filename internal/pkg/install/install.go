@@ -45,7 +45,7 @@ func RunInstallerContainer(r runtime.Runtime, opts ...Option) error {
 	var img containerd.Image
 
 	if options.ImagePull {
-		img, err = image.Pull(ctx, r.Config().Machine().Registries(), client, r.Config().Machine().Install().Image())
+		img, err = image.Pull(ctx, r.Config().Machine().Registries(), client, r.Config().Machine().Install().Image(), image.WithPolicy(r.Config().Machine().ImagePolicy()))
 		if err != nil {
 			return err
 		}
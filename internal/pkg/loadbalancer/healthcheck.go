@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package loadbalancer
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+// HealthCheck checks health of the upstream at addr (host:port).
+type HealthCheck func(ctx context.Context, addr string) error
+
+// TCPHealthCheck is a health check which attempts to establish TCP connection to the upstream.
+func TCPHealthCheck(ctx context.Context, addr string) error {
+	d := net.Dialer{}
+
+	c, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return c.Close()
+}
+
+// HTTPSHealthCheck returns a health check which does HTTPS GET request to the path of the upstream,
+// and expects HTTP status 200 in the response.
+//
+// TLS configuration should have RootCAs set to verify the upstream certificate
+// (e.g. to the Kubernetes CA for kube-apiserver `/healthz`).
+func HTTPSHealthCheck(path string, tlsConfig *tls.Config) HealthCheck {
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:   tlsConfig,
+			DisableKeepAlives: true,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return func(ctx context.Context, addr string) error {
+		u := url.URL{
+			Scheme: "https",
+			Host:   addr,
+			Path:   path,
+		}
+
+		req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Do(req.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+
+		defer resp.Body.Close() //nolint: errcheck
+
+		io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096)) //nolint: errcheck
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("unexpected HTTP status %q", resp.Status)
+		}
+
+		return nil
+	}
+}
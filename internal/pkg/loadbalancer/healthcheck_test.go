@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package loadbalancer_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/internal/pkg/loadbalancer"
+)
+
+type HealthCheckSuite struct {
+	suite.Suite
+
+	server *httptest.Server
+	addr   string
+}
+
+func (suite *HealthCheckSuite) SetupSuite() {
+	suite.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.Write([]byte("ok")) //nolint: errcheck
+
+			return
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	suite.addr = strings.TrimPrefix(suite.server.URL, "https://")
+}
+
+func (suite *HealthCheckSuite) TearDownSuite() {
+	suite.server.Close()
+}
+
+func (suite *HealthCheckSuite) check(healthCheck loadbalancer.HealthCheck, addr string) error {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), time.Second)
+	defer ctxCancel()
+
+	return healthCheck(ctx, addr)
+}
+
+func (suite *HealthCheckSuite) TestTCP() {
+	suite.Assert().NoError(suite.check(loadbalancer.TCPHealthCheck, suite.addr))
+
+	addr, err := findListenAddress()
+	suite.Require().NoError(err)
+
+	suite.Assert().Error(suite.check(loadbalancer.TCPHealthCheck, addr))
+}
+
+func (suite *HealthCheckSuite) TestHTTPS() {
+	pool := x509.NewCertPool()
+	pool.AddCert(suite.server.Certificate())
+
+	tlsConfig := &tls.Config{RootCAs: pool}
+
+	suite.Assert().NoError(suite.check(loadbalancer.HTTPSHealthCheck("/healthz", tlsConfig), suite.addr))
+	suite.Assert().EqualError(suite.check(loadbalancer.HTTPSHealthCheck("/livez", tlsConfig), suite.addr), `unexpected HTTP status "500 Internal Server Error"`)
+
+	// upstream certificate is not trusted
+	suite.Assert().Error(suite.check(loadbalancer.HTTPSHealthCheck("/healthz", &tls.Config{RootCAs: x509.NewCertPool()}), suite.addr))
+}
+
+func TestHealthCheckSuite(t *testing.T) {
+	suite.Run(t, new(HealthCheckSuite))
+}
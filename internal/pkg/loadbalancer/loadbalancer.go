@@ -7,8 +7,11 @@ package loadbalancer
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
+	"sync"
+	"time"
 
 	"inet.af/tcpproxy"
 
@@ -25,29 +28,44 @@ import (
 // Usage: call Run() to start lb and wait for shutdown, call Close() to shutdown lb.
 type TCP struct {
 	tcpproxy.Proxy
+
+	// DrainTimeout is the time connections to the unhealthy upstream are kept open.
+	//
+	// New connections are never sent to the unhealthy upstream. If DrainTimeout is zero,
+	// existing connections are not closed.
+	DrainTimeout time.Duration
+
+	routesMu sync.Mutex
+	routes   map[string]*route
 }
 
-type lbUpstream string
+type route struct {
+	list        *upstream.List
+	healthCheck HealthCheck
+	upstreams   map[string]*lbUpstream
+}
 
-func (upstream lbUpstream) HealthCheck(ctx context.Context) error {
-	d := net.Dialer{}
+type lbUpstream struct {
+	addr        string
+	healthCheck HealthCheck
+}
 
-	c, err := d.DialContext(ctx, "tcp", string(upstream))
+func (upstream *lbUpstream) HealthCheck(ctx context.Context) error {
+	err := upstream.healthCheck(ctx, upstream.addr)
 	if err != nil {
-		log.Printf("healthcheck failed for %q: %s", string(upstream), err)
-
-		return err
+		log.Printf("healthcheck failed for %q: %s", upstream.addr, err)
 	}
 
-	return c.Close()
+	return err
 }
 
 type lbTarget struct {
-	list *upstream.List
+	list         *upstream.List
+	drainTimeout time.Duration
 }
 
 func (target *lbTarget) HandleConn(conn net.Conn) {
-	upstreamBackend, err := target.list.Pick()
+	lease, err := target.list.Acquire()
 	if err != nil {
 		log.Printf("no upstreams available, closing connection from %s", conn.RemoteAddr())
 		conn.Close() //nolint: errcheck
@@ -55,38 +73,181 @@ func (target *lbTarget) HandleConn(conn net.Conn) {
 		return
 	}
 
-	upstreamAddr := upstreamBackend.(lbUpstream) //nolint: errcheck
+	defer lease.Release()
+
+	upstreamBackend := lease.Backend.(*lbUpstream) //nolint: errcheck
 
-	log.Printf("proxying connection %s -> %s", conn.RemoteAddr(), string(upstreamAddr))
+	log.Printf("proxying connection %s -> %s", conn.RemoteAddr(), upstreamBackend.addr)
 
-	upstreamTarget := tcpproxy.To(string(upstreamAddr))
+	upstreamTarget := tcpproxy.To(upstreamBackend.addr)
 	upstreamTarget.OnDialError = func(src net.Conn, dstDialErr error) {
 		src.Close() //nolint: errcheck
 
-		log.Printf("error dialing upstream %s: %s", string(upstreamAddr), dstDialErr)
+		log.Printf("error dialing upstream %s: %s", upstreamBackend.addr, dstDialErr)
 
 		target.list.Down(upstreamBackend)
 	}
 
+	if target.drainTimeout > 0 {
+		done := make(chan struct{})
+		defer close(done)
+
+		go target.drain(conn, lease, upstreamBackend.addr, done)
+	}
+
 	upstreamTarget.HandleConn(conn)
 }
 
+// drain closes the connection once drain timeout expires after the upstream went unhealthy.
+func (target *lbTarget) drain(conn net.Conn, lease *upstream.Lease, upstreamAddr string, done <-chan struct{}) {
+	select {
+	case <-done:
+		return
+	case <-lease.Draining():
+	}
+
+	timer := time.NewTimer(target.drainTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+	case <-timer.C:
+		log.Printf("closing connection %s -> %s: upstream is unhealthy", conn.RemoteAddr(), upstreamAddr)
+		conn.Close() //nolint: errcheck
+	}
+}
+
 // AddRoute installs load balancer route from listen address ipAddr to list of upstreams.
 //
 // TCP automatically does background health checks for the upstreams and picks only healthy
 // ones. Healthcheck is simple Dial attempt.
 func (t *TCP) AddRoute(ipPort string, upstreamAddrs []string, options ...upstream.ListOption) error {
-	upstreams := make([]upstream.Backend, len(upstreamAddrs))
-	for i := range upstreams {
-		upstreams[i] = lbUpstream(upstreamAddrs[i])
+	return t.AddRouteWithHealthCheck(ipPort, upstreamAddrs, TCPHealthCheck, options...)
+}
+
+// AddRouteWithHealthCheck installs load balancer route from listen address ipAddr to list of upstreams
+// using custom health check for the upstreams.
+func (t *TCP) AddRouteWithHealthCheck(ipPort string, upstreamAddrs []string, healthCheck HealthCheck, options ...upstream.ListOption) error {
+	r := &route{
+		healthCheck: healthCheck,
+		upstreams:   make(map[string]*lbUpstream),
 	}
 
-	list, err := upstream.NewList(upstreams, options...)
+	var err error
+
+	r.list, err = upstream.NewList(r.backends(upstreamAddrs), options...)
 	if err != nil {
 		return err
 	}
 
-	t.Proxy.AddRoute(ipPort, &lbTarget{list: list})
+	t.routesMu.Lock()
+
+	if t.routes == nil {
+		t.routes = make(map[string]*route)
+	}
+
+	t.routes[ipPort] = r
+
+	t.routesMu.Unlock()
+
+	t.Proxy.AddRoute(ipPort, &lbTarget{list: r.list, drainTimeout: t.DrainTimeout})
 
 	return nil
 }
+
+// ReconcileRoute updates the list of upstreams for the route installed with AddRoute.
+//
+// Upstreams which stay in the list keep their health state, connections to the removed upstreams
+// are drained.
+func (t *TCP) ReconcileRoute(ipPort string, upstreamAddrs []string) error {
+	t.routesMu.Lock()
+	defer t.routesMu.Unlock()
+
+	r := t.routes[ipPort]
+	if r == nil {
+		return fmt.Errorf("no route for %q", ipPort)
+	}
+
+	r.list.Reconcile(r.backends(upstreamAddrs))
+
+	return nil
+}
+
+// backends returns backends for the upstream addresses, reusing the existing ones.
+func (r *route) backends(upstreamAddrs []string) []upstream.Backend {
+	upstreams := make(map[string]*lbUpstream, len(upstreamAddrs))
+	backends := make([]upstream.Backend, 0, len(upstreamAddrs))
+
+	for _, addr := range upstreamAddrs {
+		if _, ok := upstreams[addr]; ok {
+			// skip duplicates
+			continue
+		}
+
+		backend := r.upstreams[addr]
+		if backend == nil {
+			backend = &lbUpstream{
+				addr:        addr,
+				healthCheck: r.healthCheck,
+			}
+		}
+
+		upstreams[addr] = backend
+		backends = append(backends, backend)
+	}
+
+	r.upstreams = upstreams
+
+	return backends
+}
+
+// Close stops the load balancer and the upstream health checks.
+func (t *TCP) Close() error {
+	err := t.Proxy.Close()
+
+	t.routesMu.Lock()
+	defer t.routesMu.Unlock()
+
+	for _, r := range t.routes {
+		r.list.Shutdown()
+	}
+
+	return err
+}
+
+// UpstreamStats is a snapshot of upstream state.
+type UpstreamStats struct {
+	Addr    string
+	Healthy bool
+	Score   float64
+
+	ActiveConnections int64
+	TotalConnections  int64
+}
+
+// Stats returns state of the upstreams for each route (listen address).
+func (t *TCP) Stats() map[string][]UpstreamStats {
+	t.routesMu.Lock()
+	defer t.routesMu.Unlock()
+
+	result := make(map[string][]UpstreamStats, len(t.routes))
+
+	for ipPort, r := range t.routes {
+		backendStats := r.list.Stats()
+		stats := make([]UpstreamStats, len(backendStats))
+
+		for i := range backendStats {
+			stats[i] = UpstreamStats{
+				Addr:              backendStats[i].Backend.(*lbUpstream).addr,
+				Healthy:           backendStats[i].Healthy,
+				Score:             backendStats[i].Score,
+				ActiveConnections: backendStats[i].ActiveConnections,
+				TotalConnections:  backendStats[i].TotalConnections,
+			}
+		}
+
+		result[ipPort] = stats
+	}
+
+	return result
+}
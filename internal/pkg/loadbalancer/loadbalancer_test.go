@@ -164,6 +164,19 @@ func (suite *TCPSuite) TestBalancer() {
 	// worst case: score = 3 (highScore) to go to -1 requires 5 requests
 	suite.Assert().Less(failedRequests, 5) // no more than 5 requests should fail
 
+	stats := lb.Stats()[listenAddr]
+	suite.Require().Len(stats, upstreamCount)
+
+	var totalConnections int64
+
+	for i := range stats {
+		suite.Assert().Equal(upstreamAddrs[i], stats[i].Addr)
+
+		totalConnections += stats[i].TotalConnections
+	}
+
+	suite.Assert().EqualValues(12*upstreamCount, totalConnections)
+
 	suite.Require().NoError(lb.Close())
 	wg.Wait()
 
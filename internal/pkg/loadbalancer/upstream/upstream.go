@@ -20,6 +20,46 @@ type Backend interface {
 type node struct {
 	backend Backend
 	score   float64
+
+	// number of active (acquired) and total connections
+	active, total int64
+
+	// drain is closed when backend goes unhealthy
+	drain chan struct{}
+}
+
+// Strategy defines the way backend is picked from the list of healthy backends.
+type Strategy int
+
+// Pick strategies.
+const (
+	// RoundRobin picks healthy backends in turns.
+	RoundRobin Strategy = iota
+	// LeastConnections picks healthy backend with the least number of active connections,
+	// ties are resolved in round-robin fashion.
+	LeastConnections
+)
+
+func (s Strategy) String() string {
+	switch s {
+	case RoundRobin:
+		return "round-robin"
+	case LeastConnections:
+		return "least-connections"
+	default:
+		return fmt.Sprintf("Strategy(%d)", int(s))
+	}
+}
+
+// ParseStrategy parses strategy name.
+func ParseStrategy(name string) (Strategy, error) {
+	for _, s := range []Strategy{RoundRobin, LeastConnections} {
+		if s.String() == name {
+			return s, nil
+		}
+	}
+
+	return RoundRobin, fmt.Errorf("unknown strategy %q", name)
 }
 
 // ListOption allows to configure List.
@@ -81,6 +121,21 @@ func WithHealthcheckInterval(interval time.Duration) ListOption {
 	}
 }
 
+// WithStrategy configures strategy to pick the backend.
+func WithStrategy(strategy Strategy) ListOption {
+	return func(l *List) error {
+		switch strategy {
+		case RoundRobin, LeastConnections:
+		default:
+			return fmt.Errorf("unsupported strategy %s", strategy)
+		}
+
+		l.strategy = strategy
+
+		return nil
+	}
+}
+
 // WithHealthcheckTimeout configures healthcheck timeout (for each backend).
 func WithHealthcheckTimeout(timeout time.Duration) ListOption {
 	return func(l *List) error {
@@ -100,11 +155,17 @@ func WithHealthcheckTimeout(timeout time.Duration) ListOption {
 // by fail delta score, and every successful check updates score by success score delta (defaults are -1/+1).
 //
 // Backend might be used if its score is not negative.
+//
+// Backends are picked with round-robin strategy by default. Backends returned by Acquire
+// are tracked as active connections until released, and connections to the backend which goes
+// unhealthy are notified to be drained.
 type List struct {
 	lowScore, highScore               float64
 	failScoreDelta, successScoreDelta float64
 	initialScore                      float64
 
+	strategy Strategy
+
 	healthcheckInterval time.Duration
 	healthcheckTimeout  time.Duration
 
@@ -150,6 +211,11 @@ func NewList(upstreams []Backend, options ...ListOption) (*List, error) {
 	for i := range list.nodes {
 		list.nodes[i].backend = upstreams[i]
 		list.nodes[i].score = list.initialScore
+		list.nodes[i].drain = make(chan struct{})
+
+		if list.nodes[i].score < 0 {
+			close(list.nodes[i].drain)
+		}
 	}
 
 	list.healthWg.Add(1)
@@ -173,10 +239,7 @@ func (list *List) Up(upstream Backend) {
 
 	for i := range list.nodes {
 		if list.nodes[i].backend == upstream {
-			list.nodes[i].score += list.successScoreDelta
-			if list.nodes[i].score > list.highScore {
-				list.nodes[i].score = list.highScore
-			}
+			list.setScore(i, list.nodes[i].score+list.successScoreDelta)
 		}
 	}
 }
@@ -188,33 +251,214 @@ func (list *List) Down(upstream Backend) {
 
 	for i := range list.nodes {
 		if list.nodes[i].backend == upstream {
-			list.nodes[i].score += list.failScoreDelta
-			if list.nodes[i].score < list.lowScore {
-				list.nodes[i].score = list.lowScore
-			}
+			list.setScore(i, list.nodes[i].score+list.failScoreDelta)
+		}
+	}
+}
+
+// setScore updates node score within low and high scores.
+//
+// When node goes unhealthy, drain channel is closed, and it is replaced when node gets healthy again.
+// setScore should be called with the mutex held.
+func (list *List) setScore(i int, score float64) {
+	if score < list.lowScore {
+		score = list.lowScore
+	}
+
+	if score > list.highScore {
+		score = list.highScore
+	}
+
+	wasHealthy := list.nodes[i].score >= 0
+	list.nodes[i].score = score
+
+	switch {
+	case wasHealthy && score < 0:
+		close(list.nodes[i].drain)
+	case !wasHealthy && score >= 0:
+		list.nodes[i].drain = make(chan struct{})
+	}
+}
+
+// Reconcile updates the list of backends.
+//
+// Backends which are already in the list keep their score and connection counters,
+// new backends are added with the initial score. Connections to the removed backends are
+// notified to be drained.
+func (list *List) Reconcile(upstreams []Backend) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	existing := make(map[Backend]node, len(list.nodes))
+
+	for _, node := range list.nodes {
+		existing[node.backend] = node
+	}
+
+	nodes := make([]node, 0, len(upstreams))
+
+	for _, backend := range upstreams {
+		if n, ok := existing[backend]; ok {
+			nodes = append(nodes, n)
+			delete(existing, backend)
+
+			continue
+		}
+
+		n := node{
+			backend: backend,
+			score:   list.initialScore,
+			drain:   make(chan struct{}),
+		}
+
+		if n.score < 0 {
+			close(n.drain)
 		}
+
+		nodes = append(nodes, n)
 	}
+
+	for _, removed := range existing {
+		if removed.score >= 0 {
+			close(removed.drain)
+		}
+	}
+
+	list.nodes = nodes
+	list.current = -1
 }
 
 // Pick returns next backend to be used.
 //
-// Default policy is to pick healthy (non-negative score) backend in
-// round-robin fashion.
+// Backend is picked from healthy (non-negative score) backends according to
+// the strategy (round-robin by default).
 func (list *List) Pick() (Backend, error) {
 	list.mu.Lock()
 	defer list.mu.Unlock()
 
+	i, err := list.pick()
+	if err != nil {
+		return nil, err
+	}
+
+	return list.nodes[i].backend, nil
+}
+
+// Lease is a backend acquired for a connection.
+type Lease struct {
+	Backend Backend
+
+	list    *List
+	drain   <-chan struct{}
+	release sync.Once
+}
+
+// Draining returns a channel which is closed when the backend goes unhealthy.
+//
+// Connections to the draining backend should be closed gracefully.
+func (lease *Lease) Draining() <-chan struct{} {
+	return lease.drain
+}
+
+// Release should be called when connection to the backend is closed.
+func (lease *Lease) Release() {
+	lease.release.Do(func() {
+		lease.list.mu.Lock()
+		defer lease.list.mu.Unlock()
+
+		for i := range lease.list.nodes {
+			if lease.list.nodes[i].backend == lease.Backend {
+				lease.list.nodes[i].active--
+			}
+		}
+	})
+}
+
+// Acquire picks next backend to be used (same as Pick) and tracks it as an active connection.
+//
+// Lease should be released when the connection is closed.
+func (list *List) Acquire() (*Lease, error) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	i, err := list.pick()
+	if err != nil {
+		return nil, err
+	}
+
+	list.nodes[i].active++
+	list.nodes[i].total++
+
+	return &Lease{
+		Backend: list.nodes[i].backend,
+		list:    list,
+		drain:   list.nodes[i].drain,
+	}, nil
+}
+
+// pick should be called with the mutex held.
+func (list *List) pick() (int, error) {
+	picked := -1
+
 	for j := 0; j < len(list.nodes); j++ {
 		i := (list.current + 1 + j) % len(list.nodes)
 
-		if list.nodes[i].score >= 0 {
-			list.current = i
+		if list.nodes[i].score < 0 {
+			continue
+		}
+
+		if picked == -1 {
+			picked = i
+
+			if list.strategy == RoundRobin {
+				break
+			}
+
+			continue
+		}
+
+		if list.nodes[i].active < list.nodes[picked].active {
+			picked = i
+		}
+	}
+
+	if picked == -1 {
+		return -1, fmt.Errorf("no upstreams available")
+	}
+
+	list.current = picked
+
+	return picked, nil
+}
+
+// BackendStats is a snapshot of backend state.
+type BackendStats struct {
+	Backend Backend
+	Score   float64
+	Healthy bool
+
+	ActiveConnections int64
+	TotalConnections  int64
+}
+
+// Stats returns current state of the backends in the list.
+func (list *List) Stats() []BackendStats {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	stats := make([]BackendStats, len(list.nodes))
 
-			return list.nodes[list.current].backend, nil
+	for i, node := range list.nodes {
+		stats[i] = BackendStats{
+			Backend:           node.backend,
+			Score:             node.score,
+			Healthy:           node.score >= 0,
+			ActiveConnections: node.active,
+			TotalConnections:  node.total,
 		}
 	}
 
-	return nil, fmt.Errorf("no upstreams available")
+	return stats
 }
 
 func (list *List) healthcheck() {
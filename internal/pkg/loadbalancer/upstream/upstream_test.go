@@ -187,6 +187,189 @@ func (suite *ListSuite) TestHealthcheck() {
 	}))
 }
 
+func (suite *ListSuite) TestLeastConnections() {
+	l, err := upstream.NewList(
+		[]upstream.Backend{
+			mockBackend("one"),
+			mockBackend("two"),
+			mockBackend("three"),
+		},
+		upstream.WithStrategy(upstream.LeastConnections),
+		upstream.WithHealthcheckInterval(time.Hour),
+	)
+	suite.Require().NoError(err)
+
+	defer l.Shutdown()
+
+	lease1, err := l.Acquire()
+	suite.Require().NoError(err)
+	suite.Assert().Equal(mockBackend("one"), lease1.Backend)
+
+	lease2, err := l.Acquire()
+	suite.Require().NoError(err)
+	suite.Assert().Equal(mockBackend("two"), lease2.Backend)
+
+	lease3, err := l.Acquire()
+	suite.Require().NoError(err)
+	suite.Assert().Equal(mockBackend("three"), lease3.Backend)
+
+	lease2.Release()
+	lease2.Release() // second release is no-op
+
+	// "two" has the least connections now
+	lease, err := l.Acquire()
+	suite.Require().NoError(err)
+	suite.Assert().Equal(mockBackend("two"), lease.Backend)
+
+	lease1.Release()
+	lease3.Release()
+
+	// "one" and "three" have zero connections, round-robin between them
+	lease, err = l.Acquire()
+	suite.Require().NoError(err)
+	suite.Assert().Equal(mockBackend("three"), lease.Backend)
+
+	lease, err = l.Acquire()
+	suite.Require().NoError(err)
+	suite.Assert().Equal(mockBackend("one"), lease.Backend)
+
+	stats := l.Stats()
+	suite.Require().Len(stats, 3)
+
+	for i, expected := range []struct {
+		active, total int64
+	}{
+		{1, 2},
+		{1, 2},
+		{1, 2},
+	} {
+		suite.Assert().Equal(expected.active, stats[i].ActiveConnections, "backend %d", i)
+		suite.Assert().Equal(expected.total, stats[i].TotalConnections, "backend %d", i)
+		suite.Assert().True(stats[i].Healthy)
+	}
+}
+
+func (suite *ListSuite) TestDraining() {
+	l, err := upstream.NewList(
+		[]upstream.Backend{
+			mockBackend("one"),
+			mockBackend("two"),
+		},
+		upstream.WithLowHighScores(-3, 3),
+		upstream.WithInitialScore(1),
+		upstream.WithScoreDeltas(-1, 1),
+		upstream.WithHealthcheckInterval(time.Hour),
+	)
+	suite.Require().NoError(err)
+
+	defer l.Shutdown()
+
+	lease, err := l.Acquire()
+	suite.Require().NoError(err)
+	suite.Assert().Equal(mockBackend("one"), lease.Backend)
+
+	defer lease.Release()
+
+	l.Down(mockBackend("one")) // score == 0
+
+	select {
+	case <-lease.Draining():
+		suite.Fail("backend is still healthy")
+	default:
+	}
+
+	l.Down(mockBackend("one")) // score == -1
+
+	select {
+	case <-lease.Draining():
+	default:
+		suite.Fail("backend should be draining")
+	}
+
+	suite.Assert().False(l.Stats()[0].Healthy)
+
+	l.Up(mockBackend("one")) // score == 0
+
+	newLease, err := l.Acquire()
+	suite.Require().NoError(err)
+	suite.Assert().Equal(mockBackend("two"), newLease.Backend)
+
+	newLease, err = l.Acquire()
+	suite.Require().NoError(err)
+	suite.Assert().Equal(mockBackend("one"), newLease.Backend)
+
+	// backend is healthy again, new connections are not draining
+	select {
+	case <-newLease.Draining():
+		suite.Fail("backend is healthy")
+	default:
+	}
+}
+
+func (suite *ListSuite) TestReconcile() {
+	l, err := upstream.NewList(
+		[]upstream.Backend{
+			mockBackend("one"),
+			mockBackend("two"),
+		},
+		upstream.WithLowHighScores(-3, 3),
+		upstream.WithInitialScore(1),
+		upstream.WithScoreDeltas(-1, 1),
+		upstream.WithHealthcheckInterval(time.Hour),
+	)
+	suite.Require().NoError(err)
+
+	defer l.Shutdown()
+
+	lease, err := l.Acquire()
+	suite.Require().NoError(err)
+	suite.Assert().Equal(mockBackend("one"), lease.Backend)
+
+	l.Down(mockBackend("two")) // score == 0
+	l.Down(mockBackend("two")) // score == -1
+
+	l.Reconcile([]upstream.Backend{mockBackend("two"), mockBackend("three")})
+
+	// "one" was removed, connections should be drained
+	select {
+	case <-lease.Draining():
+	default:
+		suite.Fail("removed backend should be draining")
+	}
+
+	lease.Release()
+
+	stats := l.Stats()
+	suite.Require().Len(stats, 2)
+
+	// "two" keeps its score
+	suite.Assert().Equal(mockBackend("two"), stats[0].Backend)
+	suite.Assert().False(stats[0].Healthy)
+
+	suite.Assert().Equal(mockBackend("three"), stats[1].Backend)
+	suite.Assert().True(stats[1].Healthy)
+	suite.Assert().EqualValues(1, stats[1].Score)
+
+	backend, err := l.Pick()
+	suite.Assert().NoError(err)
+	suite.Assert().Equal(mockBackend("three"), backend)
+
+	backend, err = l.Pick()
+	suite.Assert().NoError(err)
+	suite.Assert().Equal(mockBackend("three"), backend)
+}
+
+func (suite *ListSuite) TestParseStrategy() {
+	for _, strategy := range []upstream.Strategy{upstream.RoundRobin, upstream.LeastConnections} {
+		parsed, err := upstream.ParseStrategy(strategy.String())
+		suite.Require().NoError(err)
+		suite.Assert().Equal(strategy, parsed)
+	}
+
+	_, err := upstream.ParseStrategy("random")
+	suite.Assert().EqualError(err, `unknown strategy "random"`)
+}
+
 func TestListSuite(t *testing.T) {
 	suite.Run(t, new(ListSuite))
 }
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,7 +17,7 @@ import (
 
 	"github.com/talos-systems/talos/pkg/blockdevice"
 	"github.com/talos-systems/talos/pkg/blockdevice/filesystem/xfs"
-	gptpartition "github.com/talos-systems/talos/pkg/blockdevice/table/gpt/partition"
+	"github.com/talos-systems/talos/pkg/blockdevice/table"
 	"github.com/talos-systems/talos/pkg/blockdevice/util"
 	"github.com/talos-systems/talos/pkg/constants"
 	"github.com/talos-systems/talos/pkg/retry"
@@ -163,14 +164,19 @@ func (p *Point) Move(prefix string) (err error) {
 	return nil
 }
 
-// ResizePartition resizes a partition to the maximum size allowed.
+// ResizePartition resizes the partition backing the mount point to the
+// maximum size allowed. Only the last partition of a disk can be resized.
 func (p *Point) ResizePartition() (err error) {
-	var devname string
+	var devname, partno string
 
 	if devname, err = util.DevnameFromPartname(p.Source()); err != nil {
 		return err
 	}
 
+	if partno, err = util.PartNo(p.Source()); err != nil {
+		return err
+	}
+
 	bd, err := blockdevice.Open("/dev/" + devname)
 	if err != nil {
 		return fmt.Errorf("error opening block device %q: %w", devname, err)
@@ -188,14 +194,28 @@ func (p *Point) ResizePartition() (err error) {
 		return err
 	}
 
+	var target table.Partition
+
 	for _, partition := range pt.Partitions() {
-		if partition.(*gptpartition.Partition).Name == constants.EphemeralPartitionLabel {
-			if err := pt.Resize(partition); err != nil {
-				return err
-			}
+		if partition != nil && strconv.Itoa(int(partition.No())) == partno {
+			target = partition
+		}
+	}
+
+	if target == nil {
+		return fmt.Errorf("partition %s not found on %q", partno, devname)
+	}
+
+	for _, partition := range pt.Partitions() {
+		if partition != nil && partition.Start() > target.Start() {
+			return fmt.Errorf("partition %s is not the last partition on %q", partno, devname)
 		}
 	}
 
+	if err := pt.Resize(target); err != nil {
+		return err
+	}
+
 	if err := pt.Write(); err != nil {
 		return err
 	}
@@ -206,8 +226,13 @@ func (p *Point) ResizePartition() (err error) {
 // GrowFilesystem grows a partition's filesystem to the maximum size allowed.
 // NB: An XFS partition MUST be mounted, or this will fail.
 func (p *Point) GrowFilesystem() (err error) {
-	if err = xfs.GrowFS(p.Target()); err != nil {
-		return fmt.Errorf("xfs_growfs: %w", err)
+	switch p.Fstype() {
+	case "xfs":
+		if err = xfs.GrowFS(p.Target()); err != nil {
+			return fmt.Errorf("xfs_growfs: %w", err)
+		}
+	default:
+		return fmt.Errorf("growing %q filesystems is not supported", p.Fstype())
 	}
 
 	return nil
@@ -0,0 +1,169 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package check
+
+import (
+	"context"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"time"
+
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/pkg/config"
+	"github.com/talos-systems/talos/pkg/config/machine"
+	"github.com/talos-systems/talos/pkg/constants"
+)
+
+// DefaultCertificateExpiryThreshold is the default minimum remaining validity of the cluster certificates.
+const DefaultCertificateExpiryThreshold = 30 * 24 * time.Hour
+
+// CertificateExpiryAssertion checks that the cluster CA certificates and
+// Kubernetes API server serving certificate are valid for at least threshold.
+//
+// CA certificates are read from the machine configuration of the control plane node.
+func CertificateExpiryAssertion(ctx context.Context, cluster provision.ClusterAccess, threshold time.Duration) error {
+	certs, err := caCertificates(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	var apiServerCert *stdx509.Certificate
+
+	if apiServerCert, err = apiServerCertificate(ctx, cluster); err != nil {
+		return err
+	}
+
+	certs = append(certs, namedCertificate{"kube-apiserver", apiServerCert})
+
+	deadline := time.Now().Add(threshold)
+
+	var expiring []string
+
+	for _, cert := range certs {
+		if cert.cert.NotAfter.Before(deadline) {
+			expiring = append(expiring, fmt.Sprintf("%s (%s)", cert.name, cert.cert.NotAfter.Format(time.RFC3339)))
+		}
+	}
+
+	if len(expiring) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("some certificates expire in less than %s: %v", threshold, expiring)
+}
+
+type namedCertificate struct {
+	name string
+	cert *stdx509.Certificate
+}
+
+func caCertificates(ctx context.Context, cluster provision.ClusterAccess) ([]namedCertificate, error) {
+	cli, err := cluster.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	var controlPlaneNode string
+
+	for _, node := range cluster.Info().Nodes {
+		if node.Type == machine.TypeInit || node.Type == machine.TypeControlPlane {
+			controlPlaneNode = node.PrivateIP.String()
+			break
+		}
+	}
+
+	if controlPlaneNode == "" {
+		return nil, fmt.Errorf("control plane node not discovered")
+	}
+
+	r, errCh, err := cli.Read(client.WithNodes(ctx, controlPlaneNode), constants.ConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close() //nolint: errcheck
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = <-errCh; err != nil {
+		return nil, err
+	}
+
+	cfg, err := config.NewFromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []namedCertificate
+
+	for _, ca := range []struct {
+		name string
+		pem  []byte
+	}{
+		{"machine CA", cfg.Machine().Security().CA().Crt},
+		{"cluster CA", cfg.Cluster().CA().Crt},
+		{"etcd CA", cfg.Cluster().Etcd().CA().Crt},
+	} {
+		if len(ca.pem) == 0 {
+			continue
+		}
+
+		block, _ := pem.Decode(ca.pem)
+		if block == nil {
+			return nil, fmt.Errorf("failed to decode %s PEM", ca.name)
+		}
+
+		var cert *stdx509.Certificate
+
+		if cert, err = stdx509.ParseCertificate(block.Bytes); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", ca.name, err)
+		}
+
+		certs = append(certs, namedCertificate{ca.name, cert})
+	}
+
+	return certs, nil
+}
+
+func apiServerCertificate(ctx context.Context, cluster provision.ClusterAccess) (*stdx509.Certificate, error) {
+	clientset, err := cluster.K8sClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	host := clientset.CoreV1().RESTClient().Get().URL().Host
+
+	dialer := &net.Dialer{}
+
+	rawConn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, err
+	}
+
+	// certificate is not verified, as the goal is to inspect it, not to trust it
+	conn := tls.Client(rawConn, &tls.Config{InsecureSkipVerify: true}) //nolint: gosec
+	defer conn.Close()                                                 //nolint: errcheck
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = conn.Handshake(); err != nil {
+		return nil, err
+	}
+
+	// first certificate is the leaf (serving) certificate
+	return conn.ConnectionState().PeerCertificates[0], nil
+}
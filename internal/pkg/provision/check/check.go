@@ -37,40 +37,74 @@ func Wait(ctx context.Context, cluster provision.ClusterAccess, checks []Cluster
 		default:
 		}
 
-		condition := check(cluster)
+		if _, err := waitCheck(ctx, cluster, check, reporter); err != nil {
+			return err
+		}
+	}
 
-		errCh := make(chan error, 1)
+	return nil
+}
 
-		go func(condition conditions.Condition) {
-			errCh <- condition.Wait(ctx)
-		}(condition)
+// Result is an outcome of a single named check.
+type Result struct {
+	Name string
+	// Status is the last reported state of the check condition.
+	Status   string
+	Error    error
+	Duration time.Duration
+}
 
-		var err error
+// Passed returns true if the check succeeded.
+func (r *Result) Passed() bool {
+	return r.Error == nil
+}
 
-		func() {
-			ticker := time.NewTicker(updateInterval)
-			defer ticker.Stop()
+// Run runs all the checks against the cluster and returns the result of each check.
+//
+// Unlike Wait, Run doesn't stop on the first failure.
+func Run(ctx context.Context, cluster provision.ClusterAccess, checks []NamedCheck, reporter Reporter) []Result {
+	results := make([]Result, 0, len(checks))
 
-			// report initial state
-			reporter.Update(condition)
+	for _, check := range checks {
+		start := time.Now()
 
-			// report last state
-			defer reporter.Update(condition)
+		condition, err := waitCheck(ctx, cluster, check.Check, reporter)
 
-			for {
-				select {
-				case err = <-errCh:
-					return
-				case <-ticker.C:
-					reporter.Update(condition)
-				}
-			}
-		}()
+		results = append(results, Result{
+			Name:     check.Name,
+			Status:   condition.String(),
+			Error:    err,
+			Duration: time.Since(start),
+		})
+	}
 
-		if err != nil {
-			return err
+	return results
+}
+
+func waitCheck(ctx context.Context, cluster provision.ClusterAccess, check ClusterCheck, reporter Reporter) (conditions.Condition, error) {
+	condition := check(cluster)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- condition.Wait(ctx)
+	}()
+
+	ticker := time.NewTicker(updateInterval)
+	defer ticker.Stop()
+
+	// report initial state
+	reporter.Update(condition)
+
+	// report last state
+	defer reporter.Update(condition)
+
+	for {
+		select {
+		case err := <-errCh:
+			return condition, err
+		case <-ticker.C:
+			reporter.Update(condition)
 		}
 	}
-
-	return nil
 }
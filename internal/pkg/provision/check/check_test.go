@@ -4,11 +4,154 @@
 
 package check_test
 
-import "testing"
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/conditions"
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/internal/pkg/provision/check"
+)
+
+type nopReporter struct{}
+
+func (nopReporter) Update(conditions.Condition) {}
+
+func assertionCheck(name string, assertion conditions.AssertionFunc) check.NamedCheck {
+	return check.NamedCheck{
+		Name: name,
+		Check: func(provision.ClusterAccess) conditions.Condition {
+			return conditions.PollingCondition(name, assertion, 50*time.Millisecond, 10*time.Millisecond)
+		},
+	}
+}
+
+func TestSelectChecks(t *testing.T) {
+	checks := check.AllNamedChecks()
+
+	selected, err := check.SelectChecks(checks, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, check.CheckNames(checks), check.CheckNames(selected))
+
+	selected, err = check.SelectChecks(checks, []string{"coredns", "etcd", "time-sync"}, []string{"time-sync"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"etcd", "coredns"}, check.CheckNames(selected))
+
+	selected, err = check.SelectChecks(checks, nil, []string{"etcd", "cert-expiry"})
+	require.NoError(t, err)
+	assert.NotContains(t, check.CheckNames(selected), "etcd")
+	assert.NotContains(t, check.CheckNames(selected), "cert-expiry")
+	assert.Len(t, selected, len(checks)-2)
+
+	_, err = check.SelectChecks(checks, []string{"foo"}, nil)
+	assert.EqualError(t, err, `unknown check "foo", available checks: etcd, bootkube, apid, k8s-nodes-reported, k8s-nodes-ready, k8s-control-plane, kube-proxy, coredns, time-sync, disk-pressure, cert-expiry`)
+
+	_, err = check.SelectChecks(checks, nil, []string{"foo"})
+	assert.Error(t, err)
+}
+
+func TestRun(t *testing.T) {
+	results := check.Run(context.Background(), nil, []check.NamedCheck{
+		assertionCheck("ok", func(context.Context) error { return nil }),
+		assertionCheck("fail", func(context.Context) error { return errors.New("not ready") }),
+		assertionCheck("ok2", func(context.Context) error { return nil }),
+	}, nopReporter{})
+
+	require.Len(t, results, 3)
+
+	assert.Equal(t, "ok", results[0].Name)
+	assert.True(t, results[0].Passed())
+	assert.Equal(t, "ok: OK", results[0].Status)
+
+	assert.Equal(t, "fail", results[1].Name)
+	assert.False(t, results[1].Passed())
+	assert.Equal(t, "fail: not ready", results[1].Status)
+
+	assert.Equal(t, "ok2", results[2].Name)
+	assert.True(t, results[2].Passed())
+
+	assert.Error(t, check.Wait(context.Background(), nil, check.ClusterChecks([]check.NamedCheck{
+		assertionCheck("fail", func(context.Context) error { return errors.New("not ready") }),
+	}), nopReporter{}))
+}
+
+func testResults() []check.Result {
+	return []check.Result{
+		{
+			Name:     "etcd",
+			Status:   "etcd to be healthy: OK",
+			Duration: 1500 * time.Millisecond,
+		},
+		{
+			Name:     "coredns",
+			Status:   "coredns to report ready: some pods are not ready: [coredns-1]",
+			Error:    context.DeadlineExceeded,
+			Duration: 3 * time.Minute,
+		},
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, check.WriteJSON(&buf, testResults()))
+
+	var report struct {
+		Passed bool `json:"passed"`
+		Checks []struct {
+			Name     string  `json:"name"`
+			Passed   bool    `json:"passed"`
+			Status   string  `json:"status"`
+			Error    string  `json:"error"`
+			Duration float64 `json:"durationSeconds"`
+		} `json:"checks"`
+	}
+
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &report))
+
+	assert.False(t, report.Passed)
+	require.Len(t, report.Checks, 2)
+
+	assert.Equal(t, "etcd", report.Checks[0].Name)
+	assert.True(t, report.Checks[0].Passed)
+	assert.Empty(t, report.Checks[0].Error)
+	assert.Equal(t, 1.5, report.Checks[0].Duration)
+
+	assert.Equal(t, "coredns", report.Checks[1].Name)
+	assert.False(t, report.Checks[1].Passed)
+	assert.Equal(t, "context deadline exceeded", report.Checks[1].Error)
+	assert.Equal(t, "coredns to report ready: some pods are not ready: [coredns-1]", report.Checks[1].Status)
+}
+
+func TestWriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, check.WriteJUnit(&buf, "talos-health", testResults()))
+
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuite name="talos-health" tests="2" failures="1" time="181.500">
+  <testcase name="etcd" classname="talos-health" time="1.500"></testcase>
+  <testcase name="coredns" classname="talos-health" time="180.000">
+    <failure message="context deadline exceeded">coredns to report ready: some pods are not ready: [coredns-1]</failure>
+  </testcase>
+</testsuite>
+`, buf.String())
+}
+
+func TestWriteText(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, check.WriteText(&buf, testResults()))
+
+	assert.Equal(t, `CHECK     RESULT   DURATION   STATUS
+etcd      PASS     1.5s       etcd to be healthy: OK
+coredns   FAIL     3m0s       coredns to report ready: some pods are not ready: [coredns-1]
+`, buf.String())
 }
@@ -13,56 +13,95 @@ import (
 	"github.com/talos-systems/talos/pkg/config/machine"
 )
 
-// DefaultClusterChecks returns a set of default Talos cluster readiness checks.
-func DefaultClusterChecks() []ClusterCheck {
-	return []ClusterCheck{
+// DefaultNamedChecks returns a set of default Talos cluster readiness checks with their names.
+func DefaultNamedChecks() []NamedCheck {
+	return []NamedCheck{
 		// wait for etcd to be healthy on all control plane nodes
-		func(cluster provision.ClusterAccess) conditions.Condition {
+		{"etcd", func(cluster provision.ClusterAccess) conditions.Condition {
 			return conditions.PollingCondition("etcd to be healthy", func(ctx context.Context) error {
 				return ServiceHealthAssertion(ctx, cluster, "etcd", WithNodeTypes(machine.TypeInit, machine.TypeControlPlane))
 			}, 5*time.Minute, 5*time.Second)
-		},
+		}},
 		// wait for bootkube to finish on init node
-		func(cluster provision.ClusterAccess) conditions.Condition {
+		{"bootkube", func(cluster provision.ClusterAccess) conditions.Condition {
 			return conditions.PollingCondition("bootkube to finish", func(ctx context.Context) error {
 				return ServiceStateAssertion(ctx, cluster, "bootkube", "Finished", "Skipped")
 			}, 5*time.Minute, 5*time.Second)
-		},
+		}},
 		// wait for apid to be ready on all the nodes
-		func(cluster provision.ClusterAccess) conditions.Condition {
+		{"apid", func(cluster provision.ClusterAccess) conditions.Condition {
 			return conditions.PollingCondition("apid to be ready", func(ctx context.Context) error {
 				return ApidReadyAssertion(ctx, cluster)
 			}, 2*time.Minute, 5*time.Second)
-		},
+		}},
 		// wait for all the nodes to report in at k8s level
-		func(cluster provision.ClusterAccess) conditions.Condition {
+		{"k8s-nodes-reported", func(cluster provision.ClusterAccess) conditions.Condition {
 			return conditions.PollingCondition("all k8s nodes to report", func(ctx context.Context) error {
 				return K8sAllNodesReportedAssertion(ctx, cluster)
 			}, 5*time.Minute, 5*time.Second)
-		},
+		}},
 		// wait for all the nodes to report ready at k8s level
-		func(cluster provision.ClusterAccess) conditions.Condition {
+		{"k8s-nodes-ready", func(cluster provision.ClusterAccess) conditions.Condition {
 			return conditions.PollingCondition("all k8s nodes to report ready", func(ctx context.Context) error {
 				return K8sAllNodesReadyAssertion(ctx, cluster)
 			}, 10*time.Minute, 5*time.Second)
-		},
+		}},
 		// wait for HA k8s control plane
-		func(cluster provision.ClusterAccess) conditions.Condition {
+		{"k8s-control-plane", func(cluster provision.ClusterAccess) conditions.Condition {
 			return conditions.PollingCondition("all master nodes to be part of k8s control plane", func(ctx context.Context) error {
 				return K8sFullControlPlaneAssertion(ctx, cluster)
 			}, 2*time.Minute, 5*time.Second)
-		},
+		}},
 		// wait for kube-proxy to report ready
-		func(cluster provision.ClusterAccess) conditions.Condition {
+		{"kube-proxy", func(cluster provision.ClusterAccess) conditions.Condition {
 			return conditions.PollingCondition("kube-proxy to report ready", func(ctx context.Context) error {
 				return K8sPodReadyAssertion(ctx, cluster, "kube-system", "k8s-app=kube-proxy")
 			}, 3*time.Minute, 5*time.Second)
-		},
+		}},
 		// wait for coredns to report ready
-		func(cluster provision.ClusterAccess) conditions.Condition {
+		{"coredns", func(cluster provision.ClusterAccess) conditions.Condition {
 			return conditions.PollingCondition("coredns to report ready", func(ctx context.Context) error {
-				return K8sPodReadyAssertion(ctx, cluster, "kube-system", "k8s-app=kube-dns")
+				if err := K8sPodReadyAssertion(ctx, cluster, "kube-system", "k8s-app=kube-dns"); err != nil {
+					return err
+				}
+
+				return K8sDeploymentReadyAssertion(ctx, cluster, "kube-system", "coredns")
 			}, 3*time.Minute, 5*time.Second)
-		},
+		}},
+	}
+}
+
+// ExtraNamedChecks returns cluster health checks which are not run on cluster creation,
+// as they verify long-term cluster health rather than readiness.
+func ExtraNamedChecks() []NamedCheck {
+	return []NamedCheck{
+		// node clocks are in sync with the time server
+		{"time-sync", func(cluster provision.ClusterAccess) conditions.Condition {
+			return conditions.PollingCondition("node time to be in sync", func(ctx context.Context) error {
+				return TimeSyncAssertion(ctx, cluster, DefaultMaxTimeSkew)
+			}, time.Minute, 5*time.Second)
+		}},
+		// nodes are not running out of disk space
+		{"disk-pressure", func(cluster provision.ClusterAccess) conditions.Condition {
+			return conditions.PollingCondition("nodes to have no disk pressure", func(ctx context.Context) error {
+				return DiskPressureAssertion(ctx, cluster, DefaultMaxDiskUsage)
+			}, time.Minute, 5*time.Second)
+		}},
+		// cluster certificates are not about to expire
+		{"cert-expiry", func(cluster provision.ClusterAccess) conditions.Condition {
+			return conditions.PollingCondition("certificates not to expire soon", func(ctx context.Context) error {
+				return CertificateExpiryAssertion(ctx, cluster, DefaultCertificateExpiryThreshold)
+			}, time.Minute, 5*time.Second)
+		}},
 	}
 }
+
+// AllNamedChecks returns default and extra cluster health checks.
+func AllNamedChecks() []NamedCheck {
+	return append(DefaultNamedChecks(), ExtraNamedChecks()...)
+}
+
+// DefaultClusterChecks returns a set of default Talos cluster readiness checks.
+func DefaultClusterChecks() []ClusterCheck {
+	return ClusterChecks(DefaultNamedChecks())
+}
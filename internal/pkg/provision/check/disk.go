@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/pkg/constants"
+)
+
+// DefaultMaxDiskUsage is the default maximum allowed usage of the ephemeral partition, in percent.
+const DefaultMaxDiskUsage = 90.0
+
+// DiskPressureAssertion checks that usage of the ephemeral partition on all the nodes is below maxUsage percent
+// and that none of the Kubernetes nodes report disk pressure.
+func DiskPressureAssertion(ctx context.Context, cluster provision.ClusterAccess, maxUsage float64) error {
+	if err := DiskUsageAssertion(ctx, cluster, constants.EphemeralMountPoint, maxUsage); err != nil {
+		return err
+	}
+
+	return K8sNoDiskPressureAssertion(ctx, cluster)
+}
+
+// DiskUsageAssertion checks that usage of the filesystem mounted at mountPoint on all the nodes is below maxUsage percent.
+//
+// Nodes which don't have a separate filesystem mounted at mountPoint are not checked.
+func DiskUsageAssertion(ctx context.Context, cluster provision.ClusterAccess, mountPoint string, maxUsage float64) error {
+	cli, err := cluster.Client()
+	if err != nil {
+		return err
+	}
+
+	nodes := make([]string, 0, len(cluster.Info().Nodes))
+
+	for _, node := range cluster.Info().Nodes {
+		nodes = append(nodes, node.PrivateIP.String())
+	}
+
+	resp, err := cli.Mounts(client.WithNodes(ctx, nodes...))
+	if err != nil {
+		return err
+	}
+
+	var fullNodes []string
+
+	for _, msg := range resp.Messages {
+		for _, stat := range msg.Stats {
+			if stat.MountedOn != mountPoint || stat.Size == 0 {
+				continue
+			}
+
+			usage := float64(stat.Size-stat.Available) * 100 / float64(stat.Size)
+			if usage > maxUsage {
+				fullNodes = append(fullNodes, fmt.Sprintf("%s (%.1f%%)", msg.Metadata.GetHostname(), usage))
+			}
+		}
+	}
+
+	if len(fullNodes) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s usage is above %.1f%% on some nodes: %v", mountPoint, maxUsage, fullNodes)
+}
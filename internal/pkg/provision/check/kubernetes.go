@@ -174,3 +174,57 @@ func K8sPodReadyAssertion(ctx context.Context, cluster provision.ClusterAccess,
 
 	return fmt.Errorf("some pods are not ready: %v", notReadyPods)
 }
+
+// K8sDeploymentReadyAssertion checks whether all the replicas of the deployment are updated and ready.
+func K8sDeploymentReadyAssertion(ctx context.Context, cluster provision.ClusterAccess, namespace, name string) error {
+	clientset, err := cluster.K8sClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	if deployment.Status.UpdatedReplicas != replicas || deployment.Status.ReadyReplicas != replicas {
+		return fmt.Errorf("deployment %s/%s: %d replicas expected, %d updated, %d ready", namespace, name, replicas, deployment.Status.UpdatedReplicas, deployment.Status.ReadyReplicas)
+	}
+
+	return nil
+}
+
+// K8sNoDiskPressureAssertion checks that none of the nodes report disk pressure.
+func K8sNoDiskPressureAssertion(ctx context.Context, cluster provision.ClusterAccess) error {
+	clientset, err := cluster.K8sClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	nodes, err := clientset.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	var pressureNodes []string
+
+	for _, node := range nodes.Items {
+		for _, cond := range node.Status.Conditions {
+			if cond.Type == v1.NodeDiskPressure && cond.Status == v1.ConditionTrue {
+				pressureNodes = append(pressureNodes, node.Name)
+				break
+			}
+		}
+	}
+
+	if len(pressureNodes) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("some nodes report disk pressure: %v", pressureNodes)
+}
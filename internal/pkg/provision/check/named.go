@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package check
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NamedCheck is a ClusterCheck with a name which identifies it in the selection and in the reports.
+type NamedCheck struct {
+	Name  string
+	Check ClusterCheck
+}
+
+// ClusterChecks strips names from the named checks.
+func ClusterChecks(checks []NamedCheck) []ClusterCheck {
+	result := make([]ClusterCheck, 0, len(checks))
+
+	for _, check := range checks {
+		result = append(result, check.Check)
+	}
+
+	return result
+}
+
+// CheckNames returns names of the checks.
+func CheckNames(checks []NamedCheck) []string {
+	result := make([]string, 0, len(checks))
+
+	for _, check := range checks {
+		result = append(result, check.Name)
+	}
+
+	return result
+}
+
+// SelectChecks filters the list of checks.
+//
+// If only is not empty, just the checks listed in only are selected (in the original order).
+// Checks listed in skip are removed from the selection.
+// Unknown check names are reported as errors.
+func SelectChecks(checks []NamedCheck, only, skip []string) ([]NamedCheck, error) {
+	known := make(map[string]struct{}, len(checks))
+
+	for _, check := range checks {
+		known[check.Name] = struct{}{}
+	}
+
+	toSet := func(names []string) (map[string]struct{}, error) {
+		set := make(map[string]struct{}, len(names))
+
+		for _, name := range names {
+			if _, ok := known[name]; !ok {
+				return nil, fmt.Errorf("unknown check %q, available checks: %s", name, strings.Join(CheckNames(checks), ", "))
+			}
+
+			set[name] = struct{}{}
+		}
+
+		return set, nil
+	}
+
+	onlySet, err := toSet(only)
+	if err != nil {
+		return nil, err
+	}
+
+	skipSet, err := toSet(skip)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]NamedCheck, 0, len(checks))
+
+	for _, check := range checks {
+		if _, ok := onlySet[check.Name]; len(onlySet) > 0 && !ok {
+			continue
+		}
+
+		if _, ok := skipSet[check.Name]; ok {
+			continue
+		}
+
+		result = append(result, check)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,148 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package check
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// WriteText writes check results as a human-readable table.
+func WriteText(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(tw, "CHECK\tRESULT\tDURATION\tSTATUS")
+
+	for _, result := range results {
+		outcome := "PASS"
+		if !result.Passed() {
+			outcome = "FAIL"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", result.Name, outcome, result.Duration.Round(time.Millisecond), result.Status)
+	}
+
+	return tw.Flush()
+}
+
+type jsonReport struct {
+	Passed bool         `json:"passed"`
+	Checks []jsonResult `json:"checks"`
+}
+
+type jsonResult struct {
+	Name     string  `json:"name"`
+	Passed   bool    `json:"passed"`
+	Status   string  `json:"status"`
+	Error    string  `json:"error,omitempty"`
+	Duration float64 `json:"durationSeconds"`
+}
+
+// WriteJSON writes check results as a JSON document.
+func WriteJSON(w io.Writer, results []Result) error {
+	report := jsonReport{
+		Passed: true,
+		Checks: make([]jsonResult, 0, len(results)),
+	}
+
+	for _, result := range results {
+		r := jsonResult{
+			Name:     result.Name,
+			Passed:   result.Passed(),
+			Status:   result.Status,
+			Duration: result.Duration.Seconds(),
+		}
+
+		if result.Error != nil {
+			r.Error = result.Error.Error()
+			report.Passed = false
+		}
+
+		report.Checks = append(report.Checks, r)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(report)
+}
+
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// WriteJUnit writes check results as a JUnit XML report, with each check being a test case.
+func WriteJUnit(w io.Writer, suiteName string, results []Result) error {
+	suite := junitTestSuite{
+		Name:      suiteName,
+		Tests:     len(results),
+		TestCases: make([]junitTestCase, 0, len(results)),
+	}
+
+	var total time.Duration
+
+	for _, result := range results {
+		total += result.Duration
+
+		testCase := junitTestCase{
+			Name:      result.Name,
+			ClassName: suiteName,
+			Time:      junitTime(result.Duration),
+		}
+
+		if result.Error != nil {
+			suite.Failures++
+
+			testCase.Failure = &junitFailure{
+				Message: result.Error.Error(),
+				Text:    result.Status,
+			}
+		}
+
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	suite.Time = junitTime(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+
+	if err := enc.Encode(suite); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
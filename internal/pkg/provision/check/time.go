@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/internal/pkg/provision"
+)
+
+// DefaultMaxTimeSkew is the default maximum allowed difference between node time and time server.
+const DefaultMaxTimeSkew = 5 * time.Second
+
+// TimeSyncAssertion checks that time on all the nodes doesn't differ from the time server by more than maxSkew.
+func TimeSyncAssertion(ctx context.Context, cluster provision.ClusterAccess, maxSkew time.Duration) error {
+	cli, err := cluster.Client()
+	if err != nil {
+		return err
+	}
+
+	nodes := make([]string, 0, len(cluster.Info().Nodes))
+
+	for _, node := range cluster.Info().Nodes {
+		nodes = append(nodes, node.PrivateIP.String())
+	}
+
+	resp, err := cli.Time(client.WithNodes(ctx, nodes...))
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Messages) != len(nodes) {
+		return fmt.Errorf("expected %d responses, got %d", len(nodes), len(resp.Messages))
+	}
+
+	var skewedNodes []string
+
+	for _, msg := range resp.Messages {
+		var localTime, remoteTime time.Time
+
+		if localTime, err = ptypes.Timestamp(msg.Localtime); err != nil {
+			return err
+		}
+
+		if remoteTime, err = ptypes.Timestamp(msg.Remotetime); err != nil {
+			return err
+		}
+
+		skew := localTime.Sub(remoteTime)
+		if skew < 0 {
+			skew = -skew
+		}
+
+		if skew > maxSkew {
+			skewedNodes = append(skewedNodes, fmt.Sprintf("%s (%s)", msg.Metadata.GetHostname(), skew))
+		}
+	}
+
+	if len(skewedNodes) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("some nodes are out of time sync with %s: %v", resp.Messages[0].Server, skewedNodes)
+}
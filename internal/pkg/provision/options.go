@@ -60,6 +60,15 @@ func WithBootladerEmulation() Option {
 	}
 }
 
+// WithUEFI enables or disables UEFI boot (default is BIOS boot).
+func WithUEFI(enabled bool) Option {
+	return func(o *Options) error {
+		o.UEFIEnabled = enabled
+
+		return nil
+	}
+}
+
 // Options describes Provisioner parameters.
 type Options struct {
 	LogWriter     io.Writer
@@ -69,6 +78,9 @@ type Options struct {
 
 	// Enable bootloader by booting from disk image assets.
 	BootloaderEmulation bool
+
+	// Boot VMs with UEFI firmware instead of BIOS.
+	UEFIEnabled bool
 }
 
 // DefaultOptions returns default options.
@@ -5,38 +5,38 @@
 package docker
 
 import (
+	"bytes"
 	"context"
-	"fmt"
 	"io"
-	"strings"
 
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
 
 	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/internal/pkg/support"
 )
 
 // CrashDump produces debug information to help with debugging failures.
-func (p *provisioner) CrashDump(ctx context.Context, cluster provision.Cluster, out io.Writer) {
-	containers, err := p.listNodes(ctx, cluster.Info().ClusterName)
-	if err != nil {
-		fmt.Fprintf(out, "error listing containers: %s\n", err)
-		return
-	}
-
-	for _, container := range containers {
-		name := container.Names[0][:1]
-		fmt.Fprintf(out, "%s\n%s\n\n", name, strings.Repeat("=", len(name)))
-
-		logs, err := p.client.ContainerLogs(ctx, container.ID, types.ContainerLogsOptions{
+//
+// Crash dump is written as support bundle with the container logs of each node.
+func (p *provisioner) CrashDump(ctx context.Context, cluster provision.Cluster, out io.Writer, opts ...provision.Option) {
+	support.CrashDump(ctx, cluster, out, "docker crashdump", func(node provision.NodeInfo) ([]byte, error) {
+		logs, err := p.client.ContainerLogs(ctx, node.ID, types.ContainerLogsOptions{
 			ShowStdout: true,
 			ShowStderr: true,
 			Tail:       "1000",
 		})
 		if err != nil {
-			fmt.Fprintf(out, "error querying container logs: %s\n", err)
-			continue
+			return nil, err
 		}
 
-		_, _ = io.Copy(out, logs) //nolint: errcheck
-	}
+		defer logs.Close() //nolint: errcheck
+
+		// container output is multiplexed, as containers are not run with TTY
+		var buf bytes.Buffer
+
+		_, err = stdcopy.StdCopy(&buf, &buf, logs)
+
+		return buf.Bytes(), err
+	}, nil, opts...)
 }
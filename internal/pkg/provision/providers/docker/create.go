@@ -7,6 +7,8 @@ package docker
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/talos-systems/talos/internal/pkg/provision"
 )
@@ -29,6 +31,14 @@ func (p *provisioner) Create(ctx context.Context, request provision.ClusterReque
 		return nil, err
 	}
 
+	// docker keeps cluster state in docker itself, state directory is only used
+	// to store cluster artifacts (e.g. machine configuration)
+	statePath := filepath.Join(request.StateDirectory, request.Name)
+
+	if err = os.MkdirAll(statePath, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("error creating state directory: %w", err)
+	}
+
 	fmt.Fprintln(options.LogWriter, "creating network", request.Network.Name)
 
 	if err = p.createNetwork(ctx, request.Network); err != nil {
@@ -64,6 +74,7 @@ func (p *provisioner) Create(ctx context.Context, request provision.ClusterReque
 			},
 			Nodes: nodeInfo,
 		},
+		statePath: statePath,
 	}
 
 	return res, nil
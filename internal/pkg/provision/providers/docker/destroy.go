@@ -7,13 +7,14 @@ package docker
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/talos-systems/talos/internal/pkg/provision"
 )
 
 // Destroy Talos cluster as set of Docker nodes.
 //
-// Only cluster.Info().ClusterName and cluster.Info().Network.Name is being used.
+// Only cluster.Info().ClusterName, cluster.Info().Network.Name and cluster state path are being used.
 func (p *provisioner) Destroy(ctx context.Context, cluster provision.Cluster, opts ...provision.Option) error {
 	options := provision.DefaultOptions()
 
@@ -29,5 +30,14 @@ func (p *provisioner) Destroy(ctx context.Context, cluster provision.Cluster, op
 
 	fmt.Println("destroying network", cluster.Info().Network.Name)
 
-	return p.destroyNetwork(ctx, cluster.Info().Network.Name)
+	if err := p.destroyNetwork(ctx, cluster.Info().Network.Name); err != nil {
+		return err
+	}
+
+	stateDirectoryPath, err := cluster.StatePath()
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(stateDirectoryPath)
 }
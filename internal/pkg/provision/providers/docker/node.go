@@ -55,13 +55,24 @@ func (p *provisioner) createNodes(ctx context.Context, clusterReq provision.Clus
 	return nodesInfo, multiErr.ErrorOrNil()
 }
 
-//nolint: gocyclo
 func (p *provisioner) createNode(ctx context.Context, clusterReq provision.ClusterRequest, nodeReq provision.NodeRequest) (provision.NodeInfo, error) {
-	cfg, err := nodeReq.Config.String()
+	id, err := p.createContainer(ctx, clusterReq, nodeReq)
 	if err != nil {
 		return provision.NodeInfo{}, err
 	}
 
+	return p.startNode(ctx, clusterReq, nodeReq, id)
+}
+
+// createContainer creates the container for the node without starting it.
+//
+//nolint: gocyclo
+func (p *provisioner) createContainer(ctx context.Context, clusterReq provision.ClusterRequest, nodeReq provision.NodeRequest) (string, error) {
+	cfg, err := nodeReq.Config.String()
+	if err != nil {
+		return "", err
+	}
+
 	// Create the container config.
 	containerConfig := &container.Config{
 		Hostname: nodeReq.Name,
@@ -109,14 +120,14 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 
 		apidPort, err = nat.NewPort("tcp", "50000")
 		if err != nil {
-			return provision.NodeInfo{}, err
+			return "", err
 		}
 
 		var apiServerPort nat.Port
 
 		apiServerPort, err = nat.NewPort("tcp", "6443")
 		if err != nil {
-			return provision.NodeInfo{}, err
+			return "", err
 		}
 
 		containerConfig.ExposedPorts = nat.PortSet{
@@ -144,7 +155,7 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 		containerConfig.Volumes[constants.EtcdDataPath] = struct{}{}
 
 		if nodeReq.IP == nil {
-			return provision.NodeInfo{}, errors.New("an IP address must be provided when creating a master node")
+			return "", errors.New("an IP address must be provided when creating a master node")
 		}
 	}
 
@@ -155,17 +166,22 @@ func (p *provisioner) createNode(ctx context.Context, clusterReq provision.Clust
 	// Create the container.
 	resp, err := p.client.ContainerCreate(ctx, containerConfig, hostConfig, networkConfig, nodeReq.Name)
 	if err != nil {
-		return provision.NodeInfo{}, err
+		return "", err
 	}
 
+	return resp.ID, nil
+}
+
+// startNode starts the container created with createContainer.
+func (p *provisioner) startNode(ctx context.Context, clusterReq provision.ClusterRequest, nodeReq provision.NodeRequest, id string) (provision.NodeInfo, error) {
 	// Start the container.
-	err = p.client.ContainerStart(ctx, resp.ID, types.ContainerStartOptions{})
+	err := p.client.ContainerStart(ctx, id, types.ContainerStartOptions{})
 	if err != nil {
 		return provision.NodeInfo{}, err
 	}
 
 	// Inspect the container.
-	info, err := p.client.ContainerInspect(ctx, resp.ID)
+	info, err := p.client.ContainerInspect(ctx, id)
 	if err != nil {
 		return provision.NodeInfo{}, err
 	}
@@ -7,6 +7,7 @@ package docker
 import (
 	"context"
 	"net"
+	"path/filepath"
 	"strconv"
 
 	"github.com/talos-systems/talos/internal/pkg/provision"
@@ -18,6 +19,7 @@ func (p *provisioner) Reflect(ctx context.Context, clusterName, stateDirectory s
 		clusterInfo: provision.ClusterInfo{
 			ClusterName: clusterName,
 		},
+		statePath: filepath.Join(stateDirectory, clusterName),
 	}
 
 	// find network assuming network name == cluster name
@@ -12,6 +12,8 @@ import (
 
 type result struct {
 	clusterInfo provision.ClusterInfo
+
+	statePath string
 }
 
 func (res *result) Provisioner() string {
@@ -23,5 +25,9 @@ func (res *result) Info() provision.ClusterInfo {
 }
 
 func (res *result) StatePath() (string, error) {
-	return "", fmt.Errorf("state path is not used for docker provisioner")
+	if res.statePath == "" {
+		return "", fmt.Errorf("state path is not set")
+	}
+
+	return res.statePath, nil
 }
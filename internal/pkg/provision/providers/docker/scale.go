@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+)
+
+// AddNodes adds nodes to the existing cluster.
+//
+// Only request.Nodes are created, other fields of the request should describe the existing cluster.
+func (p *provisioner) AddNodes(ctx context.Context, cluster provision.Cluster, request provision.ClusterRequest, opts ...provision.Option) (provision.Cluster, error) {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.ensureImageExists(ctx, request.Image, &options); err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintln(options.LogWriter, "creating master nodes")
+
+	if _, err := p.createNodes(ctx, request, request.Nodes.MasterNodes()); err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintln(options.LogWriter, "creating worker nodes")
+
+	if _, err := p.createNodes(ctx, request, request.Nodes.WorkerNodes()); err != nil {
+		return nil, err
+	}
+
+	return p.reflectCluster(ctx, cluster)
+}
+
+// RemoveNodes removes nodes with the specified names from the cluster.
+func (p *provisioner) RemoveNodes(ctx context.Context, cluster provision.Cluster, nodeNames []string, opts ...provision.Option) (provision.Cluster, error) {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	containers, err := p.listNodes(ctx, cluster.Info().ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	containerIDs := make(map[string]string, len(containers))
+
+	for _, container := range containers {
+		containerIDs[strings.TrimPrefix(container.Names[0], "/")] = container.ID
+	}
+
+	for _, name := range nodeNames {
+		if _, ok := containerIDs[name]; !ok {
+			return nil, fmt.Errorf("node %q not found in cluster %q", name, cluster.Info().ClusterName)
+		}
+	}
+
+	errCh := make(chan error)
+
+	for _, name := range nodeNames {
+		go func(name string) {
+			fmt.Fprintln(options.LogWriter, "destroying node", name)
+
+			errCh <- p.client.ContainerRemove(ctx, containerIDs[name], types.ContainerRemoveOptions{RemoveVolumes: true, Force: true})
+		}(name)
+	}
+
+	var multiErr *multierror.Error
+
+	for range nodeNames {
+		multiErr = multierror.Append(multiErr, <-errCh)
+	}
+
+	if err = multiErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	return p.reflectCluster(ctx, cluster)
+}
+
+// reflectCluster re-reads cluster state from docker.
+func (p *provisioner) reflectCluster(ctx context.Context, cluster provision.Cluster) (provision.Cluster, error) {
+	statePath, err := cluster.StatePath()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Reflect(ctx, cluster.Info().ClusterName, filepath.Dir(statePath))
+}
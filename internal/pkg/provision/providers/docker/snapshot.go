@@ -0,0 +1,370 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package docker
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"gopkg.in/yaml.v2"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/pkg/config"
+)
+
+// snapshotNodesName is the name of the file in the snapshot which describes node containers.
+const snapshotNodesName = "docker-nodes.yaml"
+
+// snapshotNode captures container settings required to recreate the node.
+type snapshotNode struct {
+	Name     string
+	IP       string
+	Image    string
+	Config   string
+	NanoCPUs int64
+	Memory   int64
+
+	// Volumes are stored in the state directory as <name>-volume-<index>.tar.
+	Volumes []string
+}
+
+// Save stops the containers, archives container volumes along with the state directory
+// and starts the containers again.
+//
+//nolint: gocyclo
+func (p *provisioner) Save(ctx context.Context, cluster provision.Cluster, w io.Writer, opts ...provision.Option) error {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return err
+		}
+	}
+
+	res, ok := cluster.(*result)
+	if !ok {
+		return fmt.Errorf("error inspecting docker cluster state, %#+v", cluster)
+	}
+
+	containers, err := p.listNodes(ctx, res.clusterInfo.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	// containers are started back even if the snapshot fails
+	defer func() {
+		for _, container := range containers {
+			if startErr := p.client.ContainerStart(ctx, container.ID, types.ContainerStartOptions{}); startErr != nil {
+				fmt.Fprintf(options.LogWriter, "error starting node %s: %s\n", container.Names[0][1:], startErr)
+			}
+		}
+	}()
+
+	nodes := make([]snapshotNode, 0, len(containers))
+
+	// volume contents are consistent only when containers are stopped
+	for _, container := range containers {
+		fmt.Fprintln(options.LogWriter, "stopping node", container.Names[0][1:])
+
+		if err = p.client.ContainerStop(ctx, container.ID, nil); err != nil {
+			return err
+		}
+	}
+
+	var cleanup []string
+
+	defer func() {
+		for _, cleanupPath := range cleanup {
+			os.Remove(cleanupPath) //nolint: errcheck
+		}
+	}()
+
+	for _, container := range containers {
+		var node snapshotNode
+
+		node, err = p.inspectSnapshotNode(ctx, container.ID, res.clusterInfo.Network.Name)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(options.LogWriter, "saving volumes of node", node.Name)
+
+		for i, volume := range node.Volumes {
+			archivePath := filepath.Join(res.statePath, fmt.Sprintf("%s-volume-%d.tar", node.Name, i))
+			cleanup = append(cleanup, archivePath)
+
+			if err = p.copyFromContainer(ctx, container.ID, volume, archivePath); err != nil {
+				return fmt.Errorf("error saving volume %q of node %q: %w", volume, node.Name, err)
+			}
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	data, err := yaml.Marshal(nodes)
+	if err != nil {
+		return err
+	}
+
+	nodesPath := filepath.Join(res.statePath, snapshotNodesName)
+	cleanup = append(cleanup, nodesPath)
+
+	if err = ioutil.WriteFile(nodesPath, data, 0644); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(options.LogWriter, "saving cluster state")
+
+	return provision.WriteSnapshot(ctx, res.statePath, &provision.SnapshotManifest{
+		Provisioner: res.Provisioner(),
+		ClusterInfo: res.clusterInfo,
+	}, w)
+}
+
+// Restore recreates the network and containers from the snapshot with the same node names and IPs.
+//
+//nolint: gocyclo
+func (p *provisioner) Restore(ctx context.Context, request provision.RestoreRequest, opts ...provision.Option) (provision.Cluster, error) {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	statePath, manifest, err := provision.ExtractSnapshot(ctx, request.StateDirectory, request.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(options.LogWriter, "restored state directory in %q\n", statePath)
+
+	if manifest.Provisioner != "docker" {
+		return nil, fmt.Errorf("snapshot was created with different provisioner %q", manifest.Provisioner)
+	}
+
+	nodesPath := filepath.Join(statePath, snapshotNodesName)
+
+	data, err := ioutil.ReadFile(nodesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []snapshotNode
+
+	if err = yaml.Unmarshal(data, &nodes); err != nil {
+		return nil, fmt.Errorf("error unmarshalling snapshot nodes: %w", err)
+	}
+
+	networkInfo := manifest.ClusterInfo.Network
+	networkReq := provision.NetworkRequest{
+		Name:        networkInfo.Name,
+		CIDR:        networkInfo.CIDR,
+		GatewayAddr: networkInfo.GatewayAddr,
+		MTU:         networkInfo.MTU,
+	}
+
+	fmt.Fprintln(options.LogWriter, "creating network", networkReq.Name)
+
+	if err = p.createNetwork(ctx, networkReq); err != nil {
+		return nil, fmt.Errorf("unable to create or re-use a docker network: %w", err)
+	}
+
+	res := &result{
+		clusterInfo: provision.ClusterInfo{
+			ClusterName: manifest.ClusterInfo.ClusterName,
+			Network:     networkInfo,
+		},
+		statePath: statePath,
+	}
+
+	for _, node := range nodes {
+		var nodeInfo provision.NodeInfo
+
+		nodeInfo, err = p.restoreNode(ctx, statePath, manifest.ClusterInfo.ClusterName, networkReq, node, &options)
+		if err != nil {
+			p.removeRestoredNodes(ctx, res.clusterInfo.Nodes, &options)
+
+			return nil, fmt.Errorf("error restoring node %q: %w", node.Name, err)
+		}
+
+		res.clusterInfo.Nodes = append(res.clusterInfo.Nodes, nodeInfo)
+	}
+
+	// volume archives are no longer needed once containers are restored
+	for _, node := range nodes {
+		for i := range node.Volumes {
+			if err = os.Remove(filepath.Join(statePath, fmt.Sprintf("%s-volume-%d.tar", node.Name, i))); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = os.Remove(nodesPath); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// restoreNode creates and starts the node container, the container is removed if the node fails to start.
+func (p *provisioner) restoreNode(ctx context.Context, statePath, clusterName string, networkReq provision.NetworkRequest, node snapshotNode, options *provision.Options) (provision.NodeInfo, error) {
+	if err := p.ensureImageExists(ctx, node.Image, options); err != nil {
+		return provision.NodeInfo{}, err
+	}
+
+	cfg, err := config.NewFromBytes([]byte(node.Config))
+	if err != nil {
+		return provision.NodeInfo{}, err
+	}
+
+	clusterReq := provision.ClusterRequest{
+		Name:    clusterName,
+		Network: networkReq,
+		Image:   node.Image,
+	}
+
+	nodeReq := provision.NodeRequest{
+		Name:     node.Name,
+		IP:       net.ParseIP(node.IP),
+		Config:   cfg,
+		NanoCPUs: node.NanoCPUs,
+		Memory:   node.Memory,
+	}
+
+	fmt.Fprintln(options.LogWriter, "creating node", node.Name)
+
+	id, err := p.createContainer(ctx, clusterReq, nodeReq)
+	if err != nil {
+		return provision.NodeInfo{}, err
+	}
+
+	for i, volume := range node.Volumes {
+		if err = p.copyToContainer(ctx, id, volume, filepath.Join(statePath, fmt.Sprintf("%s-volume-%d.tar", node.Name, i))); err != nil {
+			p.removeRestoredNodes(ctx, []provision.NodeInfo{{ID: id, Name: node.Name}}, options)
+
+			return provision.NodeInfo{}, fmt.Errorf("error restoring volume %q: %w", volume, err)
+		}
+	}
+
+	nodeInfo, err := p.startNode(ctx, clusterReq, nodeReq, id)
+	if err != nil {
+		p.removeRestoredNodes(ctx, []provision.NodeInfo{{ID: id, Name: node.Name}}, options)
+
+		return provision.NodeInfo{}, err
+	}
+
+	return nodeInfo, nil
+}
+
+// removeRestoredNodes removes containers of the nodes restored before the failure.
+//
+// Errors are only logged, as the error which caused the restore to fail is more relevant.
+func (p *provisioner) removeRestoredNodes(ctx context.Context, nodes []provision.NodeInfo, options *provision.Options) {
+	for _, node := range nodes {
+		name := strings.TrimPrefix(node.Name, "/")
+
+		fmt.Fprintln(options.LogWriter, "removing node", name)
+
+		if err := p.client.ContainerRemove(ctx, node.ID, types.ContainerRemoveOptions{RemoveVolumes: true, Force: true}); err != nil {
+			fmt.Fprintf(options.LogWriter, "error removing node %s: %s\n", name, err)
+		}
+	}
+}
+
+func (p *provisioner) inspectSnapshotNode(ctx context.Context, id, networkName string) (snapshotNode, error) {
+	info, err := p.client.ContainerInspect(ctx, id)
+	if err != nil {
+		return snapshotNode{}, err
+	}
+
+	node := snapshotNode{
+		Name:     strings.TrimPrefix(info.Name, "/"),
+		Image:    info.Config.Image,
+		NanoCPUs: info.HostConfig.NanoCPUs,
+		Memory:   info.HostConfig.Memory,
+	}
+
+	if settings, ok := info.NetworkSettings.Networks[networkName]; ok {
+		node.IP = settings.IPAddress
+	}
+
+	for _, env := range info.Config.Env {
+		if !strings.HasPrefix(env, "USERDATA=") {
+			continue
+		}
+
+		var cfg []byte
+
+		cfg, err = base64.StdEncoding.DecodeString(strings.TrimPrefix(env, "USERDATA="))
+		if err != nil {
+			return snapshotNode{}, fmt.Errorf("error decoding machine configuration of node %q: %w", node.Name, err)
+		}
+
+		node.Config = string(cfg)
+	}
+
+	if node.Config == "" {
+		return snapshotNode{}, fmt.Errorf("machine configuration not found for node %q", node.Name)
+	}
+
+	for volume := range info.Config.Volumes {
+		// /run is ephemeral
+		if volume == "/run" {
+			continue
+		}
+
+		node.Volumes = append(node.Volumes, volume)
+	}
+
+	return node, nil
+}
+
+// copyFromContainer saves contents of the container path as tar archive.
+func (p *provisioner) copyFromContainer(ctx context.Context, id, srcPath, archivePath string) error {
+	r, _, err := p.client.CopyFromContainer(ctx, id, srcPath)
+	if err != nil {
+		return err
+	}
+
+	defer r.Close() //nolint: errcheck
+
+	f, err := os.Create(archivePath)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	if _, err = io.Copy(f, r); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// copyToContainer extracts archive saved with copyFromContainer back into the container.
+//
+// Archive root is the base name of the source path, so it is extracted into the parent directory.
+func (p *provisioner) copyToContainer(ctx context.Context, id, dstPath, archivePath string) error {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	return p.client.CopyToContainer(ctx, id, path.Dir(dstPath), f, types.CopyToContainerOptions{})
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 
 	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/internal/pkg/provision/providers/vm"
 )
 
 // Destroy Talos cluster as set of Firecracker VMs.
@@ -24,24 +25,24 @@ func (p *provisioner) Destroy(ctx context.Context, cluster provision.Cluster, op
 
 	fmt.Fprintln(options.LogWriter, "stopping VMs")
 
-	if err := p.destroyNodes(cluster.Info(), &options); err != nil {
+	if err := p.DestroyNodes(cluster.Info(), &options); err != nil {
 		return err
 	}
 
-	state, ok := cluster.(*state)
+	state, ok := cluster.(*vm.State)
 	if !ok {
 		return fmt.Errorf("error inspecting firecracker state, %#+v", cluster)
 	}
 
 	fmt.Fprintln(options.LogWriter, "removing load balancer")
 
-	if err := p.destroyLoadBalancer(state); err != nil {
+	if err := p.DestroyLoadBalancer(state); err != nil {
 		return fmt.Errorf("error stopping loadbalancer: %w", err)
 	}
 
 	fmt.Fprintln(options.LogWriter, "removing network")
 
-	if err := p.destroyNetwork(state); err != nil {
+	if err := p.DestroyNetwork(state); err != nil {
 		return err
 	}
 
@@ -9,19 +9,25 @@ import (
 	"context"
 
 	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/internal/pkg/provision/providers/vm"
 	"github.com/talos-systems/talos/pkg/config/machine"
 	"github.com/talos-systems/talos/pkg/config/types/v1alpha1"
 	"github.com/talos-systems/talos/pkg/config/types/v1alpha1/generate"
 )
 
-const stateFileName = "state.yaml"
-
 type provisioner struct {
+	vm.Provisioner
 }
 
-// NewProvisioner initializes docker provisioner.
+// NewProvisioner initializes firecracker provisioner.
 func NewProvisioner(ctx context.Context) (provision.Provisioner, error) {
-	p := &provisioner{}
+	p := &provisioner{
+		vm.Provisioner{
+			Name: "firecracker",
+		},
+	}
+
+	p.CreateNodes = p.createNodes
 
 	return p, nil
 }
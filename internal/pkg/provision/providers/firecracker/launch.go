@@ -16,7 +16,7 @@ import (
 
 	"github.com/firecracker-microvm/firecracker-go-sdk"
 
-	"github.com/talos-systems/talos/internal/pkg/provision/providers/firecracker/inmemhttp"
+	"github.com/talos-systems/talos/internal/pkg/provision/providers/vm/inmemhttp"
 )
 
 // LaunchConfig is passed in to the Launch function over stdin.
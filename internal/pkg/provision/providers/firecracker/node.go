@@ -11,7 +11,6 @@ import (
 	"math"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"strconv"
 	"syscall"
 
@@ -23,26 +22,10 @@ import (
 	"github.com/talos-systems/go-procfs/procfs"
 
 	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/internal/pkg/provision/providers/vm"
 )
 
-func (p *provisioner) createDisk(state *state, nodeReq provision.NodeRequest) (diskPath string, err error) {
-	diskPath = filepath.Join(state.statePath, fmt.Sprintf("%s.disk", nodeReq.Name))
-
-	var diskF *os.File
-
-	diskF, err = os.Create(diskPath)
-	if err != nil {
-		return
-	}
-
-	defer diskF.Close() //nolint: errcheck
-
-	err = diskF.Truncate(nodeReq.DiskSize)
-
-	return
-}
-
-func (p *provisioner) createNodes(state *state, clusterReq provision.ClusterRequest, nodeReqs []provision.NodeRequest, opts *provision.Options) ([]provision.NodeInfo, error) {
+func (p *provisioner) createNodes(state *vm.State, clusterReq provision.ClusterRequest, nodeReqs []provision.NodeRequest, opts *provision.Options) ([]provision.NodeInfo, error) {
 	errCh := make(chan error)
 	nodeCh := make(chan provision.NodeInfo, len(nodeReqs))
 
@@ -75,9 +58,9 @@ func (p *provisioner) createNodes(state *state, clusterReq provision.ClusterRequ
 }
 
 //nolint: gocyclo
-func (p *provisioner) createNode(state *state, clusterReq provision.ClusterRequest, nodeReq provision.NodeRequest, opts *provision.Options) (provision.NodeInfo, error) {
-	socketPath := filepath.Join(state.statePath, fmt.Sprintf("%s.sock", nodeReq.Name))
-	pidPath := filepath.Join(state.statePath, fmt.Sprintf("%s.pid", nodeReq.Name))
+func (p *provisioner) createNode(state *vm.State, clusterReq provision.ClusterRequest, nodeReq provision.NodeRequest, opts *provision.Options) (provision.NodeInfo, error) {
+	socketPath := state.GetRelativePath(fmt.Sprintf("%s.sock", nodeReq.Name))
+	pidPath := state.GetRelativePath(fmt.Sprintf("%s.pid", nodeReq.Name))
 
 	vcpuCount := int64(math.RoundToEven(float64(nodeReq.NanoCPUs) / 1000 / 1000 / 1000))
 	if vcpuCount < 2 {
@@ -86,7 +69,7 @@ func (p *provisioner) createNode(state *state, clusterReq provision.ClusterReque
 
 	memSize := nodeReq.Memory / 1024 / 1024
 
-	diskPath, err := p.createDisk(state, nodeReq)
+	diskPaths, err := p.CreateDisks(state, nodeReq)
 	if err != nil {
 		return provision.NodeInfo{}, err
 	}
@@ -129,7 +112,7 @@ func (p *provisioner) createNode(state *state, clusterReq provision.ClusterReque
 					BinPath:       clusterReq.Network.CNI.BinPath,
 					ConfDir:       clusterReq.Network.CNI.ConfDir,
 					CacheDir:      clusterReq.Network.CNI.CacheDir,
-					NetworkConfig: state.vmCNIConfig,
+					NetworkConfig: state.VMCNIConfig,
 					Args: [][2]string{
 						{"IP", fmt.Sprintf("%s/%d", nodeReq.IP, ones)},
 						{"GATEWAY", clusterReq.Network.GatewayAddr.String()},
@@ -139,17 +122,23 @@ func (p *provisioner) createNode(state *state, clusterReq provision.ClusterReque
 				},
 			},
 		},
-		Drives: []models.Drive{
-			{
-				DriveID:      firecracker.String("disk"),
-				IsRootDevice: firecracker.Bool(false),
-				IsReadOnly:   firecracker.Bool(false),
-				PathOnHost:   firecracker.String(diskPath),
-			},
-		},
 	}
 
-	logFile, err := os.OpenFile(filepath.Join(state.statePath, fmt.Sprintf("%s.log", nodeReq.Name)), os.O_APPEND|os.O_CREATE|os.O_RDWR, 0666)
+	for i, diskPath := range diskPaths {
+		driveID := "disk"
+		if i > 0 {
+			driveID = fmt.Sprintf("disk%d", i)
+		}
+
+		cfg.Drives = append(cfg.Drives, models.Drive{
+			DriveID:      firecracker.String(driveID),
+			IsRootDevice: firecracker.Bool(false),
+			IsReadOnly:   firecracker.Bool(false),
+			PathOnHost:   firecracker.String(diskPath),
+		})
+	}
+
+	logFile, err := os.OpenFile(state.GetRelativePath(fmt.Sprintf("%s.log", nodeReq.Name)), os.O_APPEND|os.O_CREATE|os.O_RDWR, 0666)
 	if err != nil {
 		return provision.NodeInfo{}, err
 	}
@@ -168,7 +157,7 @@ func (p *provisioner) createNode(state *state, clusterReq provision.ClusterReque
 		BootloaderEmulation: opts.BootloaderEmulation,
 	}
 
-	launchConfigFile, err := os.Create(filepath.Join(state.statePath, fmt.Sprintf("%s.config", nodeReq.Name)))
+	launchConfigFile, err := os.Create(state.GetRelativePath(fmt.Sprintf("%s.config", nodeReq.Name)))
 	if err != nil {
 		return provision.NodeInfo{}, err
 	}
@@ -206,36 +195,13 @@ func (p *provisioner) createNode(state *state, clusterReq provision.ClusterReque
 		Name: nodeReq.Name,
 		Type: nodeReq.Config.Machine().Type(),
 
-		NanoCPUs: nodeReq.NanoCPUs,
-		Memory:   nodeReq.Memory,
-		DiskSize: nodeReq.DiskSize,
+		NanoCPUs:   nodeReq.NanoCPUs,
+		Memory:     nodeReq.Memory,
+		DiskSize:   nodeReq.DiskSize,
+		ExtraDisks: nodeReq.ExtraDisks,
 
 		PrivateIP: nodeReq.IP,
 	}
 
 	return nodeInfo, nil
 }
-
-func (p *provisioner) destroyNodes(cluster provision.ClusterInfo, options *provision.Options) error {
-	errCh := make(chan error)
-
-	for _, node := range cluster.Nodes {
-		go func(node provision.NodeInfo) {
-			fmt.Fprintln(options.LogWriter, "stopping VM", node.Name)
-
-			errCh <- p.destroyNode(node)
-		}(node)
-	}
-
-	var multiErr *multierror.Error
-
-	for range cluster.Nodes {
-		multiErr = multierror.Append(multiErr, <-errCh)
-	}
-
-	return multiErr.ErrorOrNil()
-}
-
-func (p *provisioner) destroyNode(node provision.NodeInfo) error {
-	return stopProcessByPidfile(node.ID) // node.ID stores PID path for control process
-}
@@ -0,0 +1,202 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qemu
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv4/server4"
+
+	"github.com/talos-systems/talos/internal/pkg/provision/providers/vm"
+)
+
+const (
+	dhcpPid = "dhcpd.pid"
+	dhcpLog = "dhcpd.log"
+
+	dhcpLeaseTime = time.Hour
+)
+
+// CreateDHCPd launches DHCP server for the cluster network.
+//
+// Single server serves all the VMs of the cluster: VM NICs are looked up
+// by MAC address in the launch configs of the nodes in the state directory,
+// so the server picks up the nodes added to the cluster without a restart.
+func (p *provisioner) CreateDHCPd(state *vm.State) error {
+	statePath, err := state.StatePath()
+	if err != nil {
+		return err
+	}
+
+	pidPath := state.GetRelativePath(dhcpPid)
+
+	logFile, err := os.OpenFile(state.GetRelativePath(dhcpLog), os.O_APPEND|os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+
+	defer logFile.Close() //nolint: errcheck
+
+	args := []string{
+		"qemu-dhcpd-launch",
+		"--interface", state.BridgeName,
+		"--state-path", statePath,
+	}
+
+	cmd := exec.Command(state.SelfExecutable, args...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setsid: true, // daemonize
+	}
+
+	if err = cmd.Start(); err != nil {
+		return err
+	}
+
+	if err = ioutil.WriteFile(pidPath, []byte(strconv.Itoa(cmd.Process.Pid)), os.ModePerm); err != nil {
+		return fmt.Errorf("error writing DHCPd PID file: %w", err)
+	}
+
+	return nil
+}
+
+// DestroyDHCPd stops DHCP server.
+func (p *provisioner) DestroyDHCPd(state *vm.State) error {
+	pidPath := state.GetRelativePath(dhcpPid)
+
+	return vm.StopProcessByPidfile(pidPath)
+}
+
+// LaunchDHCPd runs DHCP server on the bridge interface of the cluster network.
+//
+// This function is invoked from 'osctl qemu-dhcpd-launch' hidden command.
+func LaunchDHCPd(ifName, statePath string) error {
+	server, err := server4.NewServer(
+		ifName,
+		&net.UDPAddr{
+			IP:   net.IPv4zero,
+			Port: dhcpv4.ServerPort,
+		},
+		handlerDHCP4(statePath),
+	)
+	if err != nil {
+		return fmt.Errorf("error launching DHCP server: %w", err)
+	}
+
+	return server.Serve()
+}
+
+// lookupLease finds launch config of the VM which has NIC with the MAC address
+// and returns the config along with the IP address of the NIC.
+func lookupLease(statePath string, mac net.HardwareAddr) (*LaunchConfig, net.IP, error) {
+	configPaths, err := filepath.Glob(filepath.Join(statePath, "*.config"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, configPath := range configPaths {
+		var config LaunchConfig
+
+		if err = readLaunchConfig(configPath, &config); err != nil {
+			return nil, nil, err
+		}
+
+		for i := range config.MACs {
+			if strings.EqualFold(config.MACs[i], mac.String()) {
+				return &config, config.IPs[i], nil
+			}
+		}
+	}
+
+	return nil, nil, nil
+}
+
+func readLaunchConfig(path string, config *LaunchConfig) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	if err = json.NewDecoder(f).Decode(config); err != nil {
+		return fmt.Errorf("error decoding launch config %q: %w", path, err)
+	}
+
+	return nil
+}
+
+func handlerDHCP4(statePath string) server4.Handler {
+	return func(conn net.PacketConn, peer net.Addr, m *dhcpv4.DHCPv4) {
+		if m.OpCode != dhcpv4.OpcodeBootRequest {
+			return
+		}
+
+		config, ip, err := lookupLease(statePath, m.ClientHWAddr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to look up lease for %s: %s\n", m.ClientHWAddr, err)
+
+			return
+		}
+
+		if config == nil {
+			// not one of the cluster VM NICs
+			return
+		}
+
+		mtu := make([]byte, 2)
+		binary.BigEndian.PutUint16(mtu, uint16(config.MTU))
+
+		resp, err := dhcpv4.NewReplyFromRequest(m,
+			dhcpv4.WithNetmask(config.CIDR.Mask),
+			dhcpv4.WithYourIP(ip),
+			dhcpv4.WithOption(dhcpv4.OptRouter(config.GatewayAddr)),
+			dhcpv4.WithOption(dhcpv4.OptDNS(config.Nameservers...)),
+			dhcpv4.WithOption(dhcpv4.OptHostName(config.NodeName)),
+			dhcpv4.WithOption(dhcpv4.OptIPAddressLeaseTime(dhcpLeaseTime)),
+			dhcpv4.WithOption(dhcpv4.OptServerIdentifier(config.GatewayAddr)),
+			dhcpv4.WithOption(dhcpv4.OptGeneric(dhcpv4.OptionInterfaceMTU, mtu)),
+		)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to build DHCP reply: %s\n", err)
+
+			return
+		}
+
+		switch m.MessageType() {
+		case dhcpv4.MessageTypeDiscover:
+			resp.UpdateOption(dhcpv4.OptMessageType(dhcpv4.MessageTypeOffer))
+		case dhcpv4.MessageTypeRequest:
+			resp.UpdateOption(dhcpv4.OptMessageType(dhcpv4.MessageTypeAck))
+		default:
+			return
+		}
+
+		// clients without an address yet can only receive broadcast replies
+		if udpPeer, ok := peer.(*net.UDPAddr); ok && udpPeer.IP.IsUnspecified() {
+			peer = &net.UDPAddr{
+				IP:   net.IPv4bcast,
+				Port: udpPeer.Port,
+			}
+		}
+
+		if _, err = conn.WriteTo(resp.ToBytes(), peer); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to send DHCP reply: %s\n", err)
+		}
+	}
+}
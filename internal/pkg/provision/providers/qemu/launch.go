@@ -0,0 +1,323 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qemu
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/containernetworking/cni/libcni"
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containernetworking/plugins/pkg/testutils"
+	"github.com/google/uuid"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/internal/pkg/provision/providers/vm/inmemhttp"
+)
+
+const qemuExecutable = "qemu-system-x86_64"
+
+// LaunchConfig is passed in to the Launch function over stdin.
+type LaunchConfig struct {
+	QemuExecutable string
+
+	// VM options
+	DiskPaths       []string
+	VCPUCount       int64
+	MemSize         int64
+	KernelArgs      string
+	KernelImagePath string
+	InitrdPath      string
+	PFlashImages    []string
+
+	// Talos config
+	Config   string
+	NodeName string
+
+	// Network
+	NetworkConfig *libcni.NetworkConfigList
+	CNI           provision.CNIConfig
+	CIDR          net.IPNet
+	IPs           []net.IP
+	MACs          []string
+	GatewayAddr   net.IP
+	MTU           int
+	Nameservers   []net.IP
+
+	// filled by CNI invocation
+	ns ns.NetNS
+}
+
+// withCNI creates network namespace for the VM, provisions a tap interface
+// for each VM NIC via CNI and tears everything down on exit.
+func withCNI(ctx context.Context, config *LaunchConfig, f func(config *LaunchConfig) error) error {
+	vmNS, err := testutils.NewNS()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		vmNS.Close()              //nolint: errcheck
+		testutils.UnmountNS(vmNS) //nolint: errcheck
+	}()
+
+	cniConfig := libcni.NewCNIConfigWithCacheDir(config.CNI.BinPath, config.CNI.CacheDir, nil)
+
+	ones, _ := config.CIDR.Mask.Size()
+	containerID := uuid.New().String()
+
+	for i, ip := range config.IPs {
+		runtimeConf := libcni.RuntimeConf{
+			ContainerID: containerID,
+			NetNS:       vmNS.Path(),
+			IfName:      fmt.Sprintf("veth%d", i),
+			Args: [][2]string{
+				{"IgnoreUnknown", "1"},
+				{"IP", fmt.Sprintf("%s/%d", ip, ones)},
+				{"GATEWAY", config.GatewayAddr.String()},
+				{"TC_REDIRECT_TAP_NAME", tapName(i)},
+			},
+		}
+
+		if _, err = cniConfig.AddNetworkList(ctx, config.NetworkConfig, &runtimeConf); err != nil {
+			return fmt.Errorf("error provisioning CNI network: %w", err)
+		}
+
+		defer func() {
+			if e := cniConfig.DelNetworkList(ctx, config.NetworkConfig, &runtimeConf); e != nil {
+				fmt.Fprintf(os.Stderr, "error cleaning up CNI: %s\n", e)
+			}
+		}()
+	}
+
+	config.ns = vmNS
+
+	return f(config)
+}
+
+func tapName(i int) string {
+	return fmt.Sprintf("tap%d", i)
+}
+
+// kvmAvailable checks whether hardware virtualization is accessible to the process.
+func kvmAvailable() bool {
+	f, err := os.OpenFile("/dev/kvm", os.O_RDWR, 0)
+	if err != nil {
+		return false
+	}
+
+	f.Close() //nolint: errcheck
+
+	return true
+}
+
+// diskBootable checks whether disk image contains a partition table (MBR signature),
+// which means Talos was already installed to the disk.
+func diskBootable(diskPath string) (bool, error) {
+	f, err := os.Open(diskPath)
+	if err != nil {
+		return false, err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	buf := make([]byte, 2)
+
+	if _, err = f.ReadAt(buf, 510); err != nil {
+		return false, err
+	}
+
+	return buf[0] == 0x55 && buf[1] == 0xaa, nil
+}
+
+func buildArgs(config *LaunchConfig) ([]string, error) {
+	args := []string{
+		"-m", strconv.FormatInt(config.MemSize, 10),
+		"-smp", fmt.Sprintf("cpus=%d", config.VCPUCount),
+		"-nographic",
+		"-no-reboot",
+	}
+
+	if kvmAvailable() {
+		args = append(args,
+			"-machine", "q35,accel=kvm",
+			"-cpu", "host",
+		)
+	} else {
+		fmt.Fprintf(os.Stderr, "/dev/kvm is not available, falling back to software emulation (TCG)\n")
+
+		args = append(args,
+			"-machine", "q35,accel=tcg",
+			"-cpu", "max",
+		)
+	}
+
+	for i, pflashImage := range config.PFlashImages {
+		drive := fmt.Sprintf("if=pflash,format=raw,file=%s", pflashImage)
+
+		// first image is firmware code, it's never modified
+		if i == 0 {
+			drive += ",readonly=on"
+		}
+
+		args = append(args, "-drive", drive)
+	}
+
+	for _, diskPath := range config.DiskPaths {
+		args = append(args, "-drive", fmt.Sprintf("format=raw,if=virtio,file=%s", diskPath))
+	}
+
+	for i, mac := range config.MACs {
+		args = append(args,
+			"-netdev", fmt.Sprintf("tap,id=net%d,ifname=%s,script=no,downscript=no", i, tapName(i)),
+			"-device", fmt.Sprintf("virtio-net-pci,netdev=net%d,mac=%s", i, mac),
+		)
+	}
+
+	bootable, err := diskBootable(config.DiskPaths[0])
+	if err != nil {
+		return nil, fmt.Errorf("error inspecting disk image: %w", err)
+	}
+
+	if bootable {
+		fmt.Fprintf(os.Stderr, "disk image is bootable, booting from disk\n")
+	} else {
+		// boot kernel & initrd directly until Talos is installed to the disk
+		args = append(args,
+			"-kernel", config.KernelImagePath,
+			"-initrd", config.InitrdPath,
+			"-append", config.KernelArgs,
+		)
+	}
+
+	return args, nil
+}
+
+// launchVM runs qemu with args built from config.
+//
+// launchVM returns nil when VM exits and it should be restarted.
+func launchVM(config *LaunchConfig, c chan os.Signal) error {
+	args, err := buildArgs(config)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(config.QemuExecutable, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	// qemu should be started in the network namespace prepared by CNI
+	if err = config.ns.Do(func(_ ns.NetNS) error {
+		return cmd.Start()
+	}); err != nil {
+		return err
+	}
+
+	waitCh := make(chan error)
+
+	go func() {
+		waitCh <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-waitCh:
+		if err != nil {
+			return fmt.Errorf("failed running VM: %w", err)
+		}
+
+		select {
+		case sig := <-c:
+			fmt.Fprintf(os.Stderr, "exiting VM as signal %s was received\n", sig)
+
+			return fmt.Errorf("process stopped")
+
+		case <-time.After(500 * time.Millisecond): // wait a bit to prevent crash loop
+		}
+	case sig := <-c:
+		fmt.Fprintf(os.Stderr, "stopping VM as signal %s was received\n", sig)
+
+		cmd.Process.Signal(syscall.SIGTERM) //nolint: errcheck
+
+		<-waitCh // wait for process to exit
+
+		return fmt.Errorf("process stopped")
+	}
+
+	return nil
+}
+
+// Launch a control process around qemu VM manager.
+//
+// This function is invoked from 'osctl qemu-launch' hidden command
+// and wraps starting, controlling and restarting 'qemu' VM process.
+//
+// Launch restarts VM forever until control process is stopped itself with a signal.
+//
+// Process is expected to receive configuration on stdin. Current working directory
+// should be cluster state directory, process output should be redirected to the
+// logfile in state directory.
+//
+// VM is booted with kernel and initrd passed directly until Talos is installed
+// to the disk, after that VM boots from the disk image via the bootloader
+// (BIOS or UEFI). Network configuration is delivered to the VM via DHCP
+// by the DHCP server of the cluster network (see LaunchDHCPd).
+//
+// When signals SIGINT, SIGTERM are received, control process stops qemu and exits.
+func Launch() error {
+	var config LaunchConfig
+
+	d := json.NewDecoder(os.Stdin)
+
+	if err := d.Decode(&config); err != nil {
+		return fmt.Errorf("error decoding config from stdin: %w", err)
+	}
+
+	if d.More() {
+		return fmt.Errorf("extra unexpected input on stdin")
+	}
+
+	if err := os.Stdin.Close(); err != nil {
+		return err
+	}
+
+	signal.Ignore(syscall.SIGHUP)
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGTERM, syscall.SIGINT)
+
+	ctx := context.Background()
+
+	httpServer, err := inmemhttp.NewServer(fmt.Sprintf("%s:0", config.GatewayAddr))
+	if err != nil {
+		return fmt.Errorf("error launching in-memory HTTP server: %w", err)
+	}
+
+	if err = httpServer.AddFile("config.yaml", []byte(config.Config)); err != nil {
+		return err
+	}
+
+	// patch kernel args
+	config.KernelArgs = strings.ReplaceAll(config.KernelArgs, "{TALOS_CONFIG_URL}", fmt.Sprintf("http://%s/config.yaml", httpServer.GetAddr()))
+
+	httpServer.Serve()
+	defer httpServer.Shutdown(ctx) //nolint: errcheck
+
+	return withCNI(ctx, &config, func(config *LaunchConfig) error {
+		for {
+			if err := launchVM(config, c); err != nil {
+				return err
+			}
+		}
+	})
+}
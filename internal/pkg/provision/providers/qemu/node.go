@@ -0,0 +1,200 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qemu
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/go-procfs/procfs"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/internal/pkg/provision/providers/vm"
+)
+
+func (p *provisioner) createNodes(state *vm.State, clusterReq provision.ClusterRequest, nodeReqs []provision.NodeRequest, opts *provision.Options) ([]provision.NodeInfo, error) {
+	errCh := make(chan error)
+	nodeCh := make(chan provision.NodeInfo, len(nodeReqs))
+
+	for _, nodeReq := range nodeReqs {
+		go func(nodeReq provision.NodeRequest) {
+			nodeInfo, err := p.createNode(state, clusterReq, nodeReq, opts)
+			errCh <- err
+
+			if err == nil {
+				nodeCh <- nodeInfo
+			}
+		}(nodeReq)
+	}
+
+	var multiErr *multierror.Error
+
+	for range nodeReqs {
+		multiErr = multierror.Append(multiErr, <-errCh)
+	}
+
+	close(nodeCh)
+
+	nodesInfo := make([]provision.NodeInfo, 0, len(nodeReqs))
+
+	for nodeInfo := range nodeCh {
+		nodesInfo = append(nodesInfo, nodeInfo)
+	}
+
+	return nodesInfo, multiErr.ErrorOrNil()
+}
+
+//nolint: gocyclo
+func (p *provisioner) createNode(state *vm.State, clusterReq provision.ClusterRequest, nodeReq provision.NodeRequest, opts *provision.Options) (provision.NodeInfo, error) {
+	pidPath := state.GetRelativePath(fmt.Sprintf("%s.pid", nodeReq.Name))
+
+	vcpuCount := int64(math.RoundToEven(float64(nodeReq.NanoCPUs) / 1000 / 1000 / 1000))
+	if vcpuCount < 2 {
+		vcpuCount = 1
+	}
+
+	memSize := nodeReq.Memory / 1024 / 1024
+
+	diskPaths, err := p.CreateDisks(state, nodeReq)
+	if err != nil {
+		return provision.NodeInfo{}, err
+	}
+
+	var pflashImages []string
+
+	if opts.UEFIEnabled {
+		if pflashImages, err = createPFlashImages(state, nodeReq.Name); err != nil {
+			return provision.NodeInfo{}, fmt.Errorf("error creating flash images: %w", err)
+		}
+	}
+
+	cmdline := procfs.NewDefaultCmdline()
+
+	// required to get kernel console
+	cmdline.Append("console", "ttyS0")
+
+	// reboot configuration
+	cmdline.Append("reboot", "k")
+	cmdline.Append("panic", "1")
+
+	// Talos config
+	cmdline.Append("talos.platform", "metal")
+	cmdline.Append("talos.config", "{TALOS_CONFIG_URL}") // to be patched by launcher
+
+	ips := append([]net.IP{nodeReq.IP}, nodeReq.ExtraIPs...)
+	macs := make([]string, len(ips))
+
+	for i := range macs {
+		if macs[i], err = randomMAC(); err != nil {
+			return provision.NodeInfo{}, err
+		}
+	}
+
+	logFile, err := os.OpenFile(state.GetRelativePath(fmt.Sprintf("%s.log", nodeReq.Name)), os.O_APPEND|os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return provision.NodeInfo{}, err
+	}
+
+	defer logFile.Close() //nolint: errcheck
+
+	nodeConfig, err := nodeReq.Config.String()
+	if err != nil {
+		return provision.NodeInfo{}, err
+	}
+
+	launchConfig := LaunchConfig{
+		QemuExecutable:  qemuExecutable,
+		DiskPaths:       diskPaths,
+		VCPUCount:       vcpuCount,
+		MemSize:         memSize,
+		KernelArgs:      cmdline.String(),
+		KernelImagePath: clusterReq.KernelPath,
+		InitrdPath:      clusterReq.InitramfsPath,
+		PFlashImages:    pflashImages,
+		Config:          nodeConfig,
+		NodeName:        nodeReq.Name,
+		NetworkConfig:   state.VMCNIConfig,
+		CNI:             clusterReq.Network.CNI,
+		CIDR:            clusterReq.Network.CIDR,
+		IPs:             ips,
+		MACs:            macs,
+		GatewayAddr:     clusterReq.Network.GatewayAddr,
+		MTU:             clusterReq.Network.MTU,
+		Nameservers:     clusterReq.Network.Nameservers,
+	}
+
+	launchConfigFile, err := os.Create(state.GetRelativePath(fmt.Sprintf("%s.config", nodeReq.Name)))
+	if err != nil {
+		return provision.NodeInfo{}, err
+	}
+
+	if err = json.NewEncoder(launchConfigFile).Encode(&launchConfig); err != nil {
+		return provision.NodeInfo{}, err
+	}
+
+	if _, err = launchConfigFile.Seek(0, io.SeekStart); err != nil {
+		return provision.NodeInfo{}, err
+	}
+
+	defer launchConfigFile.Close() //nolint: errcheck
+
+	cmd := exec.Command(clusterReq.SelfExecutable, "qemu-launch")
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.Stdin = launchConfigFile
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setsid: true, // daemonize
+	}
+
+	if err = cmd.Start(); err != nil {
+		return provision.NodeInfo{}, err
+	}
+
+	if err = ioutil.WriteFile(pidPath, []byte(strconv.Itoa(cmd.Process.Pid)), os.ModePerm); err != nil {
+		return provision.NodeInfo{}, fmt.Errorf("error writing PID file: %w", err)
+	}
+
+	// no need to wait here, as cmd has all the Stdin/out/err via *os.File
+
+	nodeInfo := provision.NodeInfo{
+		ID:   pidPath,
+		Name: nodeReq.Name,
+		Type: nodeReq.Config.Machine().Type(),
+
+		NanoCPUs:   nodeReq.NanoCPUs,
+		Memory:     nodeReq.Memory,
+		DiskSize:   nodeReq.DiskSize,
+		ExtraDisks: nodeReq.ExtraDisks,
+
+		PrivateIP: nodeReq.IP,
+		ExtraIPs:  nodeReq.ExtraIPs,
+	}
+
+	return nodeInfo, nil
+}
+
+// randomMAC generates random locally administered unicast MAC address.
+func randomMAC() (string, error) {
+	buf := make([]byte, 6)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	buf[0] = (buf[0] | 0x02) & 0xfe
+
+	return net.HardwareAddr(buf).String(), nil
+}
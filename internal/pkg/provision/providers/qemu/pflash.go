@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qemu
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/talos-systems/talos/internal/pkg/provision/providers/vm"
+)
+
+// ovmfLocations is a list of well-known locations of OVMF (UEFI firmware for QEMU)
+// code & variables images in various Linux distributions.
+var ovmfLocations = []struct {
+	code string
+	vars string
+}{
+	{"/usr/share/OVMF/OVMF_CODE.fd", "/usr/share/OVMF/OVMF_VARS.fd"},
+	{"/usr/share/edk2/ovmf/OVMF_CODE.fd", "/usr/share/edk2/ovmf/OVMF_VARS.fd"},
+	{"/usr/share/edk2-ovmf/x64/OVMF_CODE.fd", "/usr/share/edk2-ovmf/x64/OVMF_VARS.fd"},
+	{"/usr/share/qemu/edk2-x86_64-code.fd", "/usr/share/qemu/edk2-i386-vars.fd"},
+}
+
+// createPFlashImages locates OVMF firmware and prepares flash images for the node.
+//
+// Firmware code is used read-only directly from the host, while the variables
+// store is copied to the state directory, as it is modified by the VM.
+func createPFlashImages(state *vm.State, nodeName string) ([]string, error) {
+	for _, location := range ovmfLocations {
+		if _, err := os.Stat(location.code); err != nil {
+			continue
+		}
+
+		if _, err := os.Stat(location.vars); err != nil {
+			continue
+		}
+
+		varsPath := state.GetRelativePath(fmt.Sprintf("%s-flash1.img", nodeName))
+
+		if err := copyFile(location.vars, varsPath); err != nil {
+			return nil, err
+		}
+
+		return []string{location.code, varsPath}, nil
+	}
+
+	return nil, fmt.Errorf("OVMF firmware not found, please install OVMF (edk2) package")
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+
+	defer in.Close() //nolint: errcheck
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+
+	defer out.Close() //nolint: errcheck
+
+	if _, err = io.Copy(out, in); err != nil {
+		return err
+	}
+
+	return out.Close()
+}
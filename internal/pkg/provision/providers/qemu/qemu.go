@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package qemu implements Provisioner via QEMU VMs.
+package qemu
+
+import (
+	"context"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/internal/pkg/provision/providers/vm"
+	"github.com/talos-systems/talos/pkg/config/types/v1alpha1/generate"
+)
+
+type provisioner struct {
+	vm.Provisioner
+}
+
+// NewProvisioner initializes qemu provisioner.
+func NewProvisioner(ctx context.Context) (provision.Provisioner, error) {
+	p := &provisioner{
+		vm.Provisioner{
+			Name: "qemu",
+		},
+	}
+
+	p.CreateNodes = p.createNodes
+	p.CreateNetworkServices = p.CreateDHCPd
+
+	return p, nil
+}
+
+// Close and release resources.
+func (p *provisioner) Close() error {
+	return nil
+}
+
+// GenOptions provides a list of additional config generate options.
+//
+// Network configuration is delivered to the VMs via DHCP, so no network config is generated.
+func (p *provisioner) GenOptions(networkReq provision.NetworkRequest) []generate.GenOption {
+	return []generate.GenOption{
+		generate.WithInstallDisk("/dev/vda"),
+	}
+}
+
+// GetLoadBalancers returns internal/external loadbalancer endpoints.
+func (p *provisioner) GetLoadBalancers(networkReq provision.NetworkRequest) (internalEndpoint, externalEndpoint string) {
+	// qemu runs loadbalancer on the bridge, which is good for both internal & external access
+	return networkReq.GatewayAddr.String(), networkReq.GatewayAddr.String()
+}
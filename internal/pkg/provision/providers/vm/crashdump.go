@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/internal/pkg/support"
+	"github.com/talos-systems/talos/internal/pkg/tail"
+)
+
+// CrashDump produces debug information to help with debugging failures.
+//
+// Crash dump is written as support bundle with the console logs of each VM,
+// other logs in the state directory (load balancer, DHCP server) are added as cluster-wide logs.
+func (p *Provisioner) CrashDump(ctx context.Context, cluster provision.Cluster, out io.Writer, opts ...provision.Option) {
+	state, ok := cluster.(*State)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "error inspecting %s state, %#+v\n", p.Name, cluster)
+		return
+	}
+
+	nodeLogs := map[string]struct{}{}
+
+	for _, node := range state.ClusterInfo.Nodes {
+		nodeLogs[fmt.Sprintf("%s.log", node.Name)] = struct{}{}
+	}
+
+	logFiles, err := filepath.Glob(state.GetRelativePath("*.log"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error finding log paths: %s\n", err)
+	}
+
+	clusterLogs := map[string]func() ([]byte, error){}
+
+	for _, logFile := range logFiles {
+		logFile := logFile
+		name := filepath.Base(logFile)
+
+		if _, isNodeLog := nodeLogs[name]; isNodeLog {
+			continue
+		}
+
+		clusterLogs[name] = func() ([]byte, error) {
+			return readLogTail(logFile)
+		}
+	}
+
+	support.CrashDump(ctx, cluster, out, fmt.Sprintf("%s crashdump", p.Name), func(node provision.NodeInfo) ([]byte, error) {
+		return readLogTail(state.GetRelativePath(fmt.Sprintf("%s.log", node.Name)))
+	}, clusterLogs, opts...)
+}
+
+func readLogTail(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint: errcheck
+
+	if err = tail.SeekLines(f, 1000); err != nil {
+		return nil, fmt.Errorf("error seeking to the tail: %w", err)
+	}
+
+	return ioutil.ReadAll(f)
+}
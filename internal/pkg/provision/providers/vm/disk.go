@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vm
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+)
+
+// CreateDisks creates empty (sparse) disk images for the node.
+//
+// First disk is the primary (install) disk, followed by extra disks.
+func (p *Provisioner) CreateDisks(state *State, nodeReq provision.NodeRequest) (diskPaths []string, err error) {
+	diskSizes := append([]int64{nodeReq.DiskSize}, nodeReq.ExtraDisks...)
+	diskPaths = make([]string, len(diskSizes))
+
+	for i, diskSize := range diskSizes {
+		name := fmt.Sprintf("%s.disk", nodeReq.Name)
+		if i > 0 {
+			name = fmt.Sprintf("%s-%d.disk", nodeReq.Name, i)
+		}
+
+		diskPaths[i] = state.GetRelativePath(name)
+
+		if err = createDisk(diskPaths[i], diskSize); err != nil {
+			return nil, err
+		}
+	}
+
+	return diskPaths, nil
+}
+
+func createDisk(diskPath string, diskSize int64) error {
+	diskF, err := os.Create(diskPath)
+	if err != nil {
+		return err
+	}
+
+	defer diskF.Close() //nolint: errcheck
+
+	if err = diskF.Truncate(diskSize); err != nil {
+		return err
+	}
+
+	return diskF.Close()
+}
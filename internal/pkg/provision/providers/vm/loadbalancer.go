@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/pkg/config/machine"
+)
+
+const (
+	lbPid = "lb.pid"
+	lbLog = "lb.log"
+)
+
+// CreateLoadBalancer creates load balancer.
+func (p *Provisioner) CreateLoadBalancer(state *State, clusterReq provision.ClusterRequest) error {
+	// save load balancer parameters, so that it could be restarted when master nodes change
+	state.SelfExecutable = clusterReq.SelfExecutable
+	state.LoadBalancer = clusterReq.Network.LoadBalancer
+
+	masterNodes := clusterReq.Nodes.MasterNodes()
+	masterIPs := make([]string, len(masterNodes))
+
+	for i := range masterIPs {
+		masterIPs[i] = masterNodes[i].IP.String()
+	}
+
+	return p.launchLoadBalancer(state, clusterReq.Network.GatewayAddr, masterIPs)
+}
+
+// UpdateLoadBalancer restarts load balancer with the current set of master nodes.
+func (p *Provisioner) UpdateLoadBalancer(state *State) error {
+	if err := p.DestroyLoadBalancer(state); err != nil {
+		return fmt.Errorf("error stopping loadbalancer: %w", err)
+	}
+
+	var masterIPs []string
+
+	for _, node := range state.ClusterInfo.Nodes {
+		if node.Type == machine.TypeInit || node.Type == machine.TypeControlPlane {
+			masterIPs = append(masterIPs, node.PrivateIP.String())
+		}
+	}
+
+	return p.launchLoadBalancer(state, state.ClusterInfo.Network.GatewayAddr, masterIPs)
+}
+
+func (p *Provisioner) launchLoadBalancer(state *State, gatewayAddr net.IP, masterIPs []string) error {
+	pidPath := state.GetRelativePath(lbPid)
+
+	logFile, err := os.OpenFile(state.GetRelativePath(lbLog), os.O_APPEND|os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+
+	defer logFile.Close() //nolint: errcheck
+
+	args := []string{
+		"loadbalancer-launch",
+		"--loadbalancer-addr", gatewayAddr.String(),
+		"--loadbalancer-upstreams", strings.Join(masterIPs, ","),
+	}
+
+	if state.LoadBalancer.LimitApidOnlyInitNode {
+		args = append(args, "--apid-only-init-node")
+	}
+
+	cmd := exec.Command(state.SelfExecutable, args...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setsid: true, // daemonize
+	}
+
+	if err = cmd.Start(); err != nil {
+		return err
+	}
+
+	if err = ioutil.WriteFile(pidPath, []byte(strconv.Itoa(cmd.Process.Pid)), os.ModePerm); err != nil {
+		return fmt.Errorf("error writing LB PID file: %w", err)
+	}
+
+	return nil
+}
+
+// DestroyLoadBalancer destroys load balancer.
+func (p *Provisioner) DestroyLoadBalancer(state *State) error {
+	pidPath := state.GetRelativePath(lbPid)
+
+	return StopProcessByPidfile(pidPath)
+}
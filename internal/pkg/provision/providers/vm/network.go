@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-package firecracker
+package vm
 
 import (
 	"bytes"
@@ -23,7 +23,8 @@ import (
 	talosnet "github.com/talos-systems/talos/pkg/net"
 )
 
-func (p *provisioner) createNetwork(ctx context.Context, state *state, network provision.NetworkRequest) error {
+// CreateNetwork creates the bridge interface and prepares CNI config for the VMs.
+func (p *Provisioner) CreateNetwork(ctx context.Context, state *State, network provision.NetworkRequest) error {
 	// build bridge interface name by taking part of checksum of the network name
 	// so that interface name is defined by network name, and different networks have
 	// different bridge interfaces
@@ -94,31 +95,45 @@ func (p *provisioner) createNetwork(ctx context.Context, state *state, network p
 	}
 
 	// prepare an actual network config to be used by the VMs
-	t = template.Must(template.New("network").Parse(networkTemplate))
+	state.VMCNIConfig, err = vmCNIConfig(network.Name, state.BridgeName, network.MTU)
 
-	buf.Reset()
+	return err
+}
+
+// vmCNIConfig builds CNI config used to attach VMs to the bridge.
+//
+// Every plugin in the chain is required both by Firecracker and QEMU:
+//   - bridge attaches the VM NIC to the bridge and sets up masquerading for the NIC IP,
+//   - firewall allows forwarding of the VM traffic if the host firewall drops it by default,
+//   - tc-redirect-tap provides the tap device the VM is attached to.
+func vmCNIConfig(networkName, bridgeName string, mtu int) (*libcni.NetworkConfigList, error) {
+	t := template.Must(template.New("network").Parse(networkTemplate))
 
-	err = t.Execute(&buf, struct {
+	var buf bytes.Buffer
+
+	err := t.Execute(&buf, struct {
 		NetworkName   string
 		InterfaceName string
 		MTU           string
 	}{
-		NetworkName:   network.Name,
-		InterfaceName: state.BridgeName,
-		MTU:           strconv.Itoa(network.MTU),
+		NetworkName:   networkName,
+		InterfaceName: bridgeName,
+		MTU:           strconv.Itoa(mtu),
 	})
 	if err != nil {
-		return fmt.Errorf("error templating VM CNI config: %w", err)
+		return nil, fmt.Errorf("error templating VM CNI config: %w", err)
 	}
 
-	if state.vmCNIConfig, err = libcni.ConfListFromBytes(buf.Bytes()); err != nil {
-		return fmt.Errorf("error parsing VM CNI config: %w", err)
+	config, err := libcni.ConfListFromBytes(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing VM CNI config: %w", err)
 	}
 
-	return nil
+	return config, nil
 }
 
-func (p *provisioner) destroyNetwork(state *state) error {
+// DestroyNetwork removes the bridge interface.
+func (p *Provisioner) DestroyNetwork(state *State) error {
 	// destroy bridge interface by name to clean up
 	iface, err := net.InterfaceByName(state.BridgeName)
 	if err != nil {
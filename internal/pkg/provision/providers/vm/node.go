@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+)
+
+// DestroyNodes stops all the VM control processes of the cluster.
+func (p *Provisioner) DestroyNodes(cluster provision.ClusterInfo, options *provision.Options) error {
+	errCh := make(chan error)
+
+	for _, node := range cluster.Nodes {
+		go func(node provision.NodeInfo) {
+			fmt.Fprintln(options.LogWriter, "stopping VM", node.Name)
+
+			errCh <- p.DestroyNode(node)
+		}(node)
+	}
+
+	var multiErr *multierror.Error
+
+	for range cluster.Nodes {
+		multiErr = multierror.Append(multiErr, <-errCh)
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+// DestroyNode stops VM control process of the node.
+func (p *Provisioner) DestroyNode(node provision.NodeInfo) error {
+	return StopProcessByPidfile(node.ID) // node.ID stores PID path for control process
+}
+
+// AddNodes adds nodes to the existing cluster.
+//
+// Only request.Nodes are created, other fields of the request should describe the existing cluster.
+func (p *Provisioner) AddNodes(ctx context.Context, cluster provision.Cluster, request provision.ClusterRequest, opts ...provision.Option) (provision.Cluster, error) {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	state, ok := cluster.(*State)
+	if !ok {
+		return nil, fmt.Errorf("error inspecting %s state, %#+v", p.Name, cluster)
+	}
+
+	fmt.Fprintln(options.LogWriter, "creating master nodes")
+
+	nodeInfo, err := p.CreateNodes(state, request, request.Nodes.MasterNodes(), &options)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintln(options.LogWriter, "creating worker nodes")
+
+	var workerNodeInfo []provision.NodeInfo
+
+	if workerNodeInfo, err = p.CreateNodes(state, request, request.Nodes.WorkerNodes(), &options); err != nil {
+		return nil, err
+	}
+
+	nodeInfo = append(nodeInfo, workerNodeInfo...)
+
+	state.ClusterInfo.Nodes = append(state.ClusterInfo.Nodes, nodeInfo...)
+
+	if err = state.Save(); err != nil {
+		return nil, err
+	}
+
+	if len(request.Nodes.MasterNodes()) > 0 {
+		fmt.Fprintln(options.LogWriter, "updating load balancer")
+
+		if err = p.UpdateLoadBalancer(state); err != nil {
+			return nil, fmt.Errorf("error updating loadbalancer: %w", err)
+		}
+	}
+
+	return state, nil
+}
+
+// RemoveNodes stops VMs of the nodes with the specified names, removes their files
+// from the state directory and updates the state.
+func (p *Provisioner) RemoveNodes(ctx context.Context, cluster provision.Cluster, nodeNames []string, opts ...provision.Option) (provision.Cluster, error) {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	state, ok := cluster.(*State)
+	if !ok {
+		return nil, fmt.Errorf("error inspecting %s state, %#+v", p.Name, cluster)
+	}
+
+	existing := make(map[string]struct{}, len(state.ClusterInfo.Nodes))
+
+	for _, node := range state.ClusterInfo.Nodes {
+		existing[node.Name] = struct{}{}
+	}
+
+	remove := make(map[string]struct{}, len(nodeNames))
+
+	for _, name := range nodeNames {
+		if _, ok := existing[name]; !ok {
+			return nil, fmt.Errorf("node %q not found in cluster %q", name, state.ClusterInfo.ClusterName)
+		}
+
+		remove[name] = struct{}{}
+	}
+
+	var (
+		removed   provision.ClusterInfo
+		remaining []provision.NodeInfo
+	)
+
+	for _, node := range state.ClusterInfo.Nodes {
+		if _, ok := remove[node.Name]; ok {
+			removed.Nodes = append(removed.Nodes, node)
+		} else {
+			remaining = append(remaining, node)
+		}
+	}
+
+	if err := p.DestroyNodes(removed, &options); err != nil {
+		return nil, err
+	}
+
+	for _, node := range removed.Nodes {
+		if err := p.removeNodeFiles(state, node.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	state.ClusterInfo.Nodes = remaining
+
+	if err := state.Save(); err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintln(options.LogWriter, "updating load balancer")
+
+	if err := p.UpdateLoadBalancer(state); err != nil {
+		return nil, fmt.Errorf("error updating loadbalancer: %w", err)
+	}
+
+	return state, nil
+}
+
+// removeNodeFiles removes disk images, logs and other node files from the state directory.
+func (p *Provisioner) removeNodeFiles(state *State, nodeName string) error {
+	for _, pattern := range []string{nodeName + ".*", nodeName + "-*"} {
+		paths, err := filepath.Glob(state.GetRelativePath(pattern))
+		if err != nil {
+			return err
+		}
+
+		for _, path := range paths {
+			if err = os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -2,16 +2,23 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-package firecracker
+package vm
 
 import (
 	"errors"
 	"fmt"
 	"os"
 	"syscall"
+	"time"
 )
 
-func stopProcessByPidfile(pidPath string) error {
+// processStopTimeout is the time to wait for the process to exit after SIGTERM.
+const processStopTimeout = 30 * time.Second
+
+// StopProcessByPidfile stops the process by its PID file.
+//
+// StopProcessByPidfile returns once the process is gone.
+func StopProcessByPidfile(pidPath string) error {
 	pidFile, err := os.Open(pidPath)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -44,7 +51,8 @@ func stopProcessByPidfile(pidPath string) error {
 
 	if _, err = proc.Wait(); err != nil {
 		if errors.Is(err, syscall.ECHILD) {
-			return nil
+			// process is not a child of the current process, so it can't be waited for
+			return waitProcessExit(pid, pidPath)
 		}
 
 		return fmt.Errorf("error waiting for %d to exit (path %q): %w", pid, pidPath, err)
@@ -52,3 +60,25 @@ func stopProcessByPidfile(pidPath string) error {
 
 	return nil
 }
+
+// waitProcessExit polls the process until it is gone.
+func waitProcessExit(pid int, pidPath string) error {
+	deadline := time.Now().Add(processStopTimeout)
+
+	for {
+		err := syscall.Kill(pid, 0)
+		if errors.Is(err, syscall.ESRCH) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error checking process %d (path %q): %w", pid, pidPath, err)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for %d to exit (path %q)", pid, pidPath)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-package firecracker
+package vm
 
 import (
 	"context"
@@ -15,7 +15,8 @@ import (
 	"github.com/talos-systems/talos/internal/pkg/provision"
 )
 
-func (p *provisioner) Reflect(ctx context.Context, clusterName, stateDirectory string) (provision.Cluster, error) {
+// Reflect decodes cluster state from the state directory.
+func (p *Provisioner) Reflect(ctx context.Context, clusterName, stateDirectory string) (provision.Cluster, error) {
 	statePath := filepath.Join(stateDirectory, clusterName)
 
 	st, err := os.Stat(statePath)
@@ -38,17 +39,22 @@ func (p *provisioner) Reflect(ctx context.Context, clusterName, stateDirectory s
 
 	defer stateFile.Close() //nolint: errcheck
 
-	state := &state{}
+	state := &State{}
 
 	if err = yaml.NewDecoder(stateFile).Decode(state); err != nil {
 		return nil, fmt.Errorf("error unmarshalling state file: %w", err)
 	}
 
-	if state.ProvisionerName != "firecracker" {
+	if state.ProvisionerName != p.Name {
 		return nil, fmt.Errorf("cluster %q was created with different provisioner %q", clusterName, state.ProvisionerName)
 	}
 
 	state.statePath = statePath
 
+	// VM CNI config is not persisted, rebuild it as it's required to launch new nodes
+	if state.VMCNIConfig, err = vmCNIConfig(state.ClusterInfo.Network.Name, state.BridgeName, state.ClusterInfo.Network.MTU); err != nil {
+		return nil, err
+	}
+
 	return state, nil
 }
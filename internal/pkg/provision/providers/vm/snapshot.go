@@ -0,0 +1,238 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+)
+
+// Save stops the VMs, archives the state directory (disk images, launch configs,
+// machine configuration) and launches the VMs again.
+func (p *Provisioner) Save(ctx context.Context, cluster provision.Cluster, w io.Writer, opts ...provision.Option) error {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return err
+		}
+	}
+
+	state, ok := cluster.(*State)
+	if !ok {
+		return fmt.Errorf("error inspecting %s state, %#+v", p.Name, cluster)
+	}
+
+	// disk images are consistent only when VMs are stopped
+	if err := p.DestroyNodes(state.ClusterInfo, &options); err != nil {
+		return err
+	}
+
+	// stale VM API sockets can't be archived
+	if err := removeStateFiles(state, "*.sock"); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(options.LogWriter, "saving cluster state")
+
+	var result *multierror.Error
+
+	result = multierror.Append(result, provision.WriteSnapshot(ctx, state.statePath, &provision.SnapshotManifest{
+		Provisioner: p.Name,
+		ClusterInfo: state.ClusterInfo,
+	}, w))
+
+	result = multierror.Append(result, p.launchNodes(state, &options))
+
+	return result.ErrorOrNil()
+}
+
+// Restore recreates the cluster from the snapshot with the same node names and IPs.
+//
+//nolint: gocyclo
+func (p *Provisioner) Restore(ctx context.Context, request provision.RestoreRequest, opts ...provision.Option) (provision.Cluster, error) {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	statePath, manifest, err := provision.ExtractSnapshot(ctx, request.StateDirectory, request.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(options.LogWriter, "restored state directory in %q\n", statePath)
+
+	if manifest.Provisioner != p.Name {
+		return nil, fmt.Errorf("snapshot was created with different provisioner %q", manifest.Provisioner)
+	}
+
+	// PIDs in the snapshot refer to processes of the host the snapshot was taken on
+	state := &State{statePath: statePath}
+
+	if err = removeStateFiles(state, "*.pid"); err != nil {
+		return nil, err
+	}
+
+	if manifest.StatePath != statePath {
+		if err = relocateLaunchConfigs(state, manifest); err != nil {
+			return nil, err
+		}
+	}
+
+	cluster, err := p.Reflect(ctx, manifest.ClusterInfo.ClusterName, request.StateDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	var ok bool
+
+	if state, ok = cluster.(*State); !ok {
+		return nil, fmt.Errorf("error inspecting %s state, %#+v", p.Name, cluster)
+	}
+
+	state.SelfExecutable = request.SelfExecutable
+
+	for i := range state.ClusterInfo.Nodes {
+		state.ClusterInfo.Nodes[i].ID = state.GetRelativePath(fmt.Sprintf("%s.pid", state.ClusterInfo.Nodes[i].Name))
+	}
+
+	fmt.Fprintln(options.LogWriter, "creating network", state.ClusterInfo.Network.Name)
+
+	if err = p.CreateNetwork(ctx, state, provision.NetworkRequest{
+		Name:        state.ClusterInfo.Network.Name,
+		CIDR:        state.ClusterInfo.Network.CIDR,
+		GatewayAddr: state.ClusterInfo.Network.GatewayAddr,
+		MTU:         state.ClusterInfo.Network.MTU,
+		CNI:         request.CNI,
+	}); err != nil {
+		return nil, fmt.Errorf("unable to provision CNI network: %w", err)
+	}
+
+	fmt.Fprintln(options.LogWriter, "creating load balancer")
+
+	if err = p.UpdateLoadBalancer(state); err != nil {
+		return nil, fmt.Errorf("error creating loadbalancer: %w", err)
+	}
+
+	if p.CreateNetworkServices != nil {
+		fmt.Fprintln(options.LogWriter, "creating network services")
+
+		if err = p.CreateNetworkServices(state); err != nil {
+			return nil, fmt.Errorf("error creating network services: %w", err)
+		}
+	}
+
+	if err = p.launchNodes(state, &options); err != nil {
+		return nil, err
+	}
+
+	if err = state.Save(); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// launchNodes starts VMs from the launch configs saved in the state directory.
+func (p *Provisioner) launchNodes(state *State, options *provision.Options) error {
+	var multiErr *multierror.Error
+
+	for _, node := range state.ClusterInfo.Nodes {
+		fmt.Fprintln(options.LogWriter, "starting VM", node.Name)
+
+		multiErr = multierror.Append(multiErr, p.launchNode(state, node.Name))
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+func (p *Provisioner) launchNode(state *State, nodeName string) error {
+	pidPath := state.GetRelativePath(fmt.Sprintf("%s.pid", nodeName))
+
+	logFile, err := os.OpenFile(state.GetRelativePath(fmt.Sprintf("%s.log", nodeName)), os.O_APPEND|os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+
+	defer logFile.Close() //nolint: errcheck
+
+	launchConfigFile, err := os.Open(state.GetRelativePath(fmt.Sprintf("%s.config", nodeName)))
+	if err != nil {
+		return err
+	}
+
+	defer launchConfigFile.Close() //nolint: errcheck
+
+	cmd := exec.Command(state.SelfExecutable, fmt.Sprintf("%s-launch", p.Name))
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.Stdin = launchConfigFile
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setsid: true, // daemonize
+	}
+
+	if err = cmd.Start(); err != nil {
+		return err
+	}
+
+	if err = ioutil.WriteFile(pidPath, []byte(strconv.Itoa(cmd.Process.Pid)), os.ModePerm); err != nil {
+		return fmt.Errorf("error writing PID file: %w", err)
+	}
+
+	return nil
+}
+
+// relocateLaunchConfigs updates paths (disk images, sockets) in the launch configs
+// if the cluster is restored to a different state directory.
+func relocateLaunchConfigs(state *State, manifest *provision.SnapshotManifest) error {
+	oldPrefix := []byte(manifest.StatePath + string(os.PathSeparator))
+	newPrefix := []byte(state.statePath + string(os.PathSeparator))
+
+	for _, node := range manifest.ClusterInfo.Nodes {
+		path := state.GetRelativePath(fmt.Sprintf("%s.config", node.Name))
+
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if err = ioutil.WriteFile(path, bytes.ReplaceAll(contents, oldPrefix, newPrefix), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func removeStateFiles(state *State, pattern string) error {
+	paths, err := filepath.Glob(state.GetRelativePath(pattern))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		if err = os.Remove(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
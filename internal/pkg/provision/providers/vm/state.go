@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package vm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/containernetworking/cni/libcni"
+	"gopkg.in/yaml.v2"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+)
+
+// State is a common state representation for VM provisioners.
+type State struct {
+	ProvisionerName string
+	BridgeName      string
+
+	ClusterInfo provision.ClusterInfo
+
+	// Load balancer launch parameters.
+	SelfExecutable string
+	LoadBalancer   provision.LoadBalancerConfig
+
+	VMCNIConfig *libcni.NetworkConfigList `yaml:"-"`
+
+	statePath string
+}
+
+// NewState creates new VM provisioner state, creating the state directory.
+func NewState(statePath, provisionerName, clusterName string) (*State, error) {
+	s := &State{
+		ProvisionerName: provisionerName,
+		statePath:       statePath,
+	}
+
+	_, err := os.Stat(s.statePath)
+	if err == nil {
+		return nil, fmt.Errorf(
+			"state directory %q already exists, is the cluster %q already running? remove cluster state with osctl cluster destroy",
+			s.statePath,
+			clusterName,
+		)
+	}
+
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error checking state directory: %w", err)
+	}
+
+	if err = os.MkdirAll(s.statePath, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("error creating state directory: %w", err)
+	}
+
+	return s, nil
+}
+
+// Provisioner returns provisioner name.
+func (s *State) Provisioner() string {
+	return s.ProvisionerName
+}
+
+// Info returns cluster info.
+func (s *State) Info() provision.ClusterInfo {
+	return s.ClusterInfo
+}
+
+// StatePath returns path to the state directory.
+func (s *State) StatePath() (string, error) {
+	if s.statePath == "" {
+		return "", fmt.Errorf("state path is not set")
+	}
+
+	return s.statePath, nil
+}
+
+// Save state to the state file.
+func (s *State) Save() error {
+	stateFile, err := os.Create(filepath.Join(s.statePath, stateFileName))
+	if err != nil {
+		return err
+	}
+
+	defer stateFile.Close() //nolint: errcheck
+
+	if err = yaml.NewEncoder(stateFile).Encode(&s); err != nil {
+		return fmt.Errorf("error marshaling state: %w", err)
+	}
+
+	return stateFile.Close()
+}
+
+// GetRelativePath returns path relative to the state directory.
+func (s *State) GetRelativePath(path string) string {
+	return filepath.Join(s.statePath, path)
+}
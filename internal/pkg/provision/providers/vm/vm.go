@@ -0,0 +1,28 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package vm implements common methods for VM provisioners.
+package vm
+
+import (
+	"github.com/talos-systems/talos/internal/pkg/provision"
+)
+
+const stateFileName = "state.yaml"
+
+// CreateNodesFunc creates and launches VMs for the node requests.
+type CreateNodesFunc func(state *State, clusterReq provision.ClusterRequest, nodeReqs []provision.NodeRequest, opts *provision.Options) ([]provision.NodeInfo, error)
+
+// Provisioner is a base for VM provisioners.
+type Provisioner struct {
+	// Name of the actual provisioner type.
+	Name string
+
+	// CreateNodes is implemented by the actual provisioner type.
+	CreateNodes CreateNodesFunc
+
+	// CreateNetworkServices is optionally implemented by the actual provisioner type
+	// to launch services of the cluster network (e.g. DHCP server) when the cluster is restored.
+	CreateNetworkServices func(state *State) error
+}
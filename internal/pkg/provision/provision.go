@@ -17,10 +17,16 @@ type Provisioner interface {
 	Create(context.Context, ClusterRequest, ...Option) (Cluster, error)
 	Destroy(context.Context, Cluster, ...Option) error
 
-	CrashDump(context.Context, Cluster, io.Writer)
+	AddNodes(context.Context, Cluster, ClusterRequest, ...Option) (Cluster, error)
+	RemoveNodes(ctx context.Context, cluster Cluster, nodeNames []string, opts ...Option) (Cluster, error)
+
+	CrashDump(context.Context, Cluster, io.Writer, ...Option)
 
 	Reflect(ctx context.Context, clusterName, stateDirectory string) (Cluster, error)
 
+	Save(context.Context, Cluster, io.Writer, ...Option) error
+	Restore(context.Context, RestoreRequest, ...Option) (Cluster, error)
+
 	GenOptions(NetworkRequest) []generate.GenOption
 	GetLoadBalancers(NetworkRequest) (internalEndpoint, externalEndpoint string)
 
@@ -117,4 +117,8 @@ type NodeRequest struct {
 	Memory int64
 	// Disk (volume) size in bytes, if applicable
 	DiskSize int64
+	// Extra disks (volumes) sizes in bytes, if applicable
+	ExtraDisks []int64
+	// Addresses of extra network interfaces, if applicable
+	ExtraIPs []net.IP
 }
@@ -48,7 +48,12 @@ type NodeInfo struct {
 	Memory int64
 	// Disk (volume) size in bytes, if applicable
 	DiskSize int64
+	// Extra disks (volumes) sizes in bytes, if applicable
+	ExtraDisks []int64
 
 	PublicIP  net.IP
 	PrivateIP net.IP
+
+	// Addresses of extra network interfaces, if applicable
+	ExtraIPs []net.IP
 }
@@ -0,0 +1,138 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package provision
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/talos-systems/talos/pkg/archiver"
+)
+
+// SnapshotVersion is the current version of the snapshot format.
+const SnapshotVersion = "v1"
+
+// SnapshotManifestName is the name of the manifest file in the snapshot archive.
+const SnapshotManifestName = "snapshot.yaml"
+
+// SnapshotManifest describes the cluster captured in the snapshot archive.
+//
+// Snapshot archive is a .tar.gz archive of the cluster state directory with the manifest
+// at the root. Provisioners store node disks or volumes in the state directory before
+// the archive is built.
+type SnapshotManifest struct {
+	Version     string
+	Provisioner string
+
+	// Path to the state directory of the cluster at the moment of the snapshot.
+	StatePath string
+
+	ClusterInfo ClusterInfo
+}
+
+// RestoreRequest describes the cluster to be restored from the snapshot.
+type RestoreRequest struct {
+	// Snapshot archive (as produced by Provisioner.Save).
+	Snapshot io.Reader
+
+	// Path to root of state directory (~/.talos/clusters by default).
+	StateDirectory string
+
+	// Path to osctl executable to re-execute itself as needed.
+	SelfExecutable string
+
+	// CNI-specific parameters.
+	CNI CNIConfig
+}
+
+// WriteSnapshot builds snapshot archive of the state directory with the manifest.
+func WriteSnapshot(ctx context.Context, statePath string, manifest *SnapshotManifest, w io.Writer) error {
+	manifest.Version = SnapshotVersion
+	manifest.StatePath = statePath
+
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("error marshaling snapshot manifest: %w", err)
+	}
+
+	manifestPath := filepath.Join(statePath, SnapshotManifestName)
+
+	if err = ioutil.WriteFile(manifestPath, data, 0644); err != nil {
+		return err
+	}
+
+	defer os.Remove(manifestPath) //nolint: errcheck
+
+	return archiver.TarGz(ctx, statePath, w)
+}
+
+// ExtractSnapshot unpacks snapshot archive into the state directory of the cluster.
+//
+// Archive is unpacked to the temporary directory first, as the name of the cluster
+// is only known after the manifest is read. Restore fails if the state directory for
+// the cluster already exists.
+func ExtractSnapshot(ctx context.Context, stateDirectory string, r io.Reader) (statePath string, manifest *SnapshotManifest, err error) {
+	if err = os.MkdirAll(stateDirectory, os.ModePerm); err != nil {
+		return "", nil, fmt.Errorf("error creating state directory: %w", err)
+	}
+
+	tmpPath, err := ioutil.TempDir(stateDirectory, ".restore")
+	if err != nil {
+		return "", nil, err
+	}
+
+	defer os.RemoveAll(tmpPath) //nolint: errcheck
+
+	if err = archiver.UntarGz(ctx, r, tmpPath); err != nil {
+		return "", nil, fmt.Errorf("error extracting snapshot: %w", err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(tmpPath, SnapshotManifestName))
+	if err != nil {
+		return "", nil, fmt.Errorf("error reading snapshot manifest: %w", err)
+	}
+
+	manifest = &SnapshotManifest{}
+
+	if err = yaml.Unmarshal(data, manifest); err != nil {
+		return "", nil, fmt.Errorf("error unmarshalling snapshot manifest: %w", err)
+	}
+
+	if manifest.Version != SnapshotVersion {
+		return "", nil, fmt.Errorf("unsupported snapshot version %q", manifest.Version)
+	}
+
+	if err = os.Remove(filepath.Join(tmpPath, SnapshotManifestName)); err != nil {
+		return "", nil, err
+	}
+
+	clusterName := manifest.ClusterInfo.ClusterName
+
+	if clusterName == "" || clusterName == "." || clusterName == ".." || filepath.Base(clusterName) != clusterName {
+		return "", nil, fmt.Errorf("invalid cluster name %q in snapshot manifest", clusterName)
+	}
+
+	statePath = filepath.Join(stateDirectory, clusterName)
+
+	if _, err = os.Stat(statePath); err == nil {
+		return "", nil, fmt.Errorf(
+			"state directory %q already exists, is the cluster %q already running? remove cluster state with osctl cluster destroy",
+			statePath,
+			manifest.ClusterInfo.ClusterName,
+		)
+	}
+
+	if err = os.Rename(tmpPath, statePath); err != nil {
+		return "", nil, err
+	}
+
+	return statePath, manifest, nil
+}
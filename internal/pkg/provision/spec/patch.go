@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package spec
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/talos-systems/talos/internal/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/config"
+)
+
+// ApplyConfigPatches merges patches into the machine configuration in order.
+//
+// Maps are merged recursively, any other values (including lists) in the patch
+// replace the values in the configuration.
+func ApplyConfigPatches(cfg runtime.Configurator, patches ...map[string]interface{}) (runtime.Configurator, error) {
+	empty := true
+
+	for _, patch := range patches {
+		if len(patch) > 0 {
+			empty = false
+		}
+	}
+
+	if empty {
+		return cfg, nil
+	}
+
+	in, err := cfg.String()
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[interface{}]interface{}
+
+	if err = yaml.Unmarshal([]byte(in), &doc); err != nil {
+		return nil, err
+	}
+
+	for _, patch := range patches {
+		for k, v := range patch {
+			doc[k] = merge(doc[k], v)
+		}
+	}
+
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := config.NewFromBytes(out)
+	if err != nil {
+		return nil, fmt.Errorf("error applying config patch: %w", err)
+	}
+
+	return patched, nil
+}
+
+func merge(dst, src interface{}) interface{} {
+	srcMap, ok := toMap(src)
+	if !ok {
+		return src
+	}
+
+	dstMap, ok := toMap(dst)
+	if !ok {
+		return srcMap
+	}
+
+	for k, v := range srcMap {
+		dstMap[k] = merge(dstMap[k], v)
+	}
+
+	return dstMap
+}
+
+// toMap converts both flavors of maps produced by YAML decoding to map[interface{}]interface{}.
+func toMap(v interface{}) (map[interface{}]interface{}, bool) {
+	switch m := v.(type) {
+	case map[interface{}]interface{}:
+		return m, true
+	case map[string]interface{}:
+		result := make(map[interface{}]interface{}, len(m))
+
+		for k, v := range m {
+			result[k] = v
+		}
+
+		return result, true
+	default:
+		return nil, false
+	}
+}
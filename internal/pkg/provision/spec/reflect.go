@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package spec
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+)
+
+// FromClusterInfo builds cluster spec describing the running cluster.
+//
+// Machine configuration patches can't be recovered from the running cluster,
+// so they're not present in the result.
+func FromClusterInfo(provisionerName string, info provision.ClusterInfo) *Cluster {
+	cluster := &Cluster{
+		Version:     Version,
+		Name:        info.ClusterName,
+		Provisioner: provisionerName,
+		Network: Network{
+			MTU: info.Network.MTU,
+		},
+	}
+
+	if info.Network.CIDR.IP != nil {
+		cluster.Network.CIDR = info.Network.CIDR.String()
+	}
+
+	nodes := append([]provision.NodeInfo(nil), info.Nodes...)
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	for _, nodeInfo := range nodes {
+		node := Node{
+			Name: strings.TrimPrefix(nodeInfo.Name, "/"),
+			Type: nodeInfo.Type.String(),
+		}
+
+		if nodeInfo.PrivateIP != nil {
+			node.IP = nodeInfo.PrivateIP.String()
+		}
+
+		for _, ip := range nodeInfo.ExtraIPs {
+			node.ExtraIPs = append(node.ExtraIPs, ip.String())
+		}
+
+		if nodeInfo.NanoCPUs > 0 {
+			node.CPUs = FormatCPUs(nodeInfo.NanoCPUs)
+		}
+
+		if nodeInfo.Memory > 0 {
+			node.Memory = FormatSize(nodeInfo.Memory)
+		}
+
+		if nodeInfo.DiskSize > 0 {
+			node.Disk = FormatSize(nodeInfo.DiskSize)
+		}
+
+		for _, size := range nodeInfo.ExtraDisks {
+			node.ExtraDisks = append(node.ExtraDisks, FormatSize(size))
+		}
+
+		cluster.Nodes = append(cluster.Nodes, node)
+	}
+
+	return cluster
+}
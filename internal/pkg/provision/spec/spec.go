@@ -0,0 +1,270 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package spec implements declarative cluster spec file used by 'osctl cluster create'.
+package spec
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"strconv"
+
+	"github.com/dustin/go-humanize"
+	"gopkg.in/yaml.v2"
+
+	"github.com/talos-systems/talos/pkg/config/machine"
+)
+
+// Version is the current version of the cluster spec format.
+const Version = "v1alpha1"
+
+// Cluster is the root object of the cluster spec file.
+//
+// Fields which are not set in the spec are filled with defaults (usually from osctl flags).
+type Cluster struct {
+	Version           string            `yaml:"version"`
+	Name              string            `yaml:"name,omitempty"`
+	Provisioner       string            `yaml:"provisioner,omitempty"`
+	KubernetesVersion string            `yaml:"kubernetesVersion,omitempty"`
+	Image             string            `yaml:"image,omitempty"`
+	InstallImage      string            `yaml:"installImage,omitempty"`
+	VmlinuxPath       string            `yaml:"vmlinuxPath,omitempty"` // uncompressed kernel, used by Firecracker
+	VmlinuzPath       string            `yaml:"vmlinuzPath,omitempty"` // compressed kernel, used by QEMU
+	InitrdPath        string            `yaml:"initrdPath,omitempty"`
+	Endpoint          string            `yaml:"endpoint,omitempty"`
+	RegistryMirrors   map[string]string `yaml:"registryMirrors,omitempty"`
+	Network           Network           `yaml:"network,omitempty"`
+	// ConfigPatch is merged into machine configuration of every node.
+	ConfigPatch map[string]interface{} `yaml:"configPatch,omitempty"`
+	Nodes       []Node                 `yaml:"nodes"`
+}
+
+// Network describes cluster network.
+type Network struct {
+	CIDR        string   `yaml:"cidr,omitempty"`
+	MTU         int      `yaml:"mtu,omitempty"`
+	Nameservers []string `yaml:"nameservers,omitempty"`
+	CNI         *CNI     `yaml:"cni,omitempty"`
+}
+
+// CNI describes CNI settings for VM provisioners.
+type CNI struct {
+	BinPath  []string `yaml:"binPath,omitempty"`
+	ConfDir  string   `yaml:"confDir,omitempty"`
+	CacheDir string   `yaml:"cacheDir,omitempty"`
+}
+
+// Node describes a single node of the cluster.
+type Node struct {
+	Name string `yaml:"name,omitempty"`
+	// Type is one of Init, ControlPlane or Join.
+	Type string `yaml:"type"`
+	IP   string `yaml:"ip,omitempty"`
+	// ExtraIPs are addresses of extra network interfaces.
+	ExtraIPs []string `yaml:"extraIPs,omitempty"`
+	// ExtraNICs is the number of extra network interfaces with allocated addresses,
+	// ignored if ExtraIPs are set.
+	ExtraNICs int `yaml:"extraNICs,omitempty"`
+	// CPUs is a share of CPUs as fraction, e.g. "1.5".
+	CPUs string `yaml:"cpus,omitempty"`
+	// Memory, Disk and ExtraDisks are sizes in human-readable form, e.g. "2GiB".
+	Memory     string   `yaml:"memory,omitempty"`
+	Disk       string   `yaml:"disk,omitempty"`
+	ExtraDisks []string `yaml:"extraDisks,omitempty"`
+	// ConfigPatch is merged into machine configuration of the node
+	// after the cluster-wide patch.
+	ConfigPatch map[string]interface{} `yaml:"configPatch,omitempty"`
+}
+
+// Load reads and validates cluster spec from the file.
+func Load(path string) (*Cluster, error) {
+	in, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cluster spec: %w", err)
+	}
+
+	return Parse(in)
+}
+
+// Parse parses and validates cluster spec.
+func Parse(in []byte) (*Cluster, error) {
+	var cluster Cluster
+
+	if err := yaml.UnmarshalStrict(in, &cluster); err != nil {
+		return nil, fmt.Errorf("error parsing cluster spec: %w", err)
+	}
+
+	if err := cluster.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &cluster, nil
+}
+
+// Validate checks cluster spec for consistency.
+//
+// nolint: gocyclo
+func (c *Cluster) Validate() error {
+	if c.Version != Version {
+		return fmt.Errorf("unsupported cluster spec version %q, expected %q", c.Version, Version)
+	}
+
+	if c.Network.CIDR != "" {
+		if _, _, err := net.ParseCIDR(c.Network.CIDR); err != nil {
+			return fmt.Errorf("invalid network CIDR: %w", err)
+		}
+	}
+
+	for _, nameserver := range c.Network.Nameservers {
+		if net.ParseIP(nameserver) == nil {
+			return fmt.Errorf("invalid nameserver IP %q", nameserver)
+		}
+	}
+
+	if len(c.Nodes) == 0 {
+		return fmt.Errorf("cluster spec should contain at least one node")
+	}
+
+	initNodes := 0
+	names := map[string]struct{}{}
+
+	for i, node := range c.Nodes {
+		nodeType, err := node.MachineType()
+		if err != nil {
+			return fmt.Errorf("node %d: %w", i, err)
+		}
+
+		if nodeType == machine.TypeInit {
+			initNodes++
+		}
+
+		if node.Name != "" {
+			if _, exists := names[node.Name]; exists {
+				return fmt.Errorf("node %d: duplicate node name %q", i, node.Name)
+			}
+
+			names[node.Name] = struct{}{}
+		}
+
+		for _, ip := range append([]string{node.IP}, node.ExtraIPs...) {
+			if ip != "" && net.ParseIP(ip) == nil {
+				return fmt.Errorf("node %d: invalid IP %q", i, ip)
+			}
+		}
+
+		if node.ExtraNICs < 0 {
+			return fmt.Errorf("node %d: number of extra NICs can't be negative", i)
+		}
+
+		if node.CPUs != "" {
+			if _, err = ParseCPUs(node.CPUs); err != nil {
+				return fmt.Errorf("node %d: invalid cpus: %w", i, err)
+			}
+		}
+
+		for _, size := range append([]string{node.Memory, node.Disk}, node.ExtraDisks...) {
+			if size == "" {
+				continue
+			}
+
+			if _, err = ParseSize(size); err != nil {
+				return fmt.Errorf("node %d: invalid size: %w", i, err)
+			}
+		}
+	}
+
+	if initNodes != 1 {
+		return fmt.Errorf("cluster spec should contain exactly one Init node, found %d", initNodes)
+	}
+
+	return nil
+}
+
+// AssignNodeNames fills in names of the nodes which are not named in the spec.
+//
+// Masters are named <cluster>-master-N and workers <cluster>-worker-N, where N counts
+// all the nodes of the same kind, named or not. Generated names might clash with the names
+// set in the spec, so uniqueness is checked once all the nodes have names.
+func (c *Cluster) AssignNodeNames(clusterName string) error {
+	var masterIndex, workerIndex int
+
+	for i := range c.Nodes {
+		node := &c.Nodes[i]
+
+		nodeType, err := node.MachineType()
+		if err != nil {
+			return fmt.Errorf("node %d: %w", i, err)
+		}
+
+		var name string
+
+		if nodeType == machine.TypeWorker {
+			workerIndex++
+
+			name = fmt.Sprintf("%s-worker-%d", clusterName, workerIndex)
+		} else {
+			masterIndex++
+
+			name = fmt.Sprintf("%s-master-%d", clusterName, masterIndex)
+		}
+
+		if node.Name == "" {
+			node.Name = name
+		}
+	}
+
+	names := map[string]int{}
+
+	for i, node := range c.Nodes {
+		if j, exists := names[node.Name]; exists {
+			return fmt.Errorf("node %d: name %q is already used by node %d", i, node.Name, j)
+		}
+
+		names[node.Name] = i
+	}
+
+	return nil
+}
+
+// MachineType returns parsed node type.
+func (n *Node) MachineType() (machine.Type, error) {
+	return machine.ParseType(n.Type)
+}
+
+// ParseCPUs parses share of CPUs as fraction into 1e-9 fractions.
+func ParseCPUs(cpus string) (int64, error) {
+	cpu, ok := new(big.Rat).SetString(cpus)
+	if !ok {
+		return 0, fmt.Errorf("failed to parsing as a rational number: %s", cpus)
+	}
+
+	nano := cpu.Mul(cpu, big.NewRat(1e9, 1))
+	if !nano.IsInt() {
+		return 0, fmt.Errorf("value is too precise")
+	}
+
+	return nano.Num().Int64(), nil
+}
+
+// FormatCPUs is the reverse of ParseCPUs.
+func FormatCPUs(nanoCPUs int64) string {
+	return strconv.FormatFloat(float64(nanoCPUs)/1e9, 'f', -1, 64)
+}
+
+// ParseSize parses human-readable size (e.g. "512MiB", "4 GB") into bytes.
+func ParseSize(size string) (int64, error) {
+	bytes, err := humanize.ParseBytes(size)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(bytes), nil
+}
+
+// FormatSize is the reverse of ParseSize.
+func FormatSize(bytes int64) string {
+	return humanize.IBytes(uint64(bytes))
+}
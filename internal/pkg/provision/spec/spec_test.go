@@ -0,0 +1,239 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package spec_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/internal/pkg/provision/spec"
+	"github.com/talos-systems/talos/pkg/config"
+	"github.com/talos-systems/talos/pkg/config/machine"
+)
+
+const clusterSpec = `version: v1alpha1
+name: test
+provisioner: qemu
+vmlinuxPath: _out/vmlinux
+vmlinuzPath: _out/vmlinuz
+network:
+  cidr: 10.6.0.0/24
+  mtu: 1450
+configPatch:
+  machine:
+    install:
+      disk: /dev/vda
+nodes:
+  - type: Init
+    cpus: "2"
+    memory: 2GiB
+    extraDisks: [5GiB, 10GiB]
+  - type: ControlPlane
+    ip: 10.6.0.10
+  - name: test-storage
+    type: Join
+    extraNICs: 1
+    configPatch:
+      machine:
+        network:
+          hostname: storage
+`
+
+func TestParse(t *testing.T) {
+	cluster, err := spec.Parse([]byte(clusterSpec))
+	require.NoError(t, err)
+
+	assert.Equal(t, "test", cluster.Name)
+	assert.Equal(t, "qemu", cluster.Provisioner)
+	assert.Equal(t, "_out/vmlinux", cluster.VmlinuxPath)
+	assert.Equal(t, "_out/vmlinuz", cluster.VmlinuzPath)
+	assert.Equal(t, 1450, cluster.Network.MTU)
+	assert.Len(t, cluster.Nodes, 3)
+	assert.Equal(t, []string{"5GiB", "10GiB"}, cluster.Nodes[0].ExtraDisks)
+	assert.Equal(t, "10.6.0.10", cluster.Nodes[1].IP)
+	assert.Equal(t, 1, cluster.Nodes[2].ExtraNICs)
+
+	nodeType, err := cluster.Nodes[2].MachineType()
+	require.NoError(t, err)
+	assert.Equal(t, machine.TypeWorker, nodeType)
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		spec string
+	}{
+		{
+			name: "version",
+			spec: "version: v2\nnodes: [{type: Init}]\n",
+		},
+		{
+			name: "no init node",
+			spec: "version: v1alpha1\nnodes: [{type: ControlPlane}]\n",
+		},
+		{
+			name: "two init nodes",
+			spec: "version: v1alpha1\nnodes: [{type: Init}, {type: Init}]\n",
+		},
+		{
+			name: "unknown type",
+			spec: "version: v1alpha1\nnodes: [{type: Init}, {type: Master}]\n",
+		},
+		{
+			name: "duplicate name",
+			spec: "version: v1alpha1\nnodes: [{type: Init, name: a}, {type: Join, name: a}]\n",
+		},
+		{
+			name: "invalid size",
+			spec: "version: v1alpha1\nnodes: [{type: Init, memory: lots}]\n",
+		},
+		{
+			name: "invalid cpus",
+			spec: "version: v1alpha1\nnodes: [{type: Init, cpus: 0.0000000001}]\n",
+		},
+		{
+			name: "unknown field",
+			spec: "version: v1alpha1\nnodes: [{type: Init, gpus: 1}]\n",
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := spec.Parse([]byte(tt.spec))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAssignNodeNames(t *testing.T) {
+	cluster, err := spec.Parse([]byte(clusterSpec))
+	require.NoError(t, err)
+
+	require.NoError(t, cluster.AssignNodeNames("test"))
+
+	assert.Equal(t, "test-master-1", cluster.Nodes[0].Name)
+	assert.Equal(t, "test-master-2", cluster.Nodes[1].Name)
+	assert.Equal(t, "test-storage", cluster.Nodes[2].Name)
+
+	// name from the spec clashes with the generated one
+	cluster, err = spec.Parse([]byte("version: v1alpha1\nnodes: [{type: Init}, {type: Join, name: test-master-1}]\n"))
+	require.NoError(t, err)
+
+	assert.Error(t, cluster.AssignNodeNames("test"))
+
+	// generated names depend on the cluster name
+	cluster, err = spec.Parse([]byte("version: v1alpha1\nnodes: [{type: Init}, {type: Join, name: test-master-1}]\n"))
+	require.NoError(t, err)
+
+	require.NoError(t, cluster.AssignNodeNames("other"))
+	assert.Equal(t, "other-master-1", cluster.Nodes[0].Name)
+}
+
+func TestParseCPUs(t *testing.T) {
+	nanoCPUs, err := spec.ParseCPUs("1.5")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1500000000, nanoCPUs)
+	assert.Equal(t, "1.5", spec.FormatCPUs(nanoCPUs))
+}
+
+func TestParseSize(t *testing.T) {
+	size, err := spec.ParseSize("4GiB")
+	require.NoError(t, err)
+	assert.EqualValues(t, 4*1024*1024*1024, size)
+
+	size, err = spec.ParseSize(spec.FormatSize(size))
+	require.NoError(t, err)
+	assert.EqualValues(t, 4*1024*1024*1024, size)
+}
+
+func TestApplyConfigPatches(t *testing.T) {
+	cfg, err := config.NewFromBytes([]byte(`version: v1alpha1
+machine:
+  type: join
+  install:
+    disk: /dev/sda
+    image: installer
+`))
+	require.NoError(t, err)
+
+	cluster, err := spec.Parse([]byte(clusterSpec))
+	require.NoError(t, err)
+
+	patched, err := spec.ApplyConfigPatches(cfg, cluster.ConfigPatch, cluster.Nodes[2].ConfigPatch)
+	require.NoError(t, err)
+
+	assert.Equal(t, "/dev/vda", patched.Machine().Install().Disk())
+	assert.Equal(t, "installer", patched.Machine().Install().Image())
+	assert.Equal(t, "storage", patched.Machine().Network().Hostname())
+	assert.Equal(t, machine.TypeWorker, patched.Machine().Type())
+
+	// original config is not modified
+	assert.Equal(t, "/dev/sda", cfg.Machine().Install().Disk())
+}
+
+func TestFromClusterInfo(t *testing.T) {
+	_, cidr, err := net.ParseCIDR("10.5.0.0/24")
+	require.NoError(t, err)
+
+	cluster := spec.FromClusterInfo("qemu", provision.ClusterInfo{
+		ClusterName: "test",
+		Network: provision.NetworkInfo{
+			Name: "test",
+			CIDR: *cidr,
+			MTU:  1500,
+		},
+		Nodes: []provision.NodeInfo{
+			{
+				Name:       "test-worker-1",
+				Type:       machine.TypeWorker,
+				NanoCPUs:   1500000000,
+				Memory:     1024 * 1024 * 1024,
+				DiskSize:   4 * 1024 * 1024 * 1024,
+				ExtraDisks: []int64{5 * 1024 * 1024 * 1024},
+				PrivateIP:  net.ParseIP("10.5.0.3"),
+				ExtraIPs:   []net.IP{net.ParseIP("10.5.0.5")},
+			},
+			{
+				Name:      "test-master-1",
+				Type:      machine.TypeInit,
+				PrivateIP: net.ParseIP("10.5.0.2"),
+			},
+		},
+	})
+
+	out, err := yaml.Marshal(cluster)
+	require.NoError(t, err)
+
+	assert.Equal(t, `version: v1alpha1
+name: test
+provisioner: qemu
+network:
+  cidr: 10.5.0.0/24
+  mtu: 1500
+nodes:
+- name: test-master-1
+  type: Init
+  ip: 10.5.0.2
+- name: test-worker-1
+  type: Join
+  ip: 10.5.0.3
+  extraIPs:
+  - 10.5.0.5
+  cpus: "1.5"
+  memory: 1.0 GiB
+  disk: 4.0 GiB
+  extraDisks:
+  - 5.0 GiB
+`, string(out))
+
+	// output can be fed back as a spec
+	_, err = spec.Parse(out)
+	assert.NoError(t, err)
+}
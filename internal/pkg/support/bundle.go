@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package support implements support bundles: tar.gz archives with the logs and
+// the state of the nodes collected to help with debugging.
+package support
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BundleVersion is the version of the support bundle layout.
+const BundleVersion = "v1"
+
+// ManifestPath is the path of the manifest in the bundle.
+const ManifestPath = "manifest.json"
+
+// Manifest describes the contents of the support bundle.
+type Manifest struct {
+	Version   string          `json:"version"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Source    string          `json:"source"`
+	Nodes     []string        `json:"nodes"`
+	Files     []ManifestFile  `json:"files"`
+	Errors    []ManifestError `json:"errors,omitempty"`
+}
+
+// ManifestFile describes a file in the bundle.
+type ManifestFile struct {
+	Path string `json:"path"`
+	Node string `json:"node,omitempty"`
+	Size int    `json:"size"`
+}
+
+// ManifestError describes a failure to collect a file.
+type ManifestError struct {
+	Path  string `json:"path"`
+	Node  string `json:"node,omitempty"`
+	Error string `json:"error"`
+}
+
+// Bundle writes support bundle archive.
+//
+// Files of the nodes are placed into the directories named after the nodes,
+// cluster-wide files are placed into the root of the archive.
+// Bundle is safe to be used from multiple goroutines.
+type Bundle struct {
+	mu sync.Mutex
+
+	gz *gzip.Writer
+	tw *tar.Writer
+
+	manifest Manifest
+	nodes    map[string]struct{}
+}
+
+// NewBundle creates support bundle writing to out.
+//
+// Source describes the producer of the bundle, e.g. 'osctl support'.
+func NewBundle(out io.Writer, source string) *Bundle {
+	gz := gzip.NewWriter(out)
+
+	return &Bundle{
+		gz: gz,
+		tw: tar.NewWriter(gz),
+		manifest: Manifest{
+			Version:   BundleVersion,
+			CreatedAt: time.Now().UTC(),
+			Source:    source,
+		},
+		nodes: map[string]struct{}{},
+	}
+}
+
+// AddFile adds a file to the bundle, node is empty for cluster-wide files.
+func (b *Bundle) AddFile(node, name string, data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	filePath := path.Join(node, name)
+
+	if err := b.writeFile(filePath, data); err != nil {
+		return err
+	}
+
+	b.addNode(node)
+	b.manifest.Files = append(b.manifest.Files, ManifestFile{
+		Path: filePath,
+		Node: node,
+		Size: len(data),
+	})
+
+	return nil
+}
+
+// AddError records in the manifest that a file couldn't be collected.
+func (b *Bundle) AddError(node, name string, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.addNode(node)
+	b.manifest.Errors = append(b.manifest.Errors, ManifestError{
+		Path:  path.Join(node, name),
+		Node:  node,
+		Error: err.Error(),
+	})
+}
+
+// Manifest returns a copy of the bundle manifest.
+func (b *Bundle) Manifest() Manifest {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	manifest := b.manifest
+
+	manifest.Nodes = make([]string, 0, len(b.nodes))
+	for node := range b.nodes {
+		manifest.Nodes = append(manifest.Nodes, node)
+	}
+
+	sort.Strings(manifest.Nodes)
+
+	manifest.Files = append([]ManifestFile(nil), b.manifest.Files...)
+	manifest.Errors = append([]ManifestError(nil), b.manifest.Errors...)
+
+	return manifest
+}
+
+// Close writes the manifest and flushes the archive.
+//
+// Close doesn't close the underlying writer.
+func (b *Bundle) Close() error {
+	data, err := json.MarshalIndent(b.Manifest(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err = b.writeFile(ManifestPath, data); err != nil {
+		return err
+	}
+
+	if err = b.tw.Close(); err != nil {
+		return err
+	}
+
+	return b.gz.Close()
+}
+
+func (b *Bundle) addNode(node string) {
+	if node != "" {
+		b.nodes[node] = struct{}{}
+	}
+}
+
+func (b *Bundle) writeFile(name string, data []byte) error {
+	if err := b.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     int64(len(data)),
+		Mode:     0644,
+		ModTime:  time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	_, err := b.tw.Write(data)
+
+	return err
+}
+
+// PrintBundle writes the files of the support bundle read from in as plain text to out.
+//
+// Each file is preceded by a header with its path in the bundle.
+func PrintBundle(out io.Writer, in io.Reader) error {
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(gz)
+
+	for {
+		var hdr *tar.Header
+
+		hdr, err = tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(out, "%s\n%s\n\n", hdr.Name, strings.Repeat("=", len(hdr.Name)))
+
+		if _, err = io.Copy(out, tr); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(out)
+	}
+}
@@ -0,0 +1,281 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package support
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/talos-systems/talos/api/common"
+	"github.com/talos-systems/talos/cmd/osctl/pkg/client"
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/pkg/constants"
+)
+
+// DefaultLogTailLines is the number of lines of each service log collected.
+const DefaultLogTailLines = 5000
+
+type nodeCollector struct {
+	name    string
+	collect func(ctx context.Context, c *client.Client) ([]byte, error)
+}
+
+var nodeCollectors = []nodeCollector{
+	{"dmesg.log", collectDmesg},
+	{"mounts.json", collectMounts},
+	{"processes.json", collectProcesses},
+	{"interfaces.json", collectInterfaces},
+	{"routes.json", collectRoutes},
+	{"config.yaml", collectConfig},
+}
+
+// CollectNode collects information about the node via Talos API.
+//
+// Failures to collect a piece of information are recorded in the bundle manifest,
+// CollectNode returns error only if the bundle can't be written.
+func CollectNode(ctx context.Context, b *Bundle, c *client.Client, node string) error {
+	ctx = client.WithNodes(ctx, node)
+
+	resp, err := c.ServiceList(ctx)
+	if err != nil {
+		b.AddError(node, "services.json", err)
+	} else {
+		if err = addMessage(b, node, "services.json", resp); err != nil {
+			return err
+		}
+
+		for _, msg := range resp.Messages {
+			for _, svc := range msg.Services {
+				id := svc.Id
+
+				if err = addCollected(b, node, fmt.Sprintf("service-logs/%s.log", id), func() ([]byte, error) {
+					return collectServiceLog(ctx, c, id)
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, collector := range nodeCollectors {
+		collector := collector
+
+		if err = addCollected(b, node, collector.name, func() ([]byte, error) {
+			return collector.collect(ctx, c)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CollectKubernetes collects status of Kubernetes nodes and pods.
+func CollectKubernetes(ctx context.Context, b *Bundle, clientset kubernetes.Interface) error {
+	if err := addCollected(b, "", "kubernetes/nodes.json", func() ([]byte, error) {
+		nodes, err := clientset.CoreV1().Nodes().List(metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		return json.MarshalIndent(nodes, "", "  ")
+	}); err != nil {
+		return err
+	}
+
+	return addCollected(b, "", "kubernetes/pods.json", func() ([]byte, error) {
+		pods, err := clientset.CoreV1().Pods("").List(metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		return json.MarshalIndent(pods, "", "  ")
+	})
+}
+
+// CollectKubernetesFromNode collects status of Kubernetes nodes and pods
+// using admin kubeconfig fetched from the node via Talos API.
+func CollectKubernetesFromNode(ctx context.Context, b *Bundle, c *client.Client, node string) error {
+	clientset, err := k8sClient(client.WithNodes(ctx, node), c)
+	if err != nil {
+		b.AddError("", "kubernetes", err)
+
+		return nil
+	}
+
+	return CollectKubernetes(ctx, b, clientset)
+}
+
+// CollectCluster collects information about every node of the cluster and Kubernetes.
+//
+// Nodes are identified by their private IPs in the bundle.
+func CollectCluster(ctx context.Context, b *Bundle, clusterAccess provision.ClusterAccess) error {
+	c, err := clusterAccess.Client()
+	if err != nil {
+		b.AddError("", "talos", err)
+
+		return nil
+	}
+
+	for _, node := range clusterAccess.Info().Nodes {
+		if err = CollectNode(ctx, b, c, node.PrivateIP.String()); err != nil {
+			return err
+		}
+	}
+
+	clientset, err := clusterAccess.K8sClient(ctx)
+	if err != nil {
+		b.AddError("", "kubernetes", err)
+
+		return nil
+	}
+
+	return CollectKubernetes(ctx, b, clientset)
+}
+
+func k8sClient(ctx context.Context, c *client.Client) (*kubernetes.Clientset, error) {
+	kubeconfig, err := c.Kubeconfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := clientcmd.BuildConfigFromKubeconfigGetter("", func() (*clientcmdapi.Config, error) {
+		return clientcmd.Load(kubeconfig)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	config.Timeout = time.Minute
+
+	return kubernetes.NewForConfig(config)
+}
+
+func addCollected(b *Bundle, node, name string, collect func() ([]byte, error)) error {
+	data, err := collect()
+	if err != nil {
+		b.AddError(node, name, err)
+
+		return nil
+	}
+
+	return b.AddFile(node, name, data)
+}
+
+func addMessage(b *Bundle, node, name string, msg proto.Message) error {
+	data, err := marshalMessage(msg)
+	if err != nil {
+		b.AddError(node, name, err)
+
+		return nil
+	}
+
+	return b.AddFile(node, name, data)
+}
+
+func marshalMessage(msg proto.Message) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := (&jsonpb.Marshaler{Indent: "  "}).Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func readAll(r io.ReadCloser, errCh <-chan error, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close() //nolint: errcheck
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = <-errCh; err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func collectServiceLog(ctx context.Context, c *client.Client, id string) ([]byte, error) {
+	stream, err := c.Logs(ctx, constants.SystemContainerdNamespace, common.ContainerDriver_CONTAINERD, id, false, DefaultLogTailLines)
+	if err != nil {
+		return nil, err
+	}
+
+	return readAll(client.ReadStream(stream))
+}
+
+func collectDmesg(ctx context.Context, c *client.Client) ([]byte, error) {
+	stream, err := c.Dmesg(ctx, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return readAll(client.ReadStream(stream))
+}
+
+func collectMounts(ctx context.Context, c *client.Client) ([]byte, error) {
+	resp, err := c.Mounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalMessage(resp)
+}
+
+func collectProcesses(ctx context.Context, c *client.Client) ([]byte, error) {
+	resp, err := c.Processes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalMessage(resp)
+}
+
+func collectInterfaces(ctx context.Context, c *client.Client) ([]byte, error) {
+	resp, err := c.Interfaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalMessage(resp)
+}
+
+func collectRoutes(ctx context.Context, c *client.Client) ([]byte, error) {
+	resp, err := c.Routes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalMessage(resp)
+}
+
+// collectConfig collects machine configuration with the secrets redacted.
+func collectConfig(ctx context.Context, c *client.Client) ([]byte, error) {
+	data, err := readAll(c.Read(ctx, constants.ConfigPath))
+	if err != nil {
+		return nil, err
+	}
+
+	return RedactConfig(data)
+}
@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package support
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/talos-systems/talos/internal/pkg/provision"
+	"github.com/talos-systems/talos/internal/pkg/provision/access"
+)
+
+// ConsoleLogPath is the path of the node console log (container or VM output) in the bundle.
+const ConsoleLogPath = "console.log"
+
+// ProvisionerLogsPath is the directory of the cluster-wide provisioner logs (e.g. load balancer) in the bundle.
+const ProvisionerLogsPath = "provisioner"
+
+// CrashDump writes support bundle for the provisioned cluster to out.
+//
+// Console logs of the nodes and cluster-wide logs (keyed by the file name) are
+// provided by the provisioner. If Talos client
+// configuration is passed in the options, the nodes and Kubernetes are queried via the API as well.
+// Errors writing the bundle are printed to stderr, as crash dump is best effort.
+func CrashDump(ctx context.Context, cluster provision.Cluster, out io.Writer, source string, consoleLog func(provision.NodeInfo) ([]byte, error), clusterLogs map[string]func() ([]byte, error), opts ...provision.Option) {
+	if err := crashDump(ctx, cluster, out, source, consoleLog, clusterLogs, opts...); err != nil {
+		fmt.Fprintf(os.Stderr, "error writing crash dump: %s\n", err)
+	}
+}
+
+func crashDump(ctx context.Context, cluster provision.Cluster, out io.Writer, source string, consoleLog func(provision.NodeInfo) ([]byte, error), clusterLogs map[string]func() ([]byte, error), opts ...provision.Option) error {
+	options := provision.DefaultOptions()
+
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return err
+		}
+	}
+
+	bundle := NewBundle(out, source)
+
+	for _, node := range cluster.Info().Nodes {
+		node := node
+
+		if err := addCollected(bundle, node.PrivateIP.String(), ConsoleLogPath, func() ([]byte, error) {
+			return consoleLog(node)
+		}); err != nil {
+			return err
+		}
+	}
+
+	names := make([]string, 0, len(clusterLogs))
+
+	for name := range clusterLogs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := addCollected(bundle, "", path.Join(ProvisionerLogsPath, name), clusterLogs[name]); err != nil {
+			return err
+		}
+	}
+
+	if options.TalosConfig != nil || options.TalosClient != nil {
+		clusterAccess := access.NewAdapter(cluster, opts...)
+		defer clusterAccess.Close() //nolint: errcheck
+
+		if err := CollectCluster(ctx, bundle, clusterAccess); err != nil {
+			return err
+		}
+	}
+
+	return bundle.Close()
+}
@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package support
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Redacted replaces secret values in the redacted machine configuration.
+const Redacted = "******"
+
+// secretKeys are the machine configuration keys which hold secrets:
+// private keys, tokens, registry credentials and encryption secrets.
+//
+// Keys containing 'token', 'secret' or 'password' are considered secret as well.
+var secretKeys = map[string]struct{}{
+	"key":  {},
+	"auth": {},
+}
+
+// RedactConfig replaces secrets in the machine configuration with the placeholder.
+//
+// Certificates are kept, as they're not secret and often needed for debugging.
+func RedactConfig(in []byte) ([]byte, error) {
+	// MapSlice keeps the order of the keys
+	var doc yaml.MapSlice
+
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(redact(doc))
+}
+
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		for i, item := range v {
+			switch item.Value.(type) {
+			case yaml.MapSlice, []interface{}:
+				v[i].Value = redact(item.Value)
+			default:
+				if isSecretKey(item.Key) && item.Value != nil && item.Value != "" {
+					v[i].Value = Redacted
+				}
+			}
+		}
+
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = redact(v[i])
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+func isSecretKey(k interface{}) bool {
+	key, ok := k.(string)
+	if !ok {
+		return false
+	}
+
+	if _, ok = secretKeys[key]; ok {
+		return true
+	}
+
+	key = strings.ToLower(key)
+
+	return strings.Contains(key, "token") || strings.Contains(key, "secret") || strings.Contains(key, "password")
+}
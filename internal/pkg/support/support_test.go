@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package support_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/talos-systems/talos/internal/pkg/support"
+)
+
+func readBundle(t *testing.T, in []byte) map[string][]byte {
+	gz, err := gzip.NewReader(bytes.NewReader(in))
+	require.NoError(t, err)
+
+	tr := tar.NewReader(gz)
+	files := map[string][]byte{}
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(t, err)
+
+		files[hdr.Name], err = ioutil.ReadAll(tr)
+		require.NoError(t, err)
+	}
+
+	return files
+}
+
+func TestBundle(t *testing.T) {
+	var buf bytes.Buffer
+
+	bundle := support.NewBundle(&buf, "test")
+
+	require.NoError(t, bundle.AddFile("10.5.0.2", "dmesg.log", []byte("kernel log")))
+	require.NoError(t, bundle.AddFile("", "kubernetes/nodes.json", []byte("{}")))
+	bundle.AddError("10.5.0.3", "dmesg.log", errors.New("connection refused"))
+
+	require.NoError(t, bundle.Close())
+
+	files := readBundle(t, buf.Bytes())
+
+	assert.Equal(t, []byte("kernel log"), files["10.5.0.2/dmesg.log"])
+	assert.Equal(t, []byte("{}"), files["kubernetes/nodes.json"])
+
+	var manifest support.Manifest
+
+	require.NoError(t, json.Unmarshal(files[support.ManifestPath], &manifest))
+
+	assert.Equal(t, support.BundleVersion, manifest.Version)
+	assert.Equal(t, "test", manifest.Source)
+	assert.Equal(t, []string{"10.5.0.2", "10.5.0.3"}, manifest.Nodes)
+	assert.Equal(t, []support.ManifestFile{
+		{Path: "10.5.0.2/dmesg.log", Node: "10.5.0.2", Size: 10},
+		{Path: "kubernetes/nodes.json", Size: 2},
+	}, manifest.Files)
+	assert.Equal(t, []support.ManifestError{
+		{Path: "10.5.0.3/dmesg.log", Node: "10.5.0.3", Error: "connection refused"},
+	}, manifest.Errors)
+}
+
+func TestPrintBundle(t *testing.T) {
+	var buf bytes.Buffer
+
+	bundle := support.NewBundle(&buf, "test")
+
+	require.NoError(t, bundle.AddFile("10.5.0.2", "console.log", []byte("booting\n")))
+	require.NoError(t, bundle.Close())
+
+	var out bytes.Buffer
+
+	require.NoError(t, support.PrintBundle(&out, &buf))
+
+	assert.True(t, strings.HasPrefix(out.String(), "10.5.0.2/console.log\n====================\n\nbooting\n\n"))
+	assert.Contains(t, out.String(), support.ManifestPath+"\n")
+}
+
+func TestRedactConfig(t *testing.T) {
+	out, err := support.RedactConfig([]byte(`version: v1alpha1
+machine:
+  type: init
+  token: abcdef.0123456789abcdef
+  ca:
+    crt: Y2VydA==
+    key: a2V5
+  registries:
+    config:
+      registry.local:
+        auth:
+          username: admin
+          password: secret
+          auth: YWRtaW46c2VjcmV0
+          identityToken: token
+cluster:
+  token: abcdef.0123456789abcdef
+  aescbcEncryptionSecret: c2VjcmV0
+  etcd:
+    ca:
+      crt: Y2VydA==
+      key: a2V5
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, `version: v1alpha1
+machine:
+  type: init
+  token: '******'
+  ca:
+    crt: Y2VydA==
+    key: '******'
+  registries:
+    config:
+      registry.local:
+        auth:
+          username: admin
+          password: '******'
+          auth: '******'
+          identityToken: '******'
+cluster:
+  token: '******'
+  aescbcEncryptionSecret: '******'
+  etcd:
+    ca:
+      crt: Y2VydA==
+      key: '******'
+`, string(out))
+}
@@ -0,0 +1,252 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package archiver
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// UntarGz extracts .tar.gz archive from input into rootPath
+func UntarGz(ctx context.Context, input io.Reader, rootPath string) error {
+	zr, err := gzip.NewReader(input)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer zr.Close()
+
+	if err = Untar(ctx, zr, rootPath); err != nil {
+		return err
+	}
+
+	return zr.Close()
+}
+
+// Untar extracts .tar archive from input into rootPath
+//
+// Regular files, directories and links are extracted, other entries (e.g. devices)
+// are skipped. Runs of zeroes in regular files are extracted as holes, so that sparse
+// files (e.g. disk images) stay sparse.
+//
+// Entries are never extracted outside of rootPath: existing entries are replaced
+// instead of being followed, and symlinks pointing outside of rootPath are rejected
+// (absolute symlinks are resolved relative to rootPath).
+//
+//nolint: gocyclo
+func Untar(ctx context.Context, input io.Reader, rootPath string) error {
+	if err := os.MkdirAll(rootPath, 0755); err != nil {
+		return err
+	}
+
+	root, err := filepath.EvalSymlinks(rootPath)
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(input)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		var header *tar.Header
+
+		header, err = tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+
+		path := filepath.Join(root, filepath.Clean(string(os.PathSeparator)+header.Name))
+
+		if path == root {
+			continue
+		}
+
+		if err = checkWithinRoot(root, filepath.Dir(path)); err != nil {
+			return fmt.Errorf("error extracting %q: %w", header.Name, err)
+		}
+
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+
+		if err = removeExisting(path, header.Typeflag == tar.TypeDir); err != nil {
+			return fmt.Errorf("error extracting %q: %w", header.Name, err)
+		}
+
+		mode := header.FileInfo().Mode().Perm()
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err = os.MkdirAll(path, mode); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err = extractFile(ctx, tr, path, mode, header.Size); err != nil {
+				return fmt.Errorf("error extracting %q: %w", header.Name, err)
+			}
+		case tar.TypeSymlink:
+			if err = checkSymlink(root, path, header.Linkname); err != nil {
+				return fmt.Errorf("error extracting %q: %w", header.Name, err)
+			}
+
+			if err = os.Symlink(header.Linkname, path); err != nil {
+				return err
+			}
+		case tar.TypeLink:
+			target := filepath.Join(root, filepath.Clean(string(os.PathSeparator)+header.Linkname))
+
+			if err = checkWithinRoot(root, filepath.Dir(target)); err != nil {
+				return fmt.Errorf("error extracting %q: %w", header.Name, err)
+			}
+
+			if err = os.Link(target, path); err != nil {
+				return err
+			}
+		default:
+			// devices, fifos, etc. can't be restored
+			continue
+		}
+	}
+}
+
+// checkWithinRoot verifies that path doesn't escape the root via symlinks.
+//
+// Path might not exist yet, in that case the closest existing parent is checked.
+func checkWithinRoot(root, path string) error {
+	existing := path
+
+	for {
+		if _, err := os.Lstat(existing); err == nil {
+			break
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+
+		existing = filepath.Dir(existing)
+	}
+
+	resolved, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return err
+	}
+
+	if !isWithinRoot(root, resolved) {
+		return fmt.Errorf("path %q is outside of %q", resolved, root)
+	}
+
+	return nil
+}
+
+// checkSymlink verifies that symlink at path pointing to target doesn't point outside of the root.
+func checkSymlink(root, path, target string) error {
+	var resolved string
+
+	if filepath.IsAbs(target) {
+		resolved = filepath.Join(root, target)
+	} else {
+		resolved = filepath.Join(filepath.Dir(path), target)
+	}
+
+	if !isWithinRoot(root, resolved) {
+		return fmt.Errorf("symlink target %q is outside of %q", target, root)
+	}
+
+	if filepath.IsAbs(target) {
+		return nil
+	}
+
+	return checkWithinRoot(root, resolved)
+}
+
+func isWithinRoot(root, path string) bool {
+	return path == root || strings.HasPrefix(path, root+string(os.PathSeparator))
+}
+
+// removeExisting removes the entry at path, so that it is replaced instead of being followed.
+//
+// Existing directories are kept if the new entry is a directory as well.
+func removeExisting(path string, dir bool) error {
+	st, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if dir && st.IsDir() {
+		return nil
+	}
+
+	return os.Remove(path)
+}
+
+func extractFile(ctx context.Context, r io.Reader, path string, mode os.FileMode, size int64) error {
+	fp, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+
+	//nolint: errcheck
+	defer fp.Close()
+
+	buf := make([]byte, 4096)
+	zeroes := make([]byte, len(buf))
+
+	for {
+		n, readErr := io.ReadFull(r, buf)
+
+		if n > 0 {
+			if bytes.Equal(buf[:n], zeroes[:n]) {
+				_, err = fp.Seek(int64(n), io.SeekCurrent)
+			} else {
+				_, err = fp.Write(buf[:n])
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+
+		if readErr != nil {
+			return readErr
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+
+	// trailing hole is not written by Seek
+	if err = fp.Truncate(size); err != nil {
+		return err
+	}
+
+	return fp.Close()
+}
@@ -0,0 +1,229 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package archiver_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/pkg/archiver"
+)
+
+type UntarSuite struct {
+	CommonSuite
+}
+
+func (suite *UntarSuite) TestRoundTrip() {
+	var buf bytes.Buffer
+
+	suite.Require().NoError(archiver.TarGz(context.Background(), suite.tmpDir, &buf))
+
+	dest, err := ioutil.TempDir("", "untar")
+	suite.Require().NoError(err)
+
+	defer os.RemoveAll(dest) //nolint: errcheck
+
+	suite.Require().NoError(archiver.UntarGz(context.Background(), &buf, dest))
+
+	for _, fi := range filesFixture {
+		path := filepath.Join(dest, fi.Path)
+
+		switch {
+		case fi.Mode&os.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			suite.Require().NoError(err)
+			suite.Assert().Equal(string(fi.Contents), target)
+		default:
+			st, err := os.Stat(path)
+			suite.Require().NoError(err)
+			suite.Assert().Equal(fi.Mode.Perm(), st.Mode().Perm(), "path %q", fi.Path)
+
+			contents, err := ioutil.ReadFile(path)
+			suite.Require().NoError(err)
+
+			if fi.Size > 0 {
+				suite.Assert().Len(contents, fi.Size)
+			} else {
+				suite.Assert().Equal(string(fi.Contents), string(contents))
+			}
+		}
+	}
+}
+
+func (suite *UntarSuite) TestSparse() {
+	var buf bytes.Buffer
+
+	tw := tar.NewWriter(&buf)
+
+	contents := make([]byte, 1024*1024)
+	copy(contents[4096*10:], "data in the middle")
+
+	suite.Require().NoError(tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     "disk.img",
+		Mode:     0644,
+		Size:     int64(len(contents)),
+	}))
+
+	_, err := tw.Write(contents)
+	suite.Require().NoError(err)
+	suite.Require().NoError(tw.Close())
+
+	dest, err := ioutil.TempDir("", "untar")
+	suite.Require().NoError(err)
+
+	defer os.RemoveAll(dest) //nolint: errcheck
+
+	suite.Require().NoError(archiver.Untar(context.Background(), &buf, dest))
+
+	extracted, err := ioutil.ReadFile(filepath.Join(dest, "disk.img"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(contents, extracted)
+}
+
+func (suite *UntarSuite) TestEscape() {
+	var buf bytes.Buffer
+
+	tw := tar.NewWriter(&buf)
+
+	suite.Require().NoError(tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     "../../escape",
+		Mode:     0644,
+		Size:     2,
+	}))
+
+	_, err := tw.Write([]byte("hi"))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeSymlink,
+		Name:     "link",
+		Linkname: "/",
+	}))
+
+	suite.Require().NoError(tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     "link/escape",
+		Mode:     0644,
+		Size:     2,
+	}))
+
+	_, err = tw.Write([]byte("hi"))
+	suite.Require().NoError(err)
+	suite.Require().NoError(tw.Close())
+
+	dest, err := ioutil.TempDir("", "untar")
+	suite.Require().NoError(err)
+
+	defer os.RemoveAll(dest) //nolint: errcheck
+
+	suite.Require().Error(archiver.Untar(context.Background(), &buf, dest))
+
+	contents, err := ioutil.ReadFile(filepath.Join(dest, "escape"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal("hi", string(contents))
+
+	_, err = os.Stat("/escape")
+	suite.Assert().True(os.IsNotExist(err))
+}
+
+func (suite *UntarSuite) TestSymlinkEscape() {
+	for _, target := range []string{"..", "../outside", "dir/../../outside"} {
+		var buf bytes.Buffer
+
+		tw := tar.NewWriter(&buf)
+
+		suite.Require().NoError(tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeSymlink,
+			Name:     "link",
+			Linkname: target,
+		}))
+		suite.Require().NoError(tw.Close())
+
+		dest, err := ioutil.TempDir("", "untar")
+		suite.Require().NoError(err)
+
+		defer os.RemoveAll(dest) //nolint: errcheck
+
+		suite.Assert().Error(archiver.Untar(context.Background(), &buf, dest), "target %q", target)
+
+		_, err = os.Lstat(filepath.Join(dest, "link"))
+		suite.Assert().True(os.IsNotExist(err))
+	}
+}
+
+func (suite *UntarSuite) TestSymlinkReplaced() {
+	outside, err := ioutil.TempDir("", "outside")
+	suite.Require().NoError(err)
+
+	defer os.RemoveAll(outside) //nolint: errcheck
+
+	outsideFile := filepath.Join(outside, "file")
+	suite.Require().NoError(ioutil.WriteFile(outsideFile, []byte("outside"), 0644))
+
+	dest, err := ioutil.TempDir("", "untar")
+	suite.Require().NoError(err)
+
+	defer os.RemoveAll(dest) //nolint: errcheck
+
+	// symlink left in the destination before the extraction
+	suite.Require().NoError(os.Symlink(outsideFile, filepath.Join(dest, "existing")))
+
+	var buf bytes.Buffer
+
+	tw := tar.NewWriter(&buf)
+
+	// symlink followed by the file with the same name
+	suite.Require().NoError(tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeSymlink,
+		Name:     "file",
+		Linkname: "other",
+	}))
+
+	for _, name := range []string{"file", "existing"} {
+		suite.Require().NoError(tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Mode:     0644,
+			Size:     2,
+		}))
+
+		_, err = tw.Write([]byte("hi"))
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().NoError(tw.Close())
+
+	suite.Require().NoError(archiver.Untar(context.Background(), &buf, dest))
+
+	for _, name := range []string{"file", "existing"} {
+		st, err := os.Lstat(filepath.Join(dest, name))
+		suite.Require().NoError(err)
+		suite.Assert().True(st.Mode().IsRegular(), "path %q", name)
+
+		contents, err := ioutil.ReadFile(filepath.Join(dest, name))
+		suite.Require().NoError(err)
+		suite.Assert().Equal("hi", string(contents))
+	}
+
+	_, err = os.Lstat(filepath.Join(dest, "other"))
+	suite.Assert().True(os.IsNotExist(err))
+
+	contents, err := ioutil.ReadFile(outsideFile)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("outside", string(contents))
+}
+
+func TestUntarSuite(t *testing.T) {
+	suite.Run(t, new(UntarSuite))
+}
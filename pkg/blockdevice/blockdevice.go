@@ -16,6 +16,7 @@ import (
 
 	"github.com/talos-systems/talos/pkg/blockdevice/table"
 	"github.com/talos-systems/talos/pkg/blockdevice/table/gpt"
+	"github.com/talos-systems/talos/pkg/blockdevice/table/mbr"
 	"github.com/talos-systems/talos/pkg/retry"
 
 	"golang.org/x/sys/unix"
@@ -50,7 +51,8 @@ func Open(devname string, setters ...Option) (bd *BlockDevice, err error) {
 		}
 	}()
 
-	if opts.CreateGPT {
+	switch {
+	case opts.CreateGPT:
 		var g *gpt.GPT
 
 		if g, err = gpt.NewGPT(devname, f); err != nil {
@@ -68,21 +70,50 @@ func Open(devname string, setters ...Option) (bd *BlockDevice, err error) {
 		}
 
 		bd.table = pt
-	} else {
-		buf := make([]byte, 1)
-		// PMBR protective entry starts at 446. The partition type is at offset
-		// 4 from the start of the PMBR protective entry.
-		_, err = f.ReadAt(buf, 450)
+	case opts.CreateMBR:
+		var m *mbr.MBR
+
+		if m, err = mbr.NewMBR(devname, f); err != nil {
+			return nil, err
+		}
+
+		var pt table.PartitionTable
+
+		if pt, err = m.New(); err != nil {
+			return nil, err
+		}
+
+		if err = pt.Write(); err != nil {
+			return nil, err
+		}
+
+		bd.table = pt
+	default:
+		buf := make([]byte, 512)
+
+		_, err = f.ReadAt(buf, 0)
 		if err != nil {
 			return nil, err
 		}
-		// For GPT, the partition type should be 0xee (EFI GPT).
-		if bytes.Equal(buf, []byte{0xee}) {
+
+		switch {
+		// PMBR protective entry starts at 446. The partition type is at offset
+		// 4 from the start of the PMBR protective entry. For GPT, the partition
+		// type should be 0xee (EFI GPT).
+		case buf[450] == 0xee:
 			var g *gpt.GPT
 			if g, err = gpt.NewGPT(devname, f); err != nil {
 				return nil, err
 			}
 			bd.table = g
+		// Any other boot record with a valid boot signature is treated as a
+		// legacy MBR.
+		case bytes.Equal(buf[510:512], []byte{0x55, 0xaa}):
+			var m *mbr.MBR
+			if m, err = mbr.NewMBR(devname, f); err != nil {
+				return nil, err
+			}
+			bd.table = m
 		}
 	}
 
@@ -4,11 +4,85 @@
 
 package blockdevice_test
 
-import "testing"
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/pkg/blockdevice"
+)
+
+const size = 128 * 1024 * 1024
+
+type BlockDeviceSuite struct {
+	suite.Suite
+
+	f *os.File
+}
+
+func (suite *BlockDeviceSuite) SetupTest() {
+	var err error
+
+	suite.f, err = ioutil.TempFile("", "talos")
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.f.Truncate(size))
+
+	_, err = suite.f.WriteAt(bytes.Repeat([]byte{0xaa}, size), 0)
+	suite.Require().NoError(err)
+}
+
+func (suite *BlockDeviceSuite) TearDownTest() {
+	suite.Require().NoError(suite.f.Close())
+	suite.Require().NoError(os.Remove(suite.f.Name()))
+}
+
+func (suite *BlockDeviceSuite) TestWipeZero() {
+	bd, err := blockdevice.Open(suite.f.Name())
+	suite.Require().NoError(err)
+
+	// nolint: errcheck
+	defer bd.Close()
+
+	const (
+		offset = 1024 * 1024
+		length = size - 2*offset
+	)
+
+	suite.Require().Error(bd.Verify(offset, length, nil))
+
+	var reported []uint64
+
+	suite.Require().NoError(bd.Wipe(blockdevice.WipeZero, offset, length, func(done, total uint64) {
+		suite.Assert().EqualValues(length, total)
+
+		reported = append(reported, done)
+	}))
+
+	suite.Assert().Len(reported, 2)
+	suite.Assert().EqualValues(length, reported[len(reported)-1])
+
+	suite.Require().NoError(bd.Verify(offset, length, nil))
+
+	// The data outside of the wiped range is left untouched.
+	suite.Assert().Error(bd.Verify(0, offset, nil))
+	suite.Assert().Error(bd.Verify(offset+length, offset, nil))
+}
+
+func (suite *BlockDeviceSuite) TestWipeDiscard() {
+	bd, err := blockdevice.Open(suite.f.Name())
+	suite.Require().NoError(err)
+
+	// nolint: errcheck
+	defer bd.Close()
+
+	// Regular files do not support discards.
+	suite.Assert().Error(bd.Wipe(blockdevice.WipeDiscard, 0, size, nil))
+}
+
+func TestBlockDeviceSuite(t *testing.T) {
+	suite.Run(t, new(BlockDeviceSuite))
 }
@@ -0,0 +1,36 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package swap
+
+import (
+	"bytes"
+	"os"
+)
+
+const (
+	// Magic is the swap area signature.
+	Magic = "SWAPSPACE2"
+)
+
+// SuperBlock represents the signature of a swap area. The signature is
+// located at the end of the first page.
+type SuperBlock struct {
+	Magic [10]uint8
+}
+
+// Is implements the SuperBlocker interface.
+func (sb *SuperBlock) Is() bool {
+	return bytes.Equal(sb.Magic[:], []byte(Magic))
+}
+
+// Offset implements the SuperBlocker interface.
+func (sb *SuperBlock) Offset() int64 {
+	return int64(os.Getpagesize() - len(Magic))
+}
+
+// Type implements the SuperBlocker interface.
+func (sb *SuperBlock) Type() string {
+	return "swap"
+}
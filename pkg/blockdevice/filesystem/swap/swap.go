@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package swap provides a library for creating swap areas.
+package swap
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"unsafe"
+
+	"github.com/google/uuid"
+	"golang.org/x/sys/unix"
+)
+
+// Offsets within the first page of a swap area, see include/linux/swap.h.
+const (
+	versionOffset    = 1024
+	lastPageOffset   = 1028
+	uuidOffset       = 1036
+	volumeNameOffset = 1052
+	volumeNameLength = 16
+
+	version = 1
+
+	// minPages is the minimum amount of pages accepted by the kernel.
+	minPages = 10
+)
+
+// MakeSwap writes a swap area header to the specified file or block device.
+// The swap area spans the whole file or block device.
+func MakeSwap(path string, setters ...Option) (err error) {
+	opts := NewDefaultOptions(setters...)
+
+	if len(opts.Label) > volumeNameLength {
+		return fmt.Errorf("label %q is longer than %d characters", opts.Label, volumeNameLength)
+	}
+
+	var f *os.File
+
+	if f, err = os.OpenFile(path, os.O_RDWR|unix.O_CLOEXEC, 0); err != nil {
+		return err
+	}
+	// nolint: errcheck
+	defer f.Close()
+
+	var size uint64
+
+	if size, err = fileSize(f); err != nil {
+		return err
+	}
+
+	pagesize := uint64(os.Getpagesize())
+
+	pages := size / pagesize
+	if pages < minPages {
+		return fmt.Errorf("%s is too small for a swap area: %d bytes", path, size)
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+
+	page := make([]byte, pagesize)
+
+	// The kernel reads the header in native byte order.
+	binary.LittleEndian.PutUint32(page[versionOffset:], version)
+	binary.LittleEndian.PutUint32(page[lastPageOffset:], uint32(pages-1))
+	copy(page[uuidOffset:], id[:])
+	copy(page[volumeNameOffset:volumeNameOffset+volumeNameLength], opts.Label)
+	copy(page[pagesize-uint64(len(Magic)):], Magic)
+
+	if _, err = f.WriteAt(page, 0); err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
+
+// IsSwap returns true if the specified file or block device contains a swap
+// area signature.
+func IsSwap(path string) (bool, error) {
+	f, err := os.OpenFile(path, os.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return false, err
+	}
+	// nolint: errcheck
+	defer f.Close()
+
+	sb := &SuperBlock{}
+
+	if _, err = f.ReadAt(sb.Magic[:], sb.Offset()); err != nil {
+		return false, nil
+	}
+
+	return sb.Is(), nil
+}
+
+func fileSize(f *os.File) (uint64, error) {
+	st, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	if st.Mode().IsRegular() {
+		return uint64(st.Size()), nil
+	}
+
+	var size uint64
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), unix.BLKGETSIZE64, uintptr(unsafe.Pointer(&size))); errno != 0 {
+		return 0, errno
+	}
+
+	return size, nil
+}
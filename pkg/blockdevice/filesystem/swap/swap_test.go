@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package swap_test
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/pkg/blockdevice/filesystem/swap"
+)
+
+type SwapSuite struct {
+	suite.Suite
+
+	f *os.File
+}
+
+func (suite *SwapSuite) SetupTest() {
+	var err error
+
+	suite.f, err = ioutil.TempFile("", "talos")
+	suite.Require().NoError(err)
+}
+
+func (suite *SwapSuite) TearDownTest() {
+	suite.Require().NoError(suite.f.Close())
+	suite.Require().NoError(os.Remove(suite.f.Name()))
+}
+
+func (suite *SwapSuite) TestMakeSwap() {
+	pagesize := os.Getpagesize()
+
+	suite.Require().NoError(suite.f.Truncate(int64(64 * pagesize)))
+
+	ok, err := swap.IsSwap(suite.f.Name())
+	suite.Require().NoError(err)
+	suite.Assert().False(ok)
+
+	suite.Require().NoError(swap.MakeSwap(suite.f.Name(), swap.WithLabel("talos")))
+
+	ok, err = swap.IsSwap(suite.f.Name())
+	suite.Require().NoError(err)
+	suite.Assert().True(ok)
+
+	page := make([]byte, pagesize)
+	_, err = suite.f.ReadAt(page, 0)
+	suite.Require().NoError(err)
+
+	suite.Assert().EqualValues(1, binary.LittleEndian.Uint32(page[1024:]))
+	suite.Assert().EqualValues(63, binary.LittleEndian.Uint32(page[1028:]))
+	suite.Assert().Equal("talos", string(page[1052:1057]))
+}
+
+func (suite *SwapSuite) TestTooSmall() {
+	suite.Require().NoError(suite.f.Truncate(int64(os.Getpagesize())))
+
+	suite.Assert().Error(swap.MakeSwap(suite.f.Name()))
+}
+
+func TestSwapSuite(t *testing.T) {
+	suite.Run(t, new(SwapSuite))
+}
@@ -7,6 +7,7 @@ package blockdevice
 // Options is the functional options struct.
 type Options struct {
 	CreateGPT bool
+	CreateMBR bool
 }
 
 // Option is the functional option func.
@@ -19,10 +20,18 @@ func WithNewGPT(o bool) Option {
 	}
 }
 
+// WithNewMBR opens the blockdevice with a new MBR.
+func WithNewMBR(o bool) Option {
+	return func(args *Options) {
+		args.CreateMBR = o
+	}
+}
+
 // NewDefaultOptions initializes a Options struct with default values.
 func NewDefaultOptions(setters ...Option) *Options {
 	opts := &Options{
 		CreateGPT: false,
+		CreateMBR: false,
 	}
 
 	for _, setter := range setters {
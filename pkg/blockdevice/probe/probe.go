@@ -152,11 +152,17 @@ func probe(devpath string) (devpaths []string) {
 	// nolint: errcheck
 	defer bd.Close()
 
-	// A partition table was not found, and we have already checked for
-	// a file system on the block device. Let's check if the block device
-	// has partitions.
+	// Let's check if the block device has partitions. If no partition table
+	// was found, or it could not be read (e.g. a boot sector of a file
+	// system was mistaken for an MBR), check for a file system on the block
+	// device itself.
 	pt, err := bd.PartitionTable(true)
 	if err != nil {
+		// nolint: errcheck
+		if sb, _ := FileSystem(devpath); sb != nil {
+			devpaths = append(devpaths, devpath)
+		}
+
 		return devpaths
 	}
 
@@ -0,0 +1,122 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package header provides a library for working with MBR headers.
+package header
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/talos-systems/talos/pkg/serde"
+)
+
+const (
+	// HeaderSize is the master boot record size in bytes.
+	HeaderSize = 512
+	// BootstrapCodeSize is the size of the bootstrap code area in bytes.
+	BootstrapCodeSize = 440
+	// PartitionEntriesOffset is the offset of the partition entries in the
+	// master boot record.
+	PartitionEntriesOffset = 446
+	// PartitionEntrySize is the size of a single partition entry in bytes.
+	PartitionEntrySize = 16
+	// NumberOfPartitionEntries is the number of primary partition entries.
+	NumberOfPartitionEntries = 4
+)
+
+// Header represents a master boot record.
+type Header struct {
+	data []byte
+
+	BootstrapCode []byte // 0
+	DiskSignature uint32 // 440
+	CopyProtected uint16 // 444
+	// Partition entries are located at offset 446.
+	// Boot signature (0x55aa) is located at offset 510.
+}
+
+// NewHeader inializes and returns a master boot record header.
+func NewHeader(data []byte) *Header {
+	return &Header{
+		data: data,
+	}
+}
+
+// Bytes implements the table.Header interface.
+func (hdr *Header) Bytes() []byte {
+	return hdr.data
+}
+
+// Fields impements the serde.Serde interface.
+func (hdr *Header) Fields() []*serde.Field {
+	return []*serde.Field{
+		// 440 bytes Bootstrap code area
+		{
+			Offset: 0,
+			Length: BootstrapCodeSize,
+			SerializerFunc: func(offset, length uint32, new []byte, opts interface{}) ([]byte, error) {
+				data := make([]byte, length)
+				copy(data, hdr.BootstrapCode)
+
+				return data, nil
+			},
+			DeserializerFunc: func(contents []byte, opts interface{}) error {
+				hdr.BootstrapCode = make([]byte, len(contents))
+				copy(hdr.BootstrapCode, contents)
+
+				return nil
+			},
+		},
+		// 4 bytes Disk signature (little endian)
+		{
+			Offset: 440,
+			Length: 4,
+			SerializerFunc: func(offset, length uint32, new []byte, opts interface{}) ([]byte, error) {
+				data := make([]byte, length)
+				binary.LittleEndian.PutUint32(data, hdr.DiskSignature)
+
+				return data, nil
+			},
+			DeserializerFunc: func(contents []byte, opts interface{}) error {
+				hdr.DiskSignature = binary.LittleEndian.Uint32(contents)
+
+				return nil
+			},
+		},
+		// 2 bytes Copy-protected flag (0x5a5a if copy-protected, 0x0000 otherwise)
+		{
+			Offset: 444,
+			Length: 2,
+			SerializerFunc: func(offset, length uint32, new []byte, opts interface{}) ([]byte, error) {
+				data := make([]byte, length)
+				binary.LittleEndian.PutUint16(data, hdr.CopyProtected)
+
+				return data, nil
+			},
+			DeserializerFunc: func(contents []byte, opts interface{}) error {
+				hdr.CopyProtected = binary.LittleEndian.Uint16(contents)
+
+				return nil
+			},
+		},
+		// 2 bytes Boot signature (55h AAh)
+		{
+			Offset: 510,
+			Length: 2,
+			SerializerFunc: func(offset, length uint32, new []byte, opts interface{}) ([]byte, error) {
+				return []byte{0x55, 0xaa}, nil
+			},
+			DeserializerFunc: func(contents []byte, opts interface{}) error {
+				expected := []byte{0x55, 0xaa}
+				if !bytes.Equal(contents, expected) {
+					return fmt.Errorf("expected boot signature of %v, got %v", expected, contents)
+				}
+
+				return nil
+			},
+		},
+	}
+}
@@ -0,0 +1,502 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package mbr provides a library for working with MBR partitions.
+package mbr
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+
+	"github.com/talos-systems/talos/pkg/blockdevice/blkpg"
+	"github.com/talos-systems/talos/pkg/blockdevice/lba"
+	"github.com/talos-systems/talos/pkg/blockdevice/table"
+	"github.com/talos-systems/talos/pkg/blockdevice/table/mbr/header"
+	"github.com/talos-systems/talos/pkg/blockdevice/table/mbr/partition"
+	"github.com/talos-systems/talos/pkg/serde"
+)
+
+const (
+	// alignment is the alignment of the first partition in bytes.
+	alignment = 1024 * 1024
+	// maxLogicalPartitions bounds the walk of the extended boot record chain
+	// to protect against loops in corrupted tables.
+	maxLogicalPartitions = 128
+)
+
+// MBR represents the master boot record partition table.
+type MBR struct {
+	table      table.Table
+	header     *header.Header
+	partitions []table.Partition
+	lba        *lba.LogicalBlockAddresser
+
+	devname string
+	f       *os.File
+}
+
+// NewMBR initializes and returns a master boot record partition table.
+func NewMBR(devname string, f *os.File) (mbr *MBR, err error) {
+	lba, err := lba.New(f)
+	if err != nil {
+		return nil, err
+	}
+
+	mbr = &MBR{
+		lba:     lba,
+		devname: devname,
+		f:       f,
+	}
+
+	return mbr, nil
+}
+
+// Bytes returns the partition table as a byte slice.
+func (mbr *MBR) Bytes() []byte {
+	return mbr.table
+}
+
+// Type returns the partition type.
+func (mbr *MBR) Type() table.Type {
+	return table.MBR
+}
+
+// Header returns the header.
+func (mbr *MBR) Header() table.Header {
+	return mbr.header
+}
+
+// Partitions returns the partitions.
+func (mbr *MBR) Partitions() []table.Partition {
+	return mbr.partitions
+}
+
+// Read reads the partition table.
+func (mbr *MBR) Read() error {
+	data, err := mbr.readSector(0)
+	if err != nil {
+		return err
+	}
+
+	hdr := header.NewHeader(data)
+
+	if err = serde.De(hdr, data, 0, nil); err != nil {
+		return fmt.Errorf("failed to deserialize the header: %w", err)
+	}
+
+	primary, err := mbr.deserializePartitions(data)
+	if err != nil {
+		return err
+	}
+
+	partitions := make([]table.Partition, 0, len(primary))
+
+	for _, prt := range primary {
+		if prt.Type == partition.TypeGPTProtective {
+			return errors.New("found a protective MBR, the disk uses a GUID partition table")
+		}
+
+		partitions = append(partitions, prt)
+
+		if !prt.IsExtended() {
+			continue
+		}
+
+		var logical []*partition.Partition
+
+		if logical, err = mbr.readLogical(prt); err != nil {
+			return err
+		}
+
+		for _, l := range logical {
+			partitions = append(partitions, l)
+		}
+	}
+
+	mbr.table = data
+	mbr.header = hdr
+	mbr.partitions = partitions
+
+	return nil
+}
+
+// Write writes the partition table to disk.
+//
+// Only the primary partition entries are written. Extended boot records
+// describing logical partitions are left untouched.
+func (mbr *MBR) Write() error {
+	data, err := mbr.serialize()
+	if err != nil {
+		return err
+	}
+
+	written, err := mbr.f.WriteAt(data, 0)
+	if err != nil {
+		return fmt.Errorf("failed to write master boot record: %w", err)
+	}
+
+	if written != len(data) {
+		return fmt.Errorf("expected a write of %d bytes, got %d", len(data), written)
+	}
+
+	if err := mbr.f.Sync(); err != nil {
+		return err
+	}
+
+	return mbr.Read()
+}
+
+// New creates a new partition table.
+//
+// The table is not written to disk until Write is called.
+func (mbr *MBR) New() (table.PartitionTable, error) {
+	signature := make([]byte, 4)
+
+	if _, err := rand.Read(signature); err != nil {
+		return nil, fmt.Errorf("failed to generate disk signature for new partition table: %w", err)
+	}
+
+	mbr.table = mbr.lba.Make(1)
+	mbr.header = header.NewHeader(mbr.table)
+	mbr.header.DiskSignature = binary.LittleEndian.Uint32(signature)
+	mbr.partitions = []table.Partition{}
+
+	// A stale primary GPT header would still be found by tools like blkid,
+	// wipe it.
+	gpt, err := mbr.readSector(1)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(gpt, []byte("EFI PART")) {
+		if _, err = mbr.f.WriteAt(mbr.lba.Make(1), int64(mbr.lba.LogicalBlockSize)); err != nil {
+			return nil, fmt.Errorf("failed to wipe the GPT header: %w", err)
+		}
+	}
+
+	return mbr, nil
+}
+
+// Repair repairs the partition table.
+//
+// The master boot record has no backup copy and does not encode the size of
+// the disk, so there is nothing to repair.
+func (mbr *MBR) Repair() error {
+	return nil
+}
+
+// Add adds a primary partition.
+func (mbr *MBR) Add(size uint64, setters ...interface{}) (table.Partition, error) {
+	opts := partition.NewDefaultOptions(setters...)
+
+	number, err := mbr.freeSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	sectors, err := mbr.sectors()
+	if err != nil {
+		return nil, err
+	}
+
+	start := alignment / mbr.lba.LogicalBlockSize
+
+	for _, p := range mbr.partitions {
+		if end := p.(*partition.Partition).End(); end > start {
+			start = end
+		}
+	}
+
+	end := start + size/mbr.lba.LogicalBlockSize
+
+	if end > sectors {
+		available := (sectors - start) * mbr.lba.LogicalBlockSize
+		return nil, fmt.Errorf("requested partition size %d is too big, largest available is %d", size, available)
+	}
+
+	if end > math.MaxUint32 {
+		return nil, fmt.Errorf("requested partition ends at LBA %d, beyond the MBR limit of %d", end, uint64(math.MaxUint32))
+	}
+
+	prt := &partition.Partition{
+		Status:   partition.StatusInactive,
+		Type:     opts.Type,
+		FirstLBA: uint32(start),
+		Sectors:  uint32(end - start),
+		Number:   number,
+	}
+
+	if opts.Bootable {
+		prt.Status = partition.StatusBootable
+	}
+
+	mbr.partitions = append(mbr.partitions, prt)
+
+	sort.Slice(mbr.partitions, func(i, j int) bool {
+		return mbr.partitions[i].No() < mbr.partitions[j].No()
+	})
+
+	if err := blkpg.InformKernelOfAdd(mbr.f, prt); err != nil {
+		return nil, err
+	}
+
+	return prt, nil
+}
+
+// Resize grows a primary partition up to the start of the next partition,
+// or to the end of the disk.
+func (mbr *MBR) Resize(p table.Partition) error {
+	prt, ok := p.(*partition.Partition)
+	if !ok {
+		return fmt.Errorf("partition is not a master boot record partition")
+	}
+
+	if prt.Logical || prt.IsExtended() {
+		return fmt.Errorf("resizing extended and logical partitions is not supported")
+	}
+
+	limit, err := mbr.sectors()
+	if err != nil {
+		return err
+	}
+
+	for _, other := range mbr.partitions {
+		o := other.(*partition.Partition)
+		if o.Logical || o.Number == prt.Number {
+			continue
+		}
+
+		if start := uint64(o.FirstLBA); start > uint64(prt.FirstLBA) && start < limit {
+			limit = start
+		}
+	}
+
+	if limit > math.MaxUint32 {
+		limit = math.MaxUint32
+	}
+
+	prt.Sectors = uint32(limit - uint64(prt.FirstLBA))
+
+	for i, other := range mbr.partitions {
+		if other.No() == prt.Number {
+			mbr.partitions[i] = prt
+
+			return blkpg.InformKernelOfResize(mbr.f, prt)
+		}
+	}
+
+	return fmt.Errorf("unknown partition %d", prt.Number)
+}
+
+// Delete deletes a primary partition. Deleting an extended partition deletes
+// all of the logical partitions it contains.
+func (mbr *MBR) Delete(p table.Partition) error {
+	prt, ok := p.(*partition.Partition)
+	if !ok {
+		return fmt.Errorf("partition is not a master boot record partition")
+	}
+
+	found := false
+
+	for _, other := range mbr.partitions {
+		if other.No() == prt.Number {
+			found = true
+		}
+	}
+
+	if !found {
+		if prt.Logical {
+			// The logical partition was deleted along with its extended
+			// partition.
+			return nil
+		}
+
+		return fmt.Errorf("unknown partition %d", prt.Number)
+	}
+
+	if prt.Logical {
+		return fmt.Errorf("deleting logical partition %d is not supported, delete the extended partition instead", prt.Number)
+	}
+
+	partitions := make([]table.Partition, 0, len(mbr.partitions))
+
+	for _, other := range mbr.partitions {
+		o := other.(*partition.Partition)
+
+		switch {
+		case o.Number == prt.Number:
+			continue
+		case o.Logical && prt.IsExtended():
+			if err := blkpg.InformKernelOfDelete(mbr.f, o); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		partitions = append(partitions, other)
+	}
+
+	mbr.partitions = partitions
+
+	return blkpg.InformKernelOfDelete(mbr.f, prt)
+}
+
+func (mbr *MBR) readSector(n uint64) ([]byte, error) {
+	data := mbr.lba.Make(1)
+
+	read, err := mbr.f.ReadAt(data, int64(n*mbr.lba.LogicalBlockSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if read != len(data) {
+		return nil, fmt.Errorf("expected a read of %d bytes, got %d", len(data), read)
+	}
+
+	return data, nil
+}
+
+// sectors returns the size of the disk in logical blocks.
+func (mbr *MBR) sectors() (uint64, error) {
+	size, err := mbr.f.Seek(0, 2)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err = mbr.f.Seek(0, 0); err != nil {
+		return 0, err
+	}
+
+	return uint64(size) / mbr.lba.LogicalBlockSize, nil
+}
+
+func (mbr *MBR) freeSlot() (int32, error) {
+	used := map[int32]bool{}
+
+	for _, p := range mbr.partitions {
+		used[p.No()] = true
+	}
+
+	for i := int32(1); i <= header.NumberOfPartitionEntries; i++ {
+		if !used[i] {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("all %d primary partition entries are in use", header.NumberOfPartitionEntries)
+}
+
+func (mbr *MBR) serialize() ([]byte, error) {
+	data := mbr.lba.Make(1)
+	// Preserve the bootstrap code and anything else we do not manage.
+	copy(data, mbr.table)
+
+	if err := serde.Ser(mbr.header, data, 0, nil); err != nil {
+		return nil, fmt.Errorf("failed to serialize the header: %w", err)
+	}
+
+	entries := data[header.PartitionEntriesOffset : header.PartitionEntriesOffset+header.NumberOfPartitionEntries*header.PartitionEntrySize]
+	copy(entries, make([]byte, len(entries)))
+
+	for _, p := range mbr.partitions {
+		prt, ok := p.(*partition.Partition)
+		if !ok {
+			return nil, fmt.Errorf("partition is not a master boot record partition")
+		}
+
+		if prt.Logical {
+			continue
+		}
+
+		offset := uint32(header.PartitionEntriesOffset + (prt.Number-1)*header.PartitionEntrySize)
+
+		if err := serde.Ser(prt, data, offset, nil); err != nil {
+			return nil, fmt.Errorf("failed to serialize the partitions: %w", err)
+		}
+	}
+
+	return data, nil
+}
+
+func (mbr *MBR) deserializePartitions(data []byte) ([]*partition.Partition, error) {
+	partitions := make([]*partition.Partition, 0, header.NumberOfPartitionEntries)
+
+	for i := uint32(0); i < header.NumberOfPartitionEntries; i++ {
+		offset := header.PartitionEntriesOffset + i*header.PartitionEntrySize
+		prt := partition.NewPartition(data[offset : offset+header.PartitionEntrySize])
+
+		if err := serde.De(prt, data, offset, nil); err != nil {
+			return nil, fmt.Errorf("failed to deserialize the partitions: %w", err)
+		}
+
+		if prt.IsEmpty() {
+			continue
+		}
+
+		prt.Number = int32(i) + 1
+		partitions = append(partitions, prt)
+	}
+
+	return partitions, nil
+}
+
+// readLogical walks the chain of extended boot records in an extended
+// partition. Each EBR holds at most two entries: the logical partition, with
+// a start relative to the EBR, and a link to the next EBR, with a start
+// relative to the extended partition.
+func (mbr *MBR) readLogical(extended *partition.Partition) ([]*partition.Partition, error) {
+	partitions := []*partition.Partition{}
+
+	ebr := uint64(extended.FirstLBA)
+
+	for number := int32(5); number < 5+maxLogicalPartitions; number++ {
+		data, err := mbr.readSector(ebr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read extended boot record at LBA %d: %w", ebr, err)
+		}
+
+		if !bytes.Equal(data[510:512], []byte{0x55, 0xaa}) {
+			return nil, fmt.Errorf("invalid extended boot record signature at LBA %d", ebr)
+		}
+
+		entries, err := mbr.deserializePartitions(data)
+		if err != nil {
+			return nil, err
+		}
+
+		var next *partition.Partition
+
+		for _, entry := range entries {
+			switch {
+			case entry.IsExtended():
+				next = entry
+			default:
+				entry.FirstLBA += uint32(ebr)
+				entry.Number = number
+				entry.Logical = true
+				partitions = append(partitions, entry)
+			}
+		}
+
+		if next == nil {
+			return partitions, nil
+		}
+
+		following := uint64(extended.FirstLBA) + uint64(next.FirstLBA)
+		if following <= ebr || following >= extended.End() {
+			return nil, fmt.Errorf("invalid extended boot record link at LBA %d", ebr)
+		}
+
+		ebr = following
+	}
+
+	return nil, fmt.Errorf("too many logical partitions, the limit is %d", maxLogicalPartitions)
+}
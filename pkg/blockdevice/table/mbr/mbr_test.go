@@ -0,0 +1,254 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mbr_test
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/pkg/blockdevice/table"
+	"github.com/talos-systems/talos/pkg/blockdevice/table/mbr"
+	"github.com/talos-systems/talos/pkg/blockdevice/table/mbr/partition"
+)
+
+const (
+	size     = 64 * 1024 * 1024
+	mebibyte = 1024 * 1024
+)
+
+type MBRSuite struct {
+	suite.Suite
+
+	f *os.File
+}
+
+func (suite *MBRSuite) SetupTest() {
+	var err error
+
+	suite.f, err = ioutil.TempFile("", "talos")
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.f.Truncate(size))
+}
+
+func (suite *MBRSuite) TearDownTest() {
+	suite.Require().NoError(suite.f.Close())
+	suite.Require().NoError(os.Remove(suite.f.Name()))
+}
+
+func (suite *MBRSuite) newTable() table.PartitionTable {
+	m, err := mbr.NewMBR(suite.f.Name(), suite.f)
+	suite.Require().NoError(err)
+
+	pt, err := m.New()
+	suite.Require().NoError(err)
+
+	return pt
+}
+
+func (suite *MBRSuite) reread() table.PartitionTable {
+	m, err := mbr.NewMBR(suite.f.Name(), suite.f)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(m.Read())
+
+	return m
+}
+
+func (suite *MBRSuite) TestNew() {
+	pt := suite.newTable()
+	suite.Require().NoError(pt.Write())
+
+	buf := make([]byte, 512)
+	_, err := suite.f.ReadAt(buf, 0)
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]byte{0x55, 0xaa}, buf[510:512])
+
+	pt = suite.reread()
+	suite.Assert().Equal(table.MBR, pt.Type())
+	suite.Assert().Empty(pt.Partitions())
+}
+
+func (suite *MBRSuite) TestAdd() {
+	pt := suite.newTable()
+
+	_, err := pt.Add(16*mebibyte, partition.WithPartitionType(partition.TypeFAT32LBA), partition.WithBootable(true))
+	suite.Require().NoError(err)
+
+	_, err = pt.Add(32 * mebibyte)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(pt.Write())
+
+	partitions := suite.reread().Partitions()
+	suite.Require().Len(partitions, 2)
+
+	first := partitions[0].(*partition.Partition)
+	suite.Assert().EqualValues(1, first.No())
+	suite.Assert().EqualValues(2048, first.Start())
+	suite.Assert().EqualValues(16*mebibyte/512, first.Length())
+	suite.Assert().True(first.Bootable())
+	suite.Assert().EqualValues(partition.TypeFAT32LBA, first.Type)
+
+	second := partitions[1].(*partition.Partition)
+	suite.Assert().EqualValues(2, second.No())
+	suite.Assert().EqualValues(first.End(), second.Start())
+	suite.Assert().False(second.Bootable())
+	suite.Assert().EqualValues(partition.TypeLinux, second.Type)
+
+	_, err = pt.Add(size)
+	suite.Assert().Error(err)
+}
+
+func (suite *MBRSuite) TestAddTooMany() {
+	pt := suite.newTable()
+
+	for i := 0; i < 4; i++ {
+		_, err := pt.Add(mebibyte)
+		suite.Require().NoError(err)
+	}
+
+	_, err := pt.Add(mebibyte)
+	suite.Assert().Error(err)
+}
+
+func (suite *MBRSuite) TestResize() {
+	pt := suite.newTable()
+
+	_, err := pt.Add(16 * mebibyte)
+	suite.Require().NoError(err)
+
+	p, err := pt.Add(16 * mebibyte)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(pt.Resize(p))
+	suite.Require().NoError(pt.Write())
+
+	partitions := suite.reread().Partitions()
+	suite.Require().Len(partitions, 2)
+
+	suite.Assert().EqualValues(size/512, partitions[1].(*partition.Partition).End())
+}
+
+func (suite *MBRSuite) TestDelete() {
+	pt := suite.newTable()
+
+	for i := 0; i < 3; i++ {
+		_, err := pt.Add(mebibyte)
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().NoError(pt.Write())
+
+	suite.Require().NoError(pt.Delete(pt.Partitions()[1]))
+	suite.Require().NoError(pt.Write())
+
+	partitions := suite.reread().Partitions()
+	suite.Require().Len(partitions, 2)
+	suite.Assert().EqualValues(1, partitions[0].No())
+	suite.Assert().EqualValues(3, partitions[1].No())
+
+	// The freed slot is reused.
+	p, err := pt.Add(mebibyte)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(2, p.No())
+}
+
+func (suite *MBRSuite) TestProtective() {
+	buf := make([]byte, 512)
+	buf[450] = partition.TypeGPTProtective
+	binary.LittleEndian.PutUint32(buf[454:458], 1)
+	binary.LittleEndian.PutUint32(buf[458:462], size/512-1)
+	copy(buf[510:], []byte{0x55, 0xaa})
+
+	_, err := suite.f.WriteAt(buf, 0)
+	suite.Require().NoError(err)
+
+	m, err := mbr.NewMBR(suite.f.Name(), suite.f)
+	suite.Require().NoError(err)
+
+	suite.Assert().Error(m.Read())
+}
+
+// TestLogical builds an extended partition with two logical partitions by
+// hand, as done by fdisk:
+//
+//   - partition 1: primary, LBA 2048, 8 MiB
+//   - partition 2: extended, LBA 18432 up to the end of the disk
+//   - partition 5: logical, EBR at 18432, data at 20480, 8 MiB
+//   - partition 6: logical, EBR at 38912, data at 40960, 8 MiB
+func (suite *MBRSuite) TestLogical() {
+	entry := func(buf []byte, n int, typ byte, start, sectors uint32) {
+		e := buf[446+16*n : 446+16*(n+1)]
+		e[4] = typ
+		binary.LittleEndian.PutUint32(e[8:12], start)
+		binary.LittleEndian.PutUint32(e[12:16], sectors)
+	}
+
+	write := func(buf []byte, lba int64) {
+		copy(buf[510:], []byte{0x55, 0xaa})
+
+		_, err := suite.f.WriteAt(buf, lba*512)
+		suite.Require().NoError(err)
+	}
+
+	mbrSector := make([]byte, 512)
+	entry(mbrSector, 0, partition.TypeLinux, 2048, 16384)
+	entry(mbrSector, 1, partition.TypeExtendedLBA, 18432, size/512-18432)
+	write(mbrSector, 0)
+
+	ebr1 := make([]byte, 512)
+	entry(ebr1, 0, partition.TypeLinux, 2048, 16384)
+	entry(ebr1, 1, partition.TypeExtendedCHS, 20480, 18432)
+	write(ebr1, 18432)
+
+	ebr2 := make([]byte, 512)
+	entry(ebr2, 0, partition.TypeLinuxSwap, 2048, 16384)
+	write(ebr2, 38912)
+
+	pt := suite.reread()
+
+	partitions := pt.Partitions()
+	suite.Require().Len(partitions, 4)
+
+	expected := []struct {
+		no      int32
+		start   int64
+		logical bool
+	}{
+		{1, 2048, false},
+		{2, 18432, false},
+		{5, 20480, true},
+		{6, 40960, true},
+	}
+
+	for i, e := range expected {
+		p := partitions[i].(*partition.Partition)
+		suite.Assert().Equal(e.no, p.No())
+		suite.Assert().Equal(e.start, p.Start())
+		suite.Assert().Equal(e.logical, p.Logical)
+	}
+
+	suite.Assert().Error(pt.Delete(partitions[2]))
+	suite.Assert().Error(pt.Resize(partitions[1]))
+
+	// Deleting every partition, as blockdevice.ResetDevice does, removes the
+	// logical partitions along with the extended one.
+	for _, p := range partitions {
+		suite.Require().NoError(pt.Delete(p))
+	}
+
+	suite.Require().NoError(pt.Write())
+	suite.Assert().Empty(suite.reread().Partitions())
+}
+
+func TestMBRSuite(t *testing.T) {
+	suite.Run(t, new(MBRSuite))
+}
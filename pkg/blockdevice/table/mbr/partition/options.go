@@ -0,0 +1,43 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package partition
+
+// Options is the functional options struct.
+type Options struct {
+	Type     byte
+	Bootable bool
+}
+
+// Option is the functional option func.
+type Option func(*Options)
+
+// WithPartitionType sets the partition type.
+func WithPartitionType(o byte) Option {
+	return func(args *Options) {
+		args.Type = o
+	}
+}
+
+// WithBootable marks the partition as active.
+func WithBootable(o bool) Option {
+	return func(args *Options) {
+		args.Bootable = o
+	}
+}
+
+// NewDefaultOptions initializes a Options struct with default values.
+func NewDefaultOptions(setters ...interface{}) *Options {
+	opts := &Options{
+		Type: TypeLinux,
+	}
+
+	for _, setter := range setters {
+		if s, ok := setter.(Option); ok {
+			s(opts)
+		}
+	}
+
+	return opts
+}
@@ -0,0 +1,233 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package partition provides a library for working with MBR partitions.
+package partition
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/talos-systems/talos/pkg/serde"
+)
+
+const (
+	// StatusBootable is the status flag of an active (bootable) partition.
+	StatusBootable = 0x80
+	// StatusInactive is the status flag of an inactive partition.
+	StatusInactive = 0x00
+)
+
+// Well known partition types.
+// See https://en.wikipedia.org/wiki/Partition_type.
+const (
+	TypeEmpty         = 0x00
+	TypeExtendedCHS   = 0x05
+	TypeFAT32LBA      = 0x0c
+	TypeExtendedLBA   = 0x0f
+	TypeLinuxSwap     = 0x82
+	TypeLinux         = 0x83
+	TypeLinuxExtended = 0x85
+	TypeLinuxLVM      = 0x8e
+	TypeGPTProtective = 0xee
+	TypeEFISystem     = 0xef
+	TypeLinuxRAID     = 0xfd
+)
+
+// Legacy disk geometry used for CHS addressing.
+const (
+	geometryHeads   = 255
+	geometrySectors = 63
+	maxCylinder     = 1023
+)
+
+// Partition represents a partition entry in a master boot record.
+type Partition struct {
+	data []byte
+
+	Status   byte   // 0
+	FirstCHS []byte // 1
+	Type     byte   // 4
+	LastCHS  []byte // 5
+	FirstLBA uint32 // 8
+	Sectors  uint32 // 12
+
+	Number int32
+	// Logical is true for partitions described by an extended boot record.
+	// The FirstLBA of a logical partition is absolute (relative to the start
+	// of the disk), not relative to the extended boot record.
+	Logical bool
+}
+
+// NewPartition initializes and returns a new partition.
+func NewPartition(data []byte) *Partition {
+	return &Partition{
+		data: data,
+	}
+}
+
+// Bytes returns the partition as a byte slice.
+func (prt *Partition) Bytes() []byte {
+	return prt.data
+}
+
+// Start returns the partition's starting LBA.
+func (prt *Partition) Start() int64 {
+	return int64(prt.FirstLBA)
+}
+
+// Length returns the partition's length in LBA.
+func (prt *Partition) Length() int64 {
+	return int64(prt.Sectors)
+}
+
+// No returns the partition's number.
+func (prt *Partition) No() int32 {
+	return prt.Number
+}
+
+// End returns the LBA following the partition's last sector.
+func (prt *Partition) End() uint64 {
+	return uint64(prt.FirstLBA) + uint64(prt.Sectors)
+}
+
+// Bootable returns true if the partition is marked as active.
+func (prt *Partition) Bootable() bool {
+	return prt.Status == StatusBootable
+}
+
+// IsEmpty returns true if the partition entry is unused.
+func (prt *Partition) IsEmpty() bool {
+	return prt.Type == TypeEmpty || prt.Sectors == 0
+}
+
+// IsExtended returns true if the partition is an extended partition
+// containing a chain of extended boot records.
+func (prt *Partition) IsExtended() bool {
+	switch prt.Type {
+	case TypeExtendedCHS, TypeExtendedLBA, TypeLinuxExtended:
+		return true
+	default:
+		return false
+	}
+}
+
+// Fields implements the serder.Serde interface.
+func (prt *Partition) Fields() []*serde.Field {
+	return []*serde.Field{
+		// 1 byte Status (0x80 = bootable, 0x00 = inactive)
+		{
+			Offset: 0,
+			Length: 1,
+			SerializerFunc: func(offset, length uint32, new []byte, opts interface{}) ([]byte, error) {
+				return []byte{prt.Status}, nil
+			},
+			DeserializerFunc: func(contents []byte, opts interface{}) error {
+				if contents[0] != StatusBootable && contents[0] != StatusInactive {
+					return fmt.Errorf("invalid partition status %#x", contents[0])
+				}
+
+				prt.Status = contents[0]
+
+				return nil
+			},
+		},
+		// 3 bytes CHS address of first absolute sector
+		{
+			Offset: 1,
+			Length: 3,
+			SerializerFunc: func(offset, length uint32, new []byte, opts interface{}) ([]byte, error) {
+				return CHS(uint64(prt.FirstLBA)), nil
+			},
+			DeserializerFunc: func(contents []byte, opts interface{}) error {
+				prt.FirstCHS = append([]byte{}, contents...)
+
+				return nil
+			},
+		},
+		// 1 byte Partition type
+		{
+			Offset: 4,
+			Length: 1,
+			SerializerFunc: func(offset, length uint32, new []byte, opts interface{}) ([]byte, error) {
+				return []byte{prt.Type}, nil
+			},
+			DeserializerFunc: func(contents []byte, opts interface{}) error {
+				prt.Type = contents[0]
+
+				return nil
+			},
+		},
+		// 3 bytes CHS address of last absolute sector
+		{
+			Offset: 5,
+			Length: 3,
+			SerializerFunc: func(offset, length uint32, new []byte, opts interface{}) ([]byte, error) {
+				if prt.Sectors == 0 {
+					return CHS(uint64(prt.FirstLBA)), nil
+				}
+
+				return CHS(prt.End() - 1), nil
+			},
+			DeserializerFunc: func(contents []byte, opts interface{}) error {
+				prt.LastCHS = append([]byte{}, contents...)
+
+				return nil
+			},
+		},
+		// 4 bytes LBA of first absolute sector (little endian)
+		{
+			Offset: 8,
+			Length: 4,
+			SerializerFunc: func(offset, length uint32, new []byte, opts interface{}) ([]byte, error) {
+				data := make([]byte, length)
+				binary.LittleEndian.PutUint32(data, prt.FirstLBA)
+
+				return data, nil
+			},
+			DeserializerFunc: func(contents []byte, opts interface{}) error {
+				prt.FirstLBA = binary.LittleEndian.Uint32(contents)
+
+				return nil
+			},
+		},
+		// 4 bytes Number of sectors in partition (little endian)
+		{
+			Offset: 12,
+			Length: 4,
+			SerializerFunc: func(offset, length uint32, new []byte, opts interface{}) ([]byte, error) {
+				data := make([]byte, length)
+				binary.LittleEndian.PutUint32(data, prt.Sectors)
+
+				return data, nil
+			},
+			DeserializerFunc: func(contents []byte, opts interface{}) error {
+				prt.Sectors = binary.LittleEndian.Uint32(contents)
+
+				return nil
+			},
+		},
+	}
+}
+
+// CHS converts an LBA to a cylinder-head-sector address using the
+// conventional 255 heads and 63 sectors per track geometry. Addresses that
+// cannot be represented are clamped to the maximum CHS value, as is done by
+// other partitioning tools.
+func CHS(lba uint64) []byte {
+	cylinder := lba / (geometryHeads * geometrySectors)
+	if cylinder > maxCylinder {
+		return []byte{0xfe, 0xff, 0xff}
+	}
+
+	head := (lba / geometrySectors) % geometryHeads
+	sector := lba%geometrySectors + 1
+
+	chs := make([]byte, 3)
+	chs[0] = byte(head)
+	chs[1] = byte(sector) | byte((cylinder>>2)&0xc0)
+	chs[2] = byte(cylinder)
+
+	return chs
+}
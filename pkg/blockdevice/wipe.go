@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package blockdevice
+
+import (
+	"bytes"
+	"fmt"
+	"unsafe"
+
+	"github.com/talos-systems/talos/pkg/blockdevice/lba"
+	"github.com/talos-systems/talos/pkg/blockdevice/table"
+
+	"golang.org/x/sys/unix"
+)
+
+// WipeMode represents the method used to destroy the contents of a block
+// device range.
+type WipeMode int
+
+const (
+	// WipeZero overwrites the range with zeroes.
+	WipeZero WipeMode = iota
+	// WipeDiscard discards the range using the BLKDISCARD ioctl.
+	WipeDiscard
+	// WipeSecureDiscard securely discards the range using the BLKSECDISCARD
+	// ioctl.
+	WipeSecureDiscard
+)
+
+// String implements the fmt.Stringer interface.
+func (mode WipeMode) String() string {
+	switch mode {
+	case WipeZero:
+		return "zero"
+	case WipeDiscard:
+		return "discard"
+	case WipeSecureDiscard:
+		return "secure discard"
+	default:
+		return fmt.Sprintf("unknown wipe mode %d", int(mode))
+	}
+}
+
+// wipeChunkSize is the amount of bytes processed between two progress
+// reports.
+const wipeChunkSize = 64 * 1024 * 1024
+
+// ProgressFunc is called after each processed chunk with the amount of bytes
+// done so far and the total amount of bytes.
+type ProgressFunc func(done, total uint64)
+
+// Wipe destroys the contents of length bytes starting at offset.
+func (bd *BlockDevice) Wipe(mode WipeMode, offset, length uint64, progress ProgressFunc) error {
+	var zeroes []byte
+
+	if mode == WipeZero {
+		zeroes = make([]byte, wipeChunkSize)
+	}
+
+	for done := uint64(0); done < length; {
+		n := length - done
+		if n > wipeChunkSize {
+			n = wipeChunkSize
+		}
+
+		switch mode {
+		case WipeZero:
+			if _, err := bd.f.WriteAt(zeroes[:n], int64(offset+done)); err != nil {
+				return fmt.Errorf("failed to write zeroes at offset %d: %w", offset+done, err)
+			}
+		case WipeDiscard, WipeSecureDiscard:
+			req := unix.BLKDISCARD
+			if mode == WipeSecureDiscard {
+				req = unix.BLKSECDISCARD
+			}
+
+			rng := [2]uint64{offset + done, n}
+
+			if _, _, errno := unix.Syscall(unix.SYS_IOCTL, bd.f.Fd(), uintptr(req), uintptr(unsafe.Pointer(&rng))); errno != 0 {
+				return fmt.Errorf("failed to %s at offset %d: %w", mode, offset+done, errno)
+			}
+		default:
+			return fmt.Errorf("unsupported wipe mode %d", int(mode))
+		}
+
+		done += n
+
+		if progress != nil {
+			progress(done, length)
+		}
+	}
+
+	return bd.f.Sync()
+}
+
+// Verify reads length bytes starting at offset and returns an error unless
+// they are all zeroes.
+func (bd *BlockDevice) Verify(offset, length uint64, progress ProgressFunc) error {
+	buf := make([]byte, wipeChunkSize)
+	zeroes := make([]byte, wipeChunkSize)
+
+	for done := uint64(0); done < length; {
+		n := length - done
+		if n > wipeChunkSize {
+			n = wipeChunkSize
+		}
+
+		if _, err := bd.f.ReadAt(buf[:n], int64(offset+done)); err != nil {
+			return fmt.Errorf("failed to read at offset %d: %w", offset+done, err)
+		}
+
+		if !bytes.Equal(buf[:n], zeroes[:n]) {
+			return fmt.Errorf("found non-zero data in range %d-%d", offset+done, offset+done+n)
+		}
+
+		done += n
+
+		if progress != nil {
+			progress(done, length)
+		}
+	}
+
+	return nil
+}
+
+// PartitionRange returns the offset and length in bytes of the partition.
+func (bd *BlockDevice) PartitionRange(p table.Partition) (offset, length uint64, err error) {
+	var l *lba.LogicalBlockAddresser
+
+	if l, err = lba.New(bd.f); err != nil {
+		return 0, 0, err
+	}
+
+	return uint64(p.Start()) * l.LogicalBlockSize, uint64(p.Length()) * l.LogicalBlockSize, nil
+}
@@ -29,6 +29,7 @@ type Cluster interface {
 	Etcd() Etcd
 	Network() Network
 	LocalAPIServerPort() int
+	LocalLoadBalancer() LocalLoadBalancer
 	PodCheckpointer() PodCheckpointer
 	CoreDNS() CoreDNS
 	ExtraManifestURLs() []string
@@ -83,6 +84,13 @@ type Token interface {
 	Secret() string
 }
 
+// LocalLoadBalancer defines the requirements for a config that pertains to the
+// control plane endpoint load balancer running on each node.
+type LocalLoadBalancer interface {
+	Enabled() bool
+	Port() int
+}
+
 // PodCheckpointer defines the requirements for a config that pertains to bootkube
 // pod-checkpointer options.
 type PodCheckpointer interface {
@@ -9,6 +9,7 @@ import (
 	stdx509 "crypto/x509"
 	"fmt"
 	"os"
+	"time"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 
@@ -60,6 +61,9 @@ type Machine interface {
 	Kubelet() Kubelet
 	Sysctls() map[string]string
 	Registries() Registries
+	Swap() Swap
+	Services() []Service
+	ImagePolicy() ImagePolicy
 }
 
 // Env represents a set of environment variables.
@@ -93,13 +97,20 @@ type Network interface {
 
 // Device represents a network interface.
 type Device struct {
-	Interface string  `yaml:"interface"`
-	CIDR      string  `yaml:"cidr"`
-	Routes    []Route `yaml:"routes"`
-	Bond      *Bond   `yaml:"bond"`
-	MTU       int     `yaml:"mtu"`
-	DHCP      bool    `yaml:"dhcp"`
-	Ignore    bool    `yaml:"ignore"`
+	Interface string           `yaml:"interface"`
+	CIDR      string           `yaml:"cidr"`
+	Routes    []Route          `yaml:"routes"`
+	Bond      *Bond            `yaml:"bond"`
+	VIP       *DeviceVIPConfig `yaml:"vip,omitempty"`
+	MTU       int              `yaml:"mtu"`
+	DHCP      bool             `yaml:"dhcp"`
+	Ignore    bool             `yaml:"ignore"`
+}
+
+// DeviceVIPConfig contains settings for configuring a shared virtual IP
+// address on an interface.
+type DeviceVIPConfig struct {
+	IP string `yaml:"ip"`
 }
 
 // Bond contains the various options for configuring a
@@ -149,6 +160,7 @@ type Install interface {
 	Zero() bool
 	Force() bool
 	WithBootloader() bool
+	BootloaderType() string
 }
 
 // Disk represents the options available for partitioning, formatting, and
@@ -156,6 +168,9 @@ type Install interface {
 type Disk struct {
 	Device     string      `yaml:"device,omitempty"`
 	Partitions []Partition `yaml:"partitions,omitempty"`
+	// Grow indicates that the last partition and its filesystem should be
+	// grown to the size of the disk on boot.
+	Grow bool `yaml:"grow,omitempty"`
 }
 
 // Partition represents the options for a device partition.
@@ -164,12 +179,83 @@ type Partition struct {
 	MountPoint string `yaml:"mountpoint,omitempty"`
 }
 
+// Swap defines the requirements for a config that pertains to swap related
+// options.
+type Swap interface {
+	Enabled() bool
+	Files() []SwapFile
+	Partitions() []SwapPartition
+	Zram() []ZramDevice
+	NodeSwap() bool
+}
+
+// SwapFile represents the options for a swap file.
+type SwapFile struct {
+	Path     string `yaml:"path"`
+	Size     uint   `yaml:"size"`
+	Priority int    `yaml:"priority,omitempty"`
+}
+
+// SwapPartition represents the options for a swap partition.
+type SwapPartition struct {
+	Device   string `yaml:"device"`
+	Priority int    `yaml:"priority,omitempty"`
+}
+
+// ZramDevice represents the options for a compressed RAM swap device.
+type ZramDevice struct {
+	Size      uint   `yaml:"size"`
+	Algorithm string `yaml:"algorithm,omitempty"`
+	Priority  int    `yaml:"priority,omitempty"`
+}
+
+// ImagePolicy defines the requirements for a config that pertains to the
+// verification of the installer and system images.
+type ImagePolicy interface {
+	RequireDigest() bool
+	SignatureKeys() []string
+}
+
 // Time defines the requirements for a config that pertains to time related
 // options.
 type Time interface {
 	Servers() []string
 }
 
+// Service restart policies.
+const (
+	// ServiceRestartAlways restarts the service whenever it exits.
+	ServiceRestartAlways = "always"
+	// ServiceRestartOnFailure restarts the service until it exits successfully.
+	ServiceRestartOnFailure = "on-failure"
+	// ServiceRestartNever runs the service once.
+	ServiceRestartNever = "never"
+)
+
+// Service represents a user-defined system service run as a container.
+type Service struct {
+	Name         string              `yaml:"name"`
+	Image        string              `yaml:"image"`
+	Args         []string            `yaml:"args,omitempty"`
+	Env          Env                 `yaml:"env,omitempty"`
+	Mounts       []specs.Mount       `yaml:"mounts,omitempty"`
+	Capabilities []string            `yaml:"capabilities,omitempty"`
+	DependsOn    []string            `yaml:"dependsOn,omitempty"`
+	Restart      string              `yaml:"restart,omitempty"`
+	HealthCheck  *ServiceHealthCheck `yaml:"healthCheck,omitempty"`
+}
+
+// ServiceHealthCheck represents the health check of a user-defined service.
+//
+// Exactly one of TCP (address to connect to) or HTTP (URL which should return 2xx/3xx) should be set.
+type ServiceHealthCheck struct {
+	TCP          string        `yaml:"tcp,omitempty"`
+	HTTP         string        `yaml:"http,omitempty"`
+	InitialDelay time.Duration `yaml:"initialDelay,omitempty"`
+	Period       time.Duration `yaml:"period,omitempty"`
+	Timeout      time.Duration `yaml:"timeout,omitempty"`
+}
+
 // Kubelet defines the requirements for a config that pertains to kubelet
 // related options.
 type Kubelet interface {
@@ -177,6 +177,29 @@ func (m *MachineConfig) Registries() machine.Registries {
 	return &m.MachineRegistries
 }
 
+// Swap implements the Configurator interface.
+func (m *MachineConfig) Swap() machine.Swap {
+	if m.MachineSwap == nil {
+		return &SwapConfig{}
+	}
+
+	return m.MachineSwap
+}
+
+// Services implements the Configurator interface.
+func (m *MachineConfig) Services() []machine.Service {
+	return m.MachineServices
+}
+
+// ImagePolicy implements the Configurator interface.
+func (m *MachineConfig) ImagePolicy() machine.ImagePolicy {
+	if m.MachineImagePolicy == nil {
+		return &ImagePolicyConfig{}
+	}
+
+	return m.MachineImagePolicy
+}
+
 // Image implements the Configurator interface.
 func (k *KubeletConfig) Image() string {
 	image := k.KubeletImage
@@ -225,6 +248,15 @@ func (c *ClusterConfig) LocalAPIServerPort() int {
 	return c.ControlPlane.LocalAPIServerPort
 }
 
+// LocalLoadBalancer implements the Configurator interface.
+func (c *ClusterConfig) LocalLoadBalancer() cluster.LocalLoadBalancer {
+	if c.ControlPlane.LocalLoadBalancerConfig == nil {
+		return &LocalLoadBalancerConfig{}
+	}
+
+	return c.ControlPlane.LocalLoadBalancerConfig
+}
+
 // CertSANs implements the Configurator interface.
 func (c *ClusterConfig) CertSANs() []string {
 	return c.APIServerConfig.CertSANs
@@ -473,6 +505,41 @@ func (t *TimeConfig) Servers() []string {
 	return t.TimeServers
 }
 
+// Enabled implements the Configurator interface.
+func (s *SwapConfig) Enabled() bool {
+	return len(s.SwapFiles) > 0 || len(s.SwapPartitions) > 0 || len(s.SwapZram) > 0
+}
+
+// Files implements the Configurator interface.
+func (s *SwapConfig) Files() []machine.SwapFile {
+	return s.SwapFiles
+}
+
+// Partitions implements the Configurator interface.
+func (s *SwapConfig) Partitions() []machine.SwapPartition {
+	return s.SwapPartitions
+}
+
+// Zram implements the Configurator interface.
+func (s *SwapConfig) Zram() []machine.ZramDevice {
+	return s.SwapZram
+}
+
+// NodeSwap implements the Configurator interface.
+func (s *SwapConfig) NodeSwap() bool {
+	return s.SwapNodeSwap
+}
+
+// RequireDigest implements the Configurator interface.
+func (p *ImagePolicyConfig) RequireDigest() bool {
+	return p.PolicyRequireDigest
+}
+
+// SignatureKeys implements the Configurator interface.
+func (p *ImagePolicyConfig) SignatureKeys() []string {
+	return p.PolicySignatureKeys
+}
+
 // Image implements the Configurator interface.
 func (i *InstallConfig) Image() string {
 	return i.InstallImage
@@ -503,6 +570,15 @@ func (i *InstallConfig) WithBootloader() bool {
 	return i.InstallBootloader
 }
 
+// BootloaderType implements the Configurator interface.
+func (i *InstallConfig) BootloaderType() string {
+	if i.InstallBootloaderType == "" {
+		return constants.BootloaderSyslinux
+	}
+
+	return i.InstallBootloaderType
+}
+
 // Image implements the Configurator interface.
 func (c *CoreDNS) Image() string {
 	coreDNSImage := asset.DefaultImages.CoreDNS
@@ -525,6 +601,20 @@ func (p *PodCheckpointer) Image() string {
 	return checkpointerImage
 }
 
+// Enabled implements the Configurator interface.
+func (l *LocalLoadBalancerConfig) Enabled() bool {
+	return l.LocalLoadBalancerEnabled
+}
+
+// Port implements the Configurator interface.
+func (l *LocalLoadBalancerConfig) Port() int {
+	if l.LocalLoadBalancerPort == 0 {
+		return constants.DefaultLocalLoadBalancerPort
+	}
+
+	return l.LocalLoadBalancerPort
+}
+
 // CertLifetime implements the Configurator interface.
 func (a AdminKubeconfigConfig) CertLifetime() time.Duration {
 	if a.AdminKubeconfigCertLifetime == 0 {
@@ -5,8 +5,15 @@
 package v1alpha1
 
 import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
 	"github.com/talos-systems/talos/cmd/osctl/pkg/client/config"
 	"github.com/talos-systems/talos/internal/pkg/runtime"
+	"github.com/talos-systems/talos/pkg/config/machine"
 )
 
 // ConfigBundle defines the group of v1alpha1 config files.
@@ -37,3 +44,37 @@ func (c *ConfigBundle) Join() runtime.Configurator {
 func (c *ConfigBundle) TalosConfig() *config.Config {
 	return c.TalosCfg
 }
+
+// Write config files to the output directory.
+//
+// Files are named the same way config.WithExistingConfigs expects them to be.
+func (c *ConfigBundle) Write(outputDir string) error {
+	for _, t := range []machine.Type{machine.TypeInit, machine.TypeControlPlane, machine.TypeWorker} {
+		var cfg *Config
+
+		switch t {
+		case machine.TypeInit:
+			cfg = c.InitCfg
+		case machine.TypeControlPlane:
+			cfg = c.ControlPlaneCfg
+		case machine.TypeWorker:
+			cfg = c.JoinCfg
+		}
+
+		configString, err := cfg.String()
+		if err != nil {
+			return err
+		}
+
+		if err = ioutil.WriteFile(filepath.Join(outputDir, strings.ToLower(t.String())+".yaml"), []byte(configString), 0600); err != nil {
+			return err
+		}
+	}
+
+	data, err := yaml.Marshal(c.TalosCfg)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(outputDir, "talosconfig"), data, 0600)
+}
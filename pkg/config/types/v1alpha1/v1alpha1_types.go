@@ -121,6 +121,7 @@ type MachineConfig struct {
 	//     Used to partition, format and mount additional disks.
 	//     Since the rootfs is read only with the exception of `/var`, mounts are only valid if they are under `/var`.
 	//     Note that the partitioning and formating is done only once, if and only if no existing  partitions are found.
+	//     If `grow` is set, the last partition and its filesystem are grown to the size of the disk on every boot.
 	//   examples:
 	//     - |
 	//       disks:
@@ -128,6 +129,7 @@ type MachineConfig struct {
 	//           partitions:
 	//             - size: 10000000000
 	//               mountpoint: /var/lib/extra
+	//           grow: true
 	MachineDisks []machine.Disk `yaml:"disks,omitempty"` // Note: `size` is in units of bytes.
 	//   description: |
 	//     Used to provide instructions for bare-metal installations.
@@ -232,6 +234,91 @@ type MachineConfig struct {
 	//             auth: ...
 	//             identityToken: ...
 	MachineRegistries RegistriesConfig `yaml:"registries,omitempty"`
+	//   description: |
+	//     Used to configure swap files, swap partitions and compressed RAM (zram) swap devices.
+	//     Swap is enabled during boot, once the extra disks are mounted.
+	//
+	//     Swap files must be located under `/var`, and are created if they don't exist.
+	//     Swap partitions are formatted unless they already contain a swap signature or a file system.
+	//     The `size` of swap files and zram devices is in units of bytes.
+	//
+	//     Devices with a higher `priority` are used first, the kernel assigns the priority if it is not set.
+	//   examples:
+	//     - |
+	//       swap:
+	//         files:
+	//           - path: /var/swapfile
+	//             size: 2147483648
+	//         partitions:
+	//           - device: /dev/sdb1
+	//         zram:
+	//           - size: 1073741824
+	//             algorithm: lz4
+	//             priority: 100
+	MachineSwap *SwapConfig `yaml:"swap,omitempty"`
+	//   description: |
+	//     Used to run additional system services in containers, e.g. hardware monitoring
+	//     or storage agents which should be up before Kubernetes.
+	//
+	//     Services are run by containerd outside of Kubernetes, after `containerd` and `networkd`
+	//     are up and all the services listed in `dependsOn` (system or user-defined) are healthy.
+	//     Service `name` should be a DNS label, and should not clash with names of Talos system services.
+	//     If `args` are not set, the entrypoint of the image is used.
+	//
+	//     Restart policy is one of `always` (default), `on-failure` or `never`.
+	//     Health check is either a `tcp` address to connect to, or an `http` URL which should respond
+	//     with a non-error status code.
+	//
+	//     User-defined services could be started, stopped and restarted with `osctl service`.
+	//   examples:
+	//     - |
+	//       services:
+	//         - name: smartd
+	//           image: docker.io/example/smartd:latest
+	//           args:
+	//             - /usr/sbin/smartd
+	//             - --no-fork
+	//           mounts:
+	//             - type: bind
+	//               source: /dev
+	//               destination: /dev
+	//               options:
+	//                 - rbind
+	//                 - rshared
+	//                 - rw
+	//           capabilities:
+	//             - CAP_SYS_RAWIO
+	//           dependsOn:
+	//             - udevd
+	//           restart: always
+	//           healthCheck:
+	//             tcp: 127.0.0.1:9633
+	//             period: 10s
+	MachineServices []machine.Service `yaml:"services,omitempty"`
+	//   description: |
+	//     Used to configure verification of the installer image and the images pulled
+	//     into the `system` containerd namespace (kubelet, etcd and user-defined services).
+	//
+	//     If `requireDigest` is set, image references should be pinned to a digest
+	//     (`repository@sha256:...`), and pulls by tag are rejected.
+	//
+	//     If `signatureKeys` are set, images should carry a detached signature
+	//     (cosign format, stored as `<repository>:sha256-<digest>.sig`) made with one of the keys.
+	//     Keys are PEM-encoded public keys (ECDSA, Ed25519 or RSA).
+	//     Images which fail the verification are removed from the image store.
+	//
+	//     Imported images can't be verified, so importing images into the `system`
+	//     namespace is not allowed if the policy is set.
+	//   examples:
+	//     - |
+	//       imagePolicy:
+	//         requireDigest: true
+	//         signatureKeys:
+	//           - |
+	//             -----BEGIN PUBLIC KEY-----
+	//             ...
+	//             -----END PUBLIC KEY-----
+	MachineImagePolicy *ImagePolicyConfig `yaml:"imagePolicy,omitempty"`
 }
 
 // ClusterConfig reperesents the cluster-wide config values
@@ -424,6 +511,19 @@ type NetworkConfig struct {
 	//     This parameter is optional.
 	//
 	//     Routes can be repeated and includes a `Network` and `Gateway` field.
+	//
+	//     ##### machine.network.interfaces.vip
+	//
+	//     `vip` is used to specify a shared virtual IP address which floats between the control plane nodes.
+	//     The nodes elect a leader using etcd, and the leader assigns the address to this interface
+	//     and announces it with a gratuitous ARP.
+	//     The address is handed over to another node on shutdown, upgrade, or reset.
+	//     This parameter is optional, and is only supported on control plane nodes.
+	//
+	//     ```yaml
+	//     vip:
+	//       ip: 192.168.2.100
+	//     ```
 	NetworkInterfaces []machine.Device `yaml:"interfaces,omitempty"`
 	//   description: |
 	//     Used to statically set the nameservers for the host.
@@ -461,6 +561,15 @@ type InstallConfig struct {
 	//     - no
 	InstallBootloader bool `yaml:"bootloader,omitempty"`
 	//   description: |
+	//     The bootloader to install.
+	//     `syslinux` and `grub` support both BIOS and UEFI, `systemd-boot` supports UEFI only.
+	//     Defaults to `syslinux`.
+	//   values:
+	//     - syslinux
+	//     - grub
+	//     - systemd-boot
+	InstallBootloaderType string `yaml:"bootloaderType,omitempty"`
+	//   description: |
 	//     Indicates if zeroes should be written to the `disk` before performing and installation.
 	//     Defaults to `true`.
 	//   values:
@@ -489,6 +598,36 @@ type TimeConfig struct {
 	TimeServers []string `yaml:"servers,omitempty"`
 }
 
+// SwapConfig represents the options for configuring swap on a node.
+type SwapConfig struct {
+	//   description: |
+	//     Specifies the swap files to create and enable.
+	SwapFiles []machine.SwapFile `yaml:"files,omitempty"`
+	//   description: |
+	//     Specifies the swap partitions to enable.
+	SwapPartitions []machine.SwapPartition `yaml:"partitions,omitempty"`
+	//   description: |
+	//     Specifies the zram devices to create and enable.
+	//     The compression `algorithm` defaults to the kernel default (`lzo`).
+	SwapZram []machine.ZramDevice `yaml:"zram,omitempty"`
+	//   description: |
+	//     Enables the `NodeSwap` feature gate of the kubelet, allowing workloads to use swap.
+	//     Requires Kubernetes 1.22 or later.
+	//     Otherwise the kubelet is only allowed to start with swap enabled.
+	SwapNodeSwap bool `yaml:"nodeSwap,omitempty"`
+}
+
+// ImagePolicyConfig represents the verification policy for system images.
+type ImagePolicyConfig struct {
+	//   description: |
+	//     Requires image references to be pinned to a digest.
+	PolicyRequireDigest bool `yaml:"requireDigest,omitempty"`
+	//   description: |
+	//     Specifies the PEM-encoded public keys to verify image signatures with.
+	//     An image is accepted if it is signed with any of the keys.
+	PolicySignatureKeys []string `yaml:"signatureKeys,omitempty"`
+}
+
 // RegistriesConfig represents the image pull options.
 type RegistriesConfig struct {
 	//   description: |
@@ -564,6 +703,27 @@ type ControlPlaneConfig struct {
 	//     This may be different than the port portion listed in the endpoint field above.
 	//     The default is 6443.
 	LocalAPIServerPort int `yaml:"localAPIServerPort,omitempty"`
+	//   description: |
+	//     Configures the load balancer for the control plane endpoint running on each node.
+	//     When enabled, kubelet reaches the API server via the local load balancer which
+	//     balances the connections across all the control plane nodes.
+	//   examples:
+	//     - |
+	//       localLoadBalancer:
+	//         enabled: true
+	//         port: 7445
+	LocalLoadBalancerConfig *LocalLoadBalancerConfig `yaml:"localLoadBalancer,omitempty"`
+}
+
+// LocalLoadBalancerConfig represents the control plane endpoint load balancer config vals.
+type LocalLoadBalancerConfig struct {
+	//   description: |
+	//     Enables the load balancer.
+	LocalLoadBalancerEnabled bool `yaml:"enabled"`
+	//   description: |
+	//     The port the load balancer listens on localhost.
+	//     The default is 7445.
+	LocalLoadBalancerPort int `yaml:"port,omitempty"`
 }
 
 // APIServerConfig represents kube apiserver config vals.
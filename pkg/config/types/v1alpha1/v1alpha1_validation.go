@@ -7,11 +7,17 @@
 package v1alpha1
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 
@@ -49,8 +55,74 @@ var (
 	ErrBadAddressing = errors.New("invalid network device addressing method")
 	// ErrInvalidAddress denotes that a bad address was provided
 	ErrInvalidAddress = errors.New("invalid network address")
+	// ErrVIPOnWorker denotes that a shared virtual IP is configured on a worker node
+	ErrVIPOnWorker = errors.New("shared virtual IP is only supported on control plane nodes")
+
+	// Swap
+
+	// ErrInvalidSwapFilePath denotes that a swap file is not located under /var
+	ErrInvalidSwapFilePath = errors.New("swap files must be located under /var")
+	// ErrInvalidSwapSize denotes that the size of a swap file or zram device is missing
+	ErrInvalidSwapSize = errors.New("swap size is required")
+	// ErrInvalidSwapPriority denotes that a swap priority is out of range
+	ErrInvalidSwapPriority = errors.New("swap priority must be between 0 and 32767")
+	// ErrNodeSwapUnsupported denotes that the kubelet doesn't support the NodeSwap feature gate
+	ErrNodeSwapUnsupported = errors.New("nodeSwap requires kubelet 1.22 or later")
+
+	// User services
+
+	// ErrInvalidServiceName denotes that a user service name is not a valid DNS label
+	ErrInvalidServiceName = errors.New("service name should be a DNS label")
+	// ErrDuplicateServiceName denotes that several user services have the same name
+	ErrDuplicateServiceName = errors.New("duplicate service name")
+	// ErrInvalidServiceRestart denotes that a user service restart policy is unknown
+	ErrInvalidServiceRestart = errors.New("service restart policy should be one of [always,on-failure,never]")
+	// ErrInvalidServiceHealthCheck denotes that a user service health check is invalid
+	ErrInvalidServiceHealthCheck = errors.New("exactly one of tcp or http health check should be set")
+	// ErrServiceDependencyCycle denotes that user services depend on each other
+	ErrServiceDependencyCycle = errors.New("service dependency cycle")
+	// ErrReservedServiceName denotes that a user service name clashes with a system service
+	ErrReservedServiceName = errors.New("service name is reserved for a system service")
+	// ErrUnknownServiceDependency denotes that a user service depends on a service which doesn't exist
+	ErrUnknownServiceDependency = errors.New("service dependency is neither a user nor a system service")
+
+	// Image policy
+
+	// ErrImageNotPinned denotes that an image is not pinned to a digest while the image policy requires it
+	ErrImageNotPinned = errors.New("image should be pinned to a digest")
+	// ErrInvalidSignatureKey denotes that an image signature key is not a PEM-encoded public key
+	ErrInvalidSignatureKey = errors.New("signature key should be a PEM-encoded public key")
 )
 
+const maxSwapPriority = 32767
+
+// nodeSwapMinVersion is the first Kubernetes minor version with the NodeSwap feature gate.
+const nodeSwapMinVersion = 22
+
+var serviceNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// systemServices is the set of the IDs of the Talos system services.
+//
+// User services can't use these names, but they can depend on the system services.
+var systemServices = map[string]struct{}{
+	"apid":              {},
+	"bootkube":          {},
+	"containerd":        {},
+	"controlplane-lb":   {},
+	"etcd":              {},
+	"kubelet":           {},
+	"machined-api":      {},
+	"networkd":          {},
+	"ntpd":              {},
+	"osd":               {},
+	"routerd":           {},
+	"system-containerd": {},
+	"trustd":            {},
+	"udevd":             {},
+	"udevd-trigger":     {},
+	"vip":               {},
+}
+
 // NetworkDeviceCheck defines the function type for checks.
 //nolint: dupl
 type NetworkDeviceCheck func(machine.Device) error
@@ -86,6 +158,14 @@ func (c *Config) Validate(mode runtime.Mode) error {
 		}
 	}
 
+	if c.MachineConfig.MachineInstall != nil {
+		switch c.MachineConfig.MachineInstall.InstallBootloaderType {
+		case "", constants.BootloaderSyslinux, constants.BootloaderGRUB, constants.BootloaderSystemdBoot:
+		default:
+			result = multierror.Append(result, fmt.Errorf("bootloader type should be one of [%s,%s,%s]", constants.BootloaderSyslinux, constants.BootloaderGRUB, constants.BootloaderSystemdBoot))
+		}
+	}
+
 	if c.Machine().Type() == machine.TypeInit {
 		switch c.Cluster().Network().CNI().Name() {
 		case "custom":
@@ -100,7 +180,51 @@ func (c *Config) Validate(mode runtime.Mode) error {
 	}
 
 	for _, device := range c.MachineConfig.MachineNetwork.NetworkInterfaces {
-		if err := ValidateNetworkDevices(device, CheckDeviceInterface, CheckDeviceAddressing); err != nil {
+		if err := ValidateNetworkDevices(device, CheckDeviceInterface, CheckDeviceAddressing, CheckDeviceVIP); err != nil {
+			result = multierror.Append(result, err)
+		}
+
+		if device.VIP != nil && c.Machine().Type() == machine.TypeWorker {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.vip", device.VIP.IP, ErrVIPOnWorker))
+		}
+	}
+
+	if err := ValidateSwap(c.Machine().Swap()); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if swap := c.Machine().Swap(); swap.Enabled() && swap.NodeSwap() {
+		kubeletImage := (&KubeletConfig{}).Image()
+
+		if c.MachineConfig.MachineKubelet != nil {
+			kubeletImage = c.Machine().Kubelet().Image()
+		}
+
+		if err := ValidateNodeSwap(kubeletImage); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if err := ValidateServices(c.Machine().Services()); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	if c.MachineConfig != nil && c.ClusterConfig != nil {
+		images := []string{c.Machine().Install().Image()}
+
+		if c.MachineConfig.MachineKubelet != nil {
+			images = append(images, c.Machine().Kubelet().Image())
+		}
+
+		if c.Machine().Type() != machine.TypeWorker && c.ClusterConfig.EtcdConfig != nil {
+			images = append(images, c.Cluster().Etcd().Image())
+		}
+
+		for _, service := range c.Machine().Services() {
+			images = append(images, service.Image)
+		}
+
+		if err := ValidateImagePolicy(c.Machine().ImagePolicy(), images); err != nil {
 			result = multierror.Append(result, err)
 		}
 	}
@@ -108,6 +232,247 @@ func (c *Config) Validate(mode runtime.Mode) error {
 	return result.ErrorOrNil()
 }
 
+// ValidateImagePolicy validates the image signature keys, and checks that
+// the images are pinned to a digest if the policy requires it.
+func ValidateImagePolicy(policy machine.ImagePolicy, images []string) error {
+	var result *multierror.Error
+
+	for i, key := range policy.SignatureKeys() {
+		block, _ := pem.Decode([]byte(key))
+		if block == nil {
+			result = multierror.Append(result, fmt.Errorf("key %d: %w", i, ErrInvalidSignatureKey))
+
+			continue
+		}
+
+		if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			result = multierror.Append(result, fmt.Errorf("key %d: %w: %s", i, ErrInvalidSignatureKey, err))
+		}
+	}
+
+	if policy.RequireDigest() {
+		for _, image := range images {
+			if image == "" {
+				continue
+			}
+
+			if !strings.Contains(image, "@sha256:") {
+				result = multierror.Append(result, fmt.Errorf("%q: %w", image, ErrImageNotPinned))
+			}
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// ValidateSwap validates the swap files, partitions and zram devices.
+func ValidateSwap(swap machine.Swap) error {
+	var result *multierror.Error
+
+	checkPriority := func(name string, priority int) {
+		if priority < 0 || priority > maxSwapPriority {
+			result = multierror.Append(result, fmt.Errorf("%q: %w: %d", name, ErrInvalidSwapPriority, priority))
+		}
+	}
+
+	for _, file := range swap.Files() {
+		if !strings.HasPrefix(filepath.Clean(file.Path), "/var/") {
+			result = multierror.Append(result, fmt.Errorf("%q: %w", file.Path, ErrInvalidSwapFilePath))
+		}
+
+		if file.Size == 0 {
+			result = multierror.Append(result, fmt.Errorf("%q: %w", file.Path, ErrInvalidSwapSize))
+		}
+
+		checkPriority(file.Path, file.Priority)
+	}
+
+	for _, partition := range swap.Partitions() {
+		if partition.Device == "" {
+			result = multierror.Append(result, errors.New("a swap partition device is required"))
+		}
+
+		checkPriority(partition.Device, partition.Priority)
+	}
+
+	for i, zram := range swap.Zram() {
+		name := fmt.Sprintf("zram%d", i)
+
+		if zram.Size == 0 {
+			result = multierror.Append(result, fmt.Errorf("%q: %w", name, ErrInvalidSwapSize))
+		}
+
+		checkPriority(name, zram.Priority)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// ValidateNodeSwap checks that the version of the kubelet image supports the NodeSwap feature gate.
+func ValidateNodeSwap(kubeletImage string) error {
+	major, minor, ok := imageVersion(kubeletImage)
+	if !ok {
+		return fmt.Errorf("%q: %w: unable to determine the kubelet version", kubeletImage, ErrNodeSwapUnsupported)
+	}
+
+	if major < 1 || (major == 1 && minor < nodeSwapMinVersion) {
+		return fmt.Errorf("%q: %w", kubeletImage, ErrNodeSwapUnsupported)
+	}
+
+	return nil
+}
+
+// imageVersion parses the major and minor version from the image tag, e.g. v1.17.1.
+func imageVersion(image string) (major, minor int, ok bool) {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+
+	i := strings.LastIndex(image, ":")
+	if i < 0 || strings.Contains(image[i:], "/") {
+		return 0, 0, false
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(image[i+1:], "v"), ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+
+	var err error
+
+	if major, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, 0, false
+	}
+
+	if minor, err = strconv.Atoi(parts[1]); err != nil {
+		return 0, 0, false
+	}
+
+	return major, minor, true
+}
+
+// ValidateServices validates the user-defined services.
+//
+//nolint: gocyclo
+func ValidateServices(services []machine.Service) error {
+	var result *multierror.Error
+
+	dependencies := make(map[string][]string, len(services))
+
+	for _, service := range services {
+		if !serviceNameRegexp.MatchString(service.Name) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "services.name", service.Name, ErrInvalidServiceName))
+		}
+
+		if _, exists := dependencies[service.Name]; exists {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "services.name", service.Name, ErrDuplicateServiceName))
+		}
+
+		if _, reserved := systemServices[service.Name]; reserved {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "services.name", service.Name, ErrReservedServiceName))
+		}
+
+		dependencies[service.Name] = service.DependsOn
+
+		if service.Image == "" {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "services.image", service.Name, ErrRequiredSection))
+		}
+
+		switch service.Restart {
+		case "", machine.ServiceRestartAlways, machine.ServiceRestartOnFailure, machine.ServiceRestartNever:
+		default:
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "services.restart", service.Restart, ErrInvalidServiceRestart))
+		}
+
+		for _, mount := range service.Mounts {
+			if !filepath.IsAbs(mount.Destination) {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: mount destination should be an absolute path", "services.mounts", mount.Destination))
+			}
+		}
+
+		if service.HealthCheck != nil {
+			if err := validateServiceHealthCheck(service.HealthCheck); err != nil {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "services.healthCheck", service.Name, err))
+			}
+		}
+	}
+
+	for _, service := range services {
+		for _, dependency := range service.DependsOn {
+			_, isUserService := dependencies[dependency]
+			_, isSystemService := systemServices[dependency]
+
+			if !isUserService && !isSystemService {
+				result = multierror.Append(result, fmt.Errorf("[%s] %q: %w: %q", "services.dependsOn", service.Name, ErrUnknownServiceDependency, dependency))
+			}
+		}
+	}
+
+	// system services don't depend on user services, so only cycles between user services are possible
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(dependencies))
+
+	var visit func(name string) bool
+
+	visit = func(name string) bool {
+		switch state[name] {
+		case visiting:
+			return false
+		case visited:
+			return true
+		}
+
+		state[name] = visiting
+		defer func() { state[name] = visited }()
+
+		for _, dependency := range dependencies[name] {
+			if _, ok := dependencies[dependency]; ok && !visit(dependency) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for _, service := range services {
+		if state[service.Name] == unvisited && !visit(service.Name) {
+			result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "services.dependsOn", service.Name, ErrServiceDependencyCycle))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func validateServiceHealthCheck(check *machine.ServiceHealthCheck) error {
+	if (check.TCP == "") == (check.HTTP == "") {
+		return ErrInvalidServiceHealthCheck
+	}
+
+	if check.TCP != "" {
+		if _, _, err := net.SplitHostPort(check.TCP); err != nil {
+			return err
+		}
+	}
+
+	if check.HTTP != "" {
+		u, err := url.Parse(check.HTTP)
+		if err != nil {
+			return err
+		}
+
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("unsupported health check URL scheme %q", u.Scheme)
+		}
+	}
+
+	return nil
+}
+
 // ValidateNetworkDevices runs the specified validation checks specific to the
 // network devices.
 //nolint: dupl
@@ -184,3 +549,19 @@ func CheckDeviceRoutes(d machine.Device) error {
 
 	return result.ErrorOrNil()
 }
+
+// CheckDeviceVIP ensures that the shared virtual IP is valid.
+//nolint: dupl
+func CheckDeviceVIP(d machine.Device) error {
+	var result *multierror.Error
+
+	if d.VIP == nil {
+		return result.ErrorOrNil()
+	}
+
+	if ip := net.ParseIP(d.VIP.IP); ip == nil {
+		result = multierror.Append(result, fmt.Errorf("[%s] %q: %w", "networking.os.device.vip.ip", d.VIP.IP, ErrInvalidAddress))
+	}
+
+	return result.ErrorOrNil()
+}
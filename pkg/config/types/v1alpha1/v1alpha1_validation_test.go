@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/pkg/config/machine"
+	"github.com/talos-systems/talos/pkg/config/types/v1alpha1"
+)
+
+type ValidationSuite struct {
+	suite.Suite
+}
+
+func TestValidationSuite(t *testing.T) {
+	suite.Run(t, new(ValidationSuite))
+}
+
+func (suite *ValidationSuite) TestValidateNodeSwap() {
+	for _, t := range []struct {
+		image     string
+		supported bool
+	}{
+		{"k8s.gcr.io/hyperkube:v1.17.1", false},
+		{"k8s.gcr.io/kubelet:v1.22.0", true},
+		{"k8s.gcr.io/kubelet:v1.23.1-rc.0", true},
+		{"localhost:5000/kubelet:v1.22.3@sha256:0123456789abcdef", true},
+		{"localhost:5000/kubelet", false},
+		{"k8s.gcr.io/kubelet@sha256:0123456789abcdef", false},
+		{"k8s.gcr.io/kubelet:latest", false},
+	} {
+		err := v1alpha1.ValidateNodeSwap(t.image)
+
+		if t.supported {
+			suite.Assert().NoError(err, t.image)
+		} else {
+			suite.Assert().True(errors.Is(err, v1alpha1.ErrNodeSwapUnsupported), t.image)
+		}
+	}
+}
+
+func (suite *ValidationSuite) TestValidateServices() {
+	for _, t := range []struct {
+		name     string
+		services []machine.Service
+		expected []error
+	}{
+		{
+			name: "valid",
+			services: []machine.Service{
+				{Name: "agent", Image: "docker.io/example/agent:latest", DependsOn: []string{"udevd", "storage"}},
+				{Name: "storage", Image: "docker.io/example/storage:latest", Restart: machine.ServiceRestartOnFailure},
+			},
+		},
+		{
+			name: "invalid fields",
+			services: []machine.Service{
+				{Name: "Agent", Restart: "sometimes", HealthCheck: &machine.ServiceHealthCheck{}},
+			},
+			expected: []error{v1alpha1.ErrInvalidServiceName, v1alpha1.ErrRequiredSection, v1alpha1.ErrInvalidServiceRestart, v1alpha1.ErrInvalidServiceHealthCheck},
+		},
+		{
+			name: "duplicate",
+			services: []machine.Service{
+				{Name: "agent", Image: "docker.io/example/agent:latest"},
+				{Name: "agent", Image: "docker.io/example/agent:latest"},
+			},
+			expected: []error{v1alpha1.ErrDuplicateServiceName},
+		},
+		{
+			name: "reserved",
+			services: []machine.Service{
+				{Name: "kubelet", Image: "docker.io/example/kubelet:latest"},
+			},
+			expected: []error{v1alpha1.ErrReservedServiceName},
+		},
+		{
+			name: "unknown dependency",
+			services: []machine.Service{
+				{Name: "agent", Image: "docker.io/example/agent:latest", DependsOn: []string{"storage"}},
+			},
+			expected: []error{v1alpha1.ErrUnknownServiceDependency},
+		},
+		{
+			name: "self cycle",
+			services: []machine.Service{
+				{Name: "agent", Image: "docker.io/example/agent:latest", DependsOn: []string{"agent"}},
+			},
+			expected: []error{v1alpha1.ErrServiceDependencyCycle},
+		},
+		{
+			name: "cycle",
+			services: []machine.Service{
+				{Name: "agent", Image: "docker.io/example/agent:latest", DependsOn: []string{"networkd", "storage"}},
+				{Name: "storage", Image: "docker.io/example/storage:latest", DependsOn: []string{"monitor"}},
+				{Name: "monitor", Image: "docker.io/example/monitor:latest", DependsOn: []string{"agent"}},
+			},
+			expected: []error{v1alpha1.ErrServiceDependencyCycle},
+		},
+		{
+			name: "diamond",
+			services: []machine.Service{
+				{Name: "agent", Image: "docker.io/example/agent:latest", DependsOn: []string{"storage", "monitor"}},
+				{Name: "storage", Image: "docker.io/example/storage:latest", DependsOn: []string{"base"}},
+				{Name: "monitor", Image: "docker.io/example/monitor:latest", DependsOn: []string{"base"}},
+				{Name: "base", Image: "docker.io/example/base:latest"},
+			},
+		},
+	} {
+		err := v1alpha1.ValidateServices(t.services)
+
+		if len(t.expected) == 0 {
+			suite.Assert().NoError(err, t.name)
+
+			continue
+		}
+
+		suite.Require().Error(err, t.name)
+
+		// errors are aggregated with multierror
+		for _, expected := range t.expected {
+			suite.Assert().Contains(err.Error(), expected.Error(), t.name)
+		}
+	}
+}
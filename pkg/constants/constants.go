@@ -52,6 +52,19 @@ const (
 	// the boot path.
 	BootMountPoint = "/boot"
 
+	// BIOSBootPartitionLabel is the label of the BIOS boot partition used by
+	// GRUB to store its core image on GPT disks.
+	BIOSBootPartitionLabel = "BIOS"
+
+	// BootloaderSyslinux is the name of the syslinux bootloader.
+	BootloaderSyslinux = "syslinux"
+
+	// BootloaderGRUB is the name of the GRUB bootloader.
+	BootloaderGRUB = "grub"
+
+	// BootloaderSystemdBoot is the name of the systemd-boot bootloader.
+	BootloaderSystemdBoot = "systemd-boot"
+
 	// EphemeralPartitionLabel is the label of the partition to use for
 	// mounting at the data path.
 	EphemeralPartitionLabel = "EPHEMERAL"
@@ -197,9 +210,18 @@ const (
 	// ApidPort is the port for the apid service.
 	ApidPort = 50000
 
+	// AdminRole is the role (certificate organization) required to access admin-only APIs.
+	AdminRole = "os:admin"
+
+	// NodeRole is the role (certificate organization) of the Talos node certificates.
+	NodeRole = "os:node"
+
 	// TrustdPort is the port for the trustd service.
 	TrustdPort = 50001
 
+	// DefaultLocalLoadBalancerPort is the default port of the control plane endpoint load balancer on localhost.
+	DefaultLocalLoadBalancerPort = 7445
+
 	// DefaultContainerdVersion is the default container runtime version
 	DefaultContainerdVersion = "1.3.3"
 
@@ -209,6 +231,9 @@ const (
 	// SystemContainerdAddress is the path to the system containerd socket.
 	SystemContainerdAddress = SystemRunPath + "/containerd/containerd.sock"
 
+	// CgroupSystem is the cgroup (relative to the hierarchy root) for Talos services.
+	CgroupSystem = "/system"
+
 	// CRIContainerdConfig is the path to the config for the containerd instance that provides the CRI.
 	CRIContainerdConfig = "/etc/cri/containerd.toml"
 
@@ -255,6 +280,9 @@ const (
 	// directories.
 	SystemVarPath = "/var/system"
 
+	// ServiceEventsPath is the path to the persisted history of service events.
+	ServiceEventsPath = SystemVarPath + "/events"
+
 	// SystemRunPath is the path to write temporary runtime system related files
 	// and directories.
 	SystemRunPath = "/run/system"
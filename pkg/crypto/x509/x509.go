@@ -345,6 +345,9 @@ func NewRSAKey() (key *RSAKey, err error) {
 
 // NewCertificateFromCSR creates and signs X.509 certificate using the provided
 // CSR.
+//
+// If the Organization option is set, it replaces the subject organization
+// requested in the CSR.
 func NewCertificateFromCSR(ca *x509.Certificate, key interface{}, csr *x509.CertificateRequest, setters ...Option) (crt *Certificate, err error) {
 	opts := NewDefaultOptions(setters...)
 
@@ -353,6 +356,11 @@ func NewCertificateFromCSR(ca *x509.Certificate, key interface{}, csr *x509.Cert
 		return nil, err
 	}
 
+	subject := csr.Subject
+	if opts.Organization != "" {
+		subject.Organization = []string{opts.Organization}
+	}
+
 	template := &x509.Certificate{
 		Signature:          csr.Signature,
 		SignatureAlgorithm: csr.SignatureAlgorithm,
@@ -362,7 +370,7 @@ func NewCertificateFromCSR(ca *x509.Certificate, key interface{}, csr *x509.Cert
 
 		SerialNumber:          serialNumber,
 		Issuer:                ca.Subject,
-		Subject:               csr.Subject,
+		Subject:               subject,
 		NotBefore:             time.Now(),
 		NotAfter:              opts.NotAfter,
 		KeyUsage:              x509.KeyUsageDigitalSignature,
@@ -505,6 +513,8 @@ func NewCertificateAndKeyFromFiles(crt, key string) (p *PEMEncodedCertificateAnd
 
 // NewCSRAndIdentity generates and PEM encoded certificate and key, along with a
 // CSR for the generated key.
+//
+// The CSR carries the Talos node role as the subject organization.
 func NewCSRAndIdentity(dnsNames []string, ips []net.IP) (csr *CertificateSigningRequest, identity *PEMEncodedCertificateAndKey, err error) {
 	var key *Ed25519Key
 
@@ -530,6 +540,7 @@ func NewCSRAndIdentity(dnsNames []string, ips []net.IP) (csr *CertificateSigning
 	opts := []Option{}
 	opts = append(opts, DNSNames(dnsNames))
 	opts = append(opts, IPAddresses(ips))
+	opts = append(opts, Organization(constants.NodeRole))
 
 	csr, err = NewCertificateSigningRequest(priv, opts...)
 	if err != nil {
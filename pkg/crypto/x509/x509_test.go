@@ -4,11 +4,37 @@
 
 package x509_test
 
-import "testing"
+import (
+	"testing"
 
-func TestEmpty(t *testing.T) {
-	// added for accurate coverage estimation
-	//
-	// please remove it once any unit-test is added
-	// for this package
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/pkg/crypto/x509"
+)
+
+type X509Suite struct {
+	suite.Suite
+}
+
+func TestX509Suite(t *testing.T) {
+	suite.Run(t, new(X509Suite))
+}
+
+func (suite *X509Suite) TestNewCertificateFromCSROrganization() {
+	ca, err := x509.NewSelfSignedCertificateAuthority()
+	suite.Require().NoError(err)
+
+	key, err := x509.NewEd25519Key()
+	suite.Require().NoError(err)
+
+	csr, err := x509.NewCertificateSigningRequest(key.PrivateKey, x509.Organization("requested"))
+	suite.Require().NoError(err)
+
+	crt, err := x509.NewCertificateFromCSRBytes(ca.CrtPEM, ca.KeyPEM, csr.X509CertificateRequestPEM)
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]string{"requested"}, crt.X509Certificate.Subject.Organization)
+
+	crt, err = x509.NewCertificateFromCSRBytes(ca.CrtPEM, ca.KeyPEM, csr.X509CertificateRequestPEM, x509.Organization("forced"))
+	suite.Require().NoError(err)
+	suite.Assert().Equal([]string{"forced"}, crt.X509Certificate.Subject.Organization)
 }
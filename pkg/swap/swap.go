@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package swap provides a library for managing swap areas.
+package swap
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Flags of the swapon system call, see include/linux/swap.h.
+const (
+	flagPrefer   = 0x8000
+	flagPrioMask = 0x7fff
+)
+
+// Area represents an active swap area.
+type Area struct {
+	Filename string
+	Type     string
+	// Size is the size of the swap area in kB.
+	Size uint64
+	// Used is the amount of swap space in use in kB.
+	Used     uint64
+	Priority int
+}
+
+// On enables swapping on the specified file or block device. A priority of
+// zero lets the kernel assign the priority.
+func On(path string, priority int) error {
+	p, err := unix.BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+
+	flags := 0
+	if priority > 0 {
+		flags = flagPrefer | (priority & flagPrioMask)
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_SWAPON, uintptr(unsafe.Pointer(p)), uintptr(flags), 0); errno != 0 {
+		return fmt.Errorf("swapon %s: %w", path, errno)
+	}
+
+	return nil
+}
+
+// Off disables swapping on the specified file or block device.
+func Off(path string) error {
+	p, err := unix.BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_SWAPOFF, uintptr(unsafe.Pointer(p)), 0, 0); errno != 0 {
+		return fmt.Errorf("swapoff %s: %w", path, errno)
+	}
+
+	return nil
+}
+
+// Areas returns the active swap areas.
+func Areas() ([]Area, error) {
+	f, err := os.Open("/proc/swaps")
+	if err != nil {
+		return nil, err
+	}
+	// nolint: errcheck
+	defer f.Close()
+
+	return ParseAreas(f)
+}
+
+// ParseAreas parses the active swap areas in the /proc/swaps format.
+func ParseAreas(r io.Reader) (areas []Area, err error) {
+	areas = []Area{}
+
+	scanner := bufio.NewScanner(r)
+
+	// Skip the header.
+	scanner.Scan()
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("malformed swap area %q", scanner.Text())
+		}
+
+		area := Area{
+			// Spaces in file names are escaped.
+			Filename: strings.ReplaceAll(fields[0], `\040`, " "),
+			Type:     fields[1],
+		}
+
+		if area.Size, err = strconv.ParseUint(fields[2], 10, 64); err != nil {
+			return nil, err
+		}
+
+		if area.Used, err = strconv.ParseUint(fields[3], 10, 64); err != nil {
+			return nil, err
+		}
+
+		if area.Priority, err = strconv.Atoi(fields[4]); err != nil {
+			return nil, err
+		}
+
+		areas = append(areas, area)
+	}
+
+	return areas, scanner.Err()
+}
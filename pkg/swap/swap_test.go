@@ -0,0 +1,42 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package swap_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/talos-systems/talos/pkg/swap"
+)
+
+type SwapSuite struct {
+	suite.Suite
+}
+
+func (suite *SwapSuite) TestParseAreas() {
+	areas, err := swap.ParseAreas(strings.NewReader(`Filename				Type		Size		Used		Priority
+/dev/zram0                              partition	1048572		1024		100
+/var/swap\040file                       file		2097148		0		-2
+`))
+	suite.Require().NoError(err)
+
+	suite.Assert().Equal([]swap.Area{
+		{Filename: "/dev/zram0", Type: "partition", Size: 1048572, Used: 1024, Priority: 100},
+		{Filename: "/var/swap file", Type: "file", Size: 2097148, Used: 0, Priority: -2},
+	}, areas)
+
+	areas, err = swap.ParseAreas(strings.NewReader("Filename\tType\tSize\tUsed\tPriority\n"))
+	suite.Require().NoError(err)
+	suite.Assert().Empty(areas)
+
+	_, err = swap.ParseAreas(strings.NewReader("Filename\tType\tSize\tUsed\tPriority\n/dev/sda2\tpartition\n"))
+	suite.Assert().Error(err)
+}
+
+func TestSwapSuite(t *testing.T) {
+	suite.Run(t, new(SwapSuite))
+}
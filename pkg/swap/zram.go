@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package swap
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const zramControlHotAdd = "/sys/class/zram-control/hot_add"
+
+// Zram represents a compressed RAM block device.
+type Zram struct {
+	ID int
+}
+
+// ZramStats represents the compression statistics of a zram device.
+type ZramStats struct {
+	Algorithm string
+	// OrigDataSize is the uncompressed size of the stored data in bytes.
+	OrigDataSize uint64
+	// ComprDataSize is the compressed size of the stored data in bytes.
+	ComprDataSize uint64
+}
+
+// GetZram returns the zram device with the specified ID, allocating devices
+// as needed.
+func GetZram(id int) (*Zram, error) {
+	z := &Zram{ID: id}
+
+	for {
+		if _, err := os.Stat(z.sysfs("")); err == nil {
+			return z, nil
+		}
+
+		contents, err := ioutil.ReadFile(zramControlHotAdd)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, errors.New("zram is not supported by the kernel")
+			}
+
+			return nil, err
+		}
+
+		var added int
+
+		if added, err = strconv.Atoi(strings.TrimSpace(string(contents))); err != nil {
+			return nil, err
+		}
+
+		if added > id {
+			return nil, fmt.Errorf("failed to allocate zram%d", id)
+		}
+	}
+}
+
+// IsZram returns true if the path refers to a zram device.
+func IsZram(path string) bool {
+	return strings.HasPrefix(filepath.Base(path), "zram")
+}
+
+// ZramFromPath returns the zram device referred to by the path.
+func ZramFromPath(path string) (*Zram, error) {
+	id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(path), "zram"))
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a zram device", path)
+	}
+
+	return &Zram{ID: id}, nil
+}
+
+// Path returns the path to the block device.
+func (z *Zram) Path() string {
+	return fmt.Sprintf("/dev/zram%d", z.ID)
+}
+
+// Configure sets the size in bytes and the compression algorithm of the
+// device. An empty algorithm keeps the kernel default.
+func (z *Zram) Configure(size uint64, algorithm string) error {
+	// The algorithm can't be changed once the size is set.
+	if algorithm != "" {
+		if err := ioutil.WriteFile(z.sysfs("comp_algorithm"), []byte(algorithm), 0644); err != nil {
+			return fmt.Errorf("failed to set zram%d compression algorithm %q: %w", z.ID, algorithm, err)
+		}
+	}
+
+	if err := ioutil.WriteFile(z.sysfs("disksize"), []byte(strconv.FormatUint(size, 10)), 0644); err != nil {
+		return fmt.Errorf("failed to set zram%d size: %w", z.ID, err)
+	}
+
+	return nil
+}
+
+// Stats returns the compression statistics of the device.
+func (z *Zram) Stats() (stats *ZramStats, err error) {
+	stats = &ZramStats{}
+
+	var contents []byte
+
+	if contents, err = ioutil.ReadFile(z.sysfs("comp_algorithm")); err != nil {
+		return nil, err
+	}
+
+	// The selected algorithm is enclosed in brackets, e.g. "lzo [lz4] zstd".
+	for _, algorithm := range strings.Fields(string(contents)) {
+		if strings.HasPrefix(algorithm, "[") {
+			stats.Algorithm = strings.Trim(algorithm, "[]")
+		}
+	}
+
+	if contents, err = ioutil.ReadFile(z.sysfs("mm_stat")); err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(string(contents))
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("malformed zram%d mm_stat %q", z.ID, contents)
+	}
+
+	if stats.OrigDataSize, err = strconv.ParseUint(fields[0], 10, 64); err != nil {
+		return nil, err
+	}
+
+	if stats.ComprDataSize, err = strconv.ParseUint(fields[1], 10, 64); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (z *Zram) sysfs(attr string) string {
+	return filepath.Join(fmt.Sprintf("/sys/block/zram%d", z.ID), attr)
+}